@@ -0,0 +1,440 @@
+package lsp
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// providers maps requests to the server capability announcing them. The
+// value true is used for the capability, unless the feature has options
+// derived by NewServerCapabilities.
+var providers = map[string]string{
+	"textDocument/hover":                "hoverProvider",
+	"textDocument/completion":           "completionProvider",
+	"textDocument/signatureHelp":        "signatureHelpProvider",
+	"textDocument/declaration":          "declarationProvider",
+	"textDocument/definition":           "definitionProvider",
+	"textDocument/typeDefinition":       "typeDefinitionProvider",
+	"textDocument/implementation":       "implementationProvider",
+	"textDocument/references":           "referencesProvider",
+	"textDocument/documentHighlight":    "documentHighlightProvider",
+	"textDocument/documentSymbol":       "documentSymbolProvider",
+	"textDocument/codeAction":           "codeActionProvider",
+	"textDocument/codeLens":             "codeLensProvider",
+	"textDocument/documentLink":         "documentLinkProvider",
+	"textDocument/documentColor":        "colorProvider",
+	"textDocument/formatting":           "documentFormattingProvider",
+	"textDocument/rangeFormatting":      "documentRangeFormattingProvider",
+	"textDocument/onTypeFormatting":     "documentOnTypeFormattingProvider",
+	"textDocument/rename":               "renameProvider",
+	"textDocument/foldingRange":         "foldingRangeProvider",
+	"textDocument/selectionRange":       "selectionRangeProvider",
+	"textDocument/linkedEditingRange":   "linkedEditingRangeProvider",
+	"textDocument/prepareCallHierarchy": "callHierarchyProvider",
+	"textDocument/semanticTokens/full":  "semanticTokensProvider",
+	"textDocument/semanticTokens/range": "semanticTokensProvider",
+	"textDocument/moniker":              "monikerProvider",
+	"textDocument/prepareTypeHierarchy": "typeHierarchyProvider",
+	"textDocument/inlineValue":          "inlineValueProvider",
+	"textDocument/inlayHint":            "inlayHintProvider",
+	"textDocument/diagnostic":           "diagnosticProvider",
+	"workspace/symbol":                  "workspaceSymbolProvider",
+	"workspace/executeCommand":          "executeCommandProvider",
+}
+
+// resolvers maps requests to the request resolving their results lazily.
+var resolvers = map[string]string{
+	"textDocument/completion":   "completionItem/resolve",
+	"textDocument/codeAction":   "codeAction/resolve",
+	"textDocument/codeLens":     "codeLens/resolve",
+	"textDocument/documentLink": "documentLink/resolve",
+	"textDocument/inlayHint":    "inlayHint/resolve",
+	"workspace/symbol":          "workspaceSymbol/resolve",
+}
+
+// fileOperations maps file operation notifications and requests to their
+// capability in workspace.fileOperations.
+var fileOperations = map[string]string{
+	"workspace/didCreateFiles":  "didCreate",
+	"workspace/willCreateFiles": "willCreate",
+	"workspace/didRenameFiles":  "didRename",
+	"workspace/willRenameFiles": "willRename",
+	"workspace/didDeleteFiles":  "didDelete",
+	"workspace/willDeleteFiles": "willDelete",
+}
+
+// CapabilityOptions are options of server features, which cannot be derived
+// from the handled methods.
+type CapabilityOptions struct {
+	// PositionEncoding is the position encoding used by the server.
+	PositionEncoding PositionEncodingKind
+
+	// SyncKind is how changes of text documents are sent by the client.
+	// If the server handles textDocument/didChange and SyncKind is not
+	// set, TextDocumentSyncKindIncremental is used.
+	SyncKind TextDocumentSyncKind
+
+	// IncludeText is whether the client sends the text of saved
+	// documents.
+	IncludeText bool
+
+	// CompletionTriggerCharacters are characters triggering completion.
+	CompletionTriggerCharacters []string
+
+	// SignatureHelpTriggerCharacters are characters triggering signature
+	// help. SignatureHelpRetriggerCharacters retrigger it, while it is
+	// active.
+	SignatureHelpTriggerCharacters   []string
+	SignatureHelpRetriggerCharacters []string
+
+	// OnTypeFormattingTriggerCharacters are characters triggering
+	// formatting while typing. At least one is required for on type
+	// formatting.
+	OnTypeFormattingTriggerCharacters []string
+
+	// Commands are the commands executed by workspace/executeCommand.
+	Commands []string
+
+	// SemanticTokenTypes and SemanticTokenModifiers are the legend of
+	// semantic tokens.
+	SemanticTokenTypes     []string
+	SemanticTokenModifiers []string
+
+	// InterFileDependencies is whether diagnostics of a document depend on
+	// other documents.
+	InterFileDependencies bool
+
+	// FileOperationFilters select the files of handled file operations.
+	FileOperationFilters []FileOperationFilter
+
+	// Overrides sets capabilities to the given values. The keys are
+	// dotted paths of JSON names, like "codeActionProvider" or
+	// "workspace.fileOperations.didCreate". Overrides are applied after
+	// the capabilities derived from methods.
+	Overrides map[string]interface{}
+}
+
+// NewServerCapabilities returns the capabilities of a server handling the
+// given methods. Features without options are announced with true, others
+// with options derived from the methods and opts, which may be nil.
+func NewServerCapabilities(methods []string, opts *CapabilityOptions) (*ServerCapabilities, error) {
+	if opts == nil {
+		opts = &CapabilityOptions{}
+	}
+	handles := make(map[string]bool)
+	for _, m := range methods {
+		handles[m] = true
+	}
+
+	caps := make(map[string]interface{})
+	set := func(path string, v interface{}) {
+		m := caps
+		keys := strings.Split(path, ".")
+		for _, k := range keys[:len(keys)-1] {
+			next, ok := m[k].(map[string]interface{})
+			if !ok {
+				next = make(map[string]interface{})
+				m[k] = next
+			}
+			m = next
+		}
+		m[keys[len(keys)-1]] = v
+	}
+
+	if opts.PositionEncoding != "" {
+		set("positionEncoding", opts.PositionEncoding)
+	}
+
+	if sync := textDocumentSync(handles, opts); sync != nil {
+		set("textDocumentSync", sync)
+	}
+
+	for method, name := range providers {
+		if !handles[method] {
+			continue
+		}
+		var v interface{} = true
+		if resolve, ok := resolvers[method]; ok {
+			v = map[string]interface{}{"resolveProvider": handles[resolve]}
+		}
+		switch name {
+		case "completionProvider":
+			v.(map[string]interface{})["triggerCharacters"] = opts.CompletionTriggerCharacters
+		case "signatureHelpProvider":
+			v = map[string]interface{}{
+				"triggerCharacters":   opts.SignatureHelpTriggerCharacters,
+				"retriggerCharacters": opts.SignatureHelpRetriggerCharacters,
+			}
+		case "documentOnTypeFormattingProvider":
+			if len(opts.OnTypeFormattingTriggerCharacters) == 0 {
+				continue
+			}
+			v = map[string]interface{}{
+				"firstTriggerCharacter": opts.OnTypeFormattingTriggerCharacters[0],
+				"moreTriggerCharacter":  opts.OnTypeFormattingTriggerCharacters[1:],
+			}
+		case "renameProvider":
+			v = map[string]interface{}{"prepareProvider": handles["textDocument/prepareRename"]}
+		case "executeCommandProvider":
+			v = map[string]interface{}{"commands": nonNil(opts.Commands)}
+		case "semanticTokensProvider":
+			full := interface{}(false)
+			if handles["textDocument/semanticTokens/full"] {
+				full = map[string]interface{}{"delta": handles["textDocument/semanticTokens/full/delta"]}
+			}
+			v = map[string]interface{}{
+				"legend": map[string]interface{}{
+					"tokenTypes":     nonNil(opts.SemanticTokenTypes),
+					"tokenModifiers": nonNil(opts.SemanticTokenModifiers),
+				},
+				"full":  full,
+				"range": handles["textDocument/semanticTokens/range"],
+			}
+		case "diagnosticProvider":
+			v = map[string]interface{}{
+				"interFileDependencies": opts.InterFileDependencies,
+				"workspaceDiagnostics":  handles["workspace/diagnostic"],
+			}
+		}
+		set(name, v)
+	}
+
+	if handles["workspace/didChangeWorkspaceFolders"] {
+		set("workspace.workspaceFolders", map[string]interface{}{
+			"supported":           true,
+			"changeNotifications": true,
+		})
+	}
+	for method, name := range fileOperations {
+		if handles[method] {
+			filters := opts.FileOperationFilters
+			if filters == nil {
+				filters = []FileOperationFilter{}
+			}
+			set("workspace.fileOperations."+name, map[string]interface{}{"filters": filters})
+		}
+	}
+	if handles["notebookDocument/didOpen"] {
+		// All notebooks, unless overridden.
+		set("notebookDocumentSync", map[string]interface{}{
+			"notebookSelector": []interface{}{
+				map[string]interface{}{"notebook": map[string]interface{}{"pattern": "**"}},
+			},
+			"save": handles["notebookDocument/didSave"],
+		})
+	}
+
+	for path, v := range opts.Overrides {
+		set(path, v)
+	}
+
+	b, err := json.Marshal(caps)
+	if err != nil {
+		return nil, err
+	}
+	var sc ServerCapabilities
+	if err := json.Unmarshal(b, &sc); err != nil {
+		return nil, err
+	}
+	return &sc, nil
+}
+
+// textDocumentSync returns the textDocumentSync capability, or nil if the
+// server handles no synchronization notifications.
+func textDocumentSync(handles map[string]bool, opts *CapabilityOptions) interface{} {
+	openClose := handles["textDocument/didOpen"] || handles["textDocument/didClose"]
+	change := TextDocumentSyncKindNone
+	if handles["textDocument/didChange"] {
+		change = opts.SyncKind
+		if change == TextDocumentSyncKindNone {
+			change = TextDocumentSyncKindIncremental
+		}
+	}
+	sync := map[string]interface{}{
+		"openClose":         openClose,
+		"change":            change,
+		"willSave":          handles["textDocument/willSave"],
+		"willSaveWaitUntil": handles["textDocument/willSaveWaitUntil"],
+	}
+	if handles["textDocument/didSave"] {
+		sync["save"] = map[string]interface{}{"includeText": opts.IncludeText}
+	}
+	for _, v := range sync {
+		if v != false && v != TextDocumentSyncKindNone {
+			return sync
+		}
+	}
+	return nil
+}
+
+func nonNil(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+	return s
+}
+
+// clientCapabilities maps methods to the path of their client
+// capabilities, if it does not follow from the method name.
+var clientCapabilities = map[string]string{
+	"textDocument/didOpen":                   "textDocument.synchronization",
+	"textDocument/didChange":                 "textDocument.synchronization",
+	"textDocument/didClose":                  "textDocument.synchronization",
+	"textDocument/didSave":                   "textDocument.synchronization",
+	"textDocument/willSave":                  "textDocument.synchronization",
+	"textDocument/willSaveWaitUntil":         "textDocument.synchronization",
+	"textDocument/documentColor":             "textDocument.colorProvider",
+	"textDocument/prepareCallHierarchy":      "textDocument.callHierarchy",
+	"textDocument/prepareTypeHierarchy":      "textDocument.typeHierarchy",
+	"textDocument/semanticTokens/full":       "textDocument.semanticTokens",
+	"textDocument/semanticTokens/full/delta": "textDocument.semanticTokens",
+	"textDocument/semanticTokens/range":      "textDocument.semanticTokens",
+	"notebookDocument/didOpen":               "notebookDocument.synchronization",
+	"notebookDocument/didChange":             "notebookDocument.synchronization",
+	"notebookDocument/didSave":               "notebookDocument.synchronization",
+	"notebookDocument/didClose":              "notebookDocument.synchronization",
+	"notebookDocument/sync":                  "notebookDocument.synchronization",
+	"workspace/didCreateFiles":               "workspace.fileOperations",
+	"workspace/willCreateFiles":              "workspace.fileOperations",
+	"workspace/didRenameFiles":               "workspace.fileOperations",
+	"workspace/willRenameFiles":              "workspace.fileOperations",
+	"workspace/didDeleteFiles":               "workspace.fileOperations",
+	"workspace/willDeleteFiles":              "workspace.fileOperations",
+}
+
+// ClientFeatures is a queryable view of the ClientCapabilities sent by the
+// client with the initialize request.
+type ClientFeatures struct {
+	caps map[string]interface{}
+}
+
+// NewClientFeatures returns a view of c, which may be nil.
+func NewClientFeatures(c *ClientCapabilities) (*ClientFeatures, error) {
+	f := &ClientFeatures{}
+	if c == nil {
+		return f, nil
+	}
+	b, err := json.Marshal(c)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(b, &f.caps); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// Lookup returns the value of the capability at a dotted path of JSON
+// names, like "textDocument.hover.contentFormat".
+func (f *ClientFeatures) Lookup(path string) (interface{}, bool) {
+	var v interface{} = f.caps
+	for _, k := range strings.Split(path, ".") {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		if v, ok = m[k]; !ok {
+			return nil, false
+		}
+	}
+	return v, v != nil
+}
+
+// Has reports whether the client announced the capability at path.
+func (f *ClientFeatures) Has(path string) bool {
+	_, ok := f.Lookup(path)
+	return ok
+}
+
+// Bool returns the boolean capability at path, or false if the client
+// did not announce it.
+func (f *ClientFeatures) Bool(path string) bool {
+	v, _ := f.Lookup(path)
+	b, _ := v.(bool)
+	return b
+}
+
+// Strings returns the string list capability at path.
+func (f *ClientFeatures) Strings(path string) []string {
+	v, _ := f.Lookup(path)
+	l, _ := v.([]interface{})
+	var s []string
+	for _, e := range l {
+		if e, ok := e.(string); ok {
+			s = append(s, e)
+		}
+	}
+	return s
+}
+
+// Contains reports whether the string list capability at path contains s.
+func (f *ClientFeatures) Contains(path string, s string) bool {
+	for _, e := range f.Strings(path) {
+		if e == s {
+			return true
+		}
+	}
+	return false
+}
+
+// capabilityPath returns the path of the client capabilities of method.
+func capabilityPath(method string) string {
+	if path, ok := clientCapabilities[method]; ok {
+		return path
+	}
+	return strings.ReplaceAll(method, "/", ".")
+}
+
+// Supports reports whether the client announced capabilities for method.
+// Methods without capabilities are assumed to be supported.
+func (f *ClientFeatures) Supports(method string) bool {
+	path := capabilityPath(method)
+	if !strings.Contains(path, ".") {
+		return true
+	}
+	return f.Has(path)
+}
+
+// DynamicRegistration reports whether the client supports dynamic
+// registration of method.
+func (f *ClientFeatures) DynamicRegistration(method string) bool {
+	return f.Bool(capabilityPath(method) + ".dynamicRegistration")
+}
+
+// HoverMarkup reports whether the client renders hover content of the
+// given kind.
+func (f *ClientFeatures) HoverMarkup(kind MarkupKind) bool {
+	return f.Contains("textDocument.hover.contentFormat", string(kind))
+}
+
+// Snippets reports whether the client supports snippets in completion
+// items.
+func (f *ClientFeatures) Snippets() bool {
+	return f.Bool("textDocument.completion.completionItem.snippetSupport")
+}
+
+// PullDiagnostics reports whether the client requests diagnostics with
+// textDocument/diagnostic.
+func (f *ClientFeatures) PullDiagnostics() bool {
+	return f.Has("textDocument.diagnostic")
+}
+
+// WorkDoneProgress reports whether the client supports server initiated
+// progress.
+func (f *ClientFeatures) WorkDoneProgress() bool {
+	return f.Bool("window.workDoneProgress")
+}
+
+// PositionEncodings returns the position encodings supported by the
+// client in order of preference. Clients not announcing encodings support
+// UTF-16 only.
+func (f *ClientFeatures) PositionEncodings() []PositionEncodingKind {
+	var kinds []PositionEncodingKind
+	for _, s := range f.Strings("general.positionEncodings") {
+		kinds = append(kinds, PositionEncodingKind(s))
+	}
+	if len(kinds) == 0 {
+		kinds = []PositionEncodingKind{PositionEncodingKindUTF16}
+	}
+	return kinds
+}
@@ -0,0 +1,207 @@
+package lsp
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestNewServerCapabilities(t *testing.T) {
+	tests := []struct {
+		methods []string
+		opts    *CapabilityOptions
+		want    string
+	}{
+		{nil, nil, `{}`},
+		{[]string{"textDocument/hover"}, nil, `{"hoverProvider":true}`},
+		{
+			[]string{"textDocument/completion", "completionItem/resolve"},
+			&CapabilityOptions{CompletionTriggerCharacters: []string{"."}},
+			`{"completionProvider":{"triggerCharacters":["."],"resolveProvider":true}}`,
+		},
+		{
+			[]string{"textDocument/codeAction", "textDocument/codeLens", "codeLens/resolve"},
+			nil,
+			`{"codeActionProvider":{"resolveProvider":false},"codeLensProvider":{"resolveProvider":true}}`,
+		},
+		{
+			[]string{"textDocument/signatureHelp"},
+			&CapabilityOptions{SignatureHelpTriggerCharacters: []string{"("}, SignatureHelpRetriggerCharacters: []string{","}},
+			`{"signatureHelpProvider":{"triggerCharacters":["("],"retriggerCharacters":[","]}}`,
+		},
+
+		// On type formatting requires a trigger character.
+		{[]string{"textDocument/onTypeFormatting"}, nil, `{}`},
+		{
+			[]string{"textDocument/onTypeFormatting"},
+			&CapabilityOptions{OnTypeFormattingTriggerCharacters: []string{"}", ";"}},
+			`{"documentOnTypeFormattingProvider":{"firstTriggerCharacter":"}","moreTriggerCharacter":[";"]}}`,
+		},
+
+		{[]string{"textDocument/rename", "textDocument/prepareRename"}, nil, `{"renameProvider":{"prepareProvider":true}}`},
+		{[]string{"workspace/executeCommand"}, nil, `{"executeCommandProvider":{"commands":[]}}`},
+		{
+			[]string{"textDocument/semanticTokens/full"},
+			&CapabilityOptions{SemanticTokenTypes: []string{"keyword"}},
+			`{"semanticTokensProvider":{"legend":{"tokenTypes":["keyword"],"tokenModifiers":[]},"range":false,"full":{"delta":false}}}`,
+		},
+		{
+			[]string{"textDocument/semanticTokens/full", "textDocument/semanticTokens/full/delta", "textDocument/semanticTokens/range"},
+			nil,
+			`{"semanticTokensProvider":{"legend":{"tokenTypes":[],"tokenModifiers":[]},"range":true,"full":{"delta":true}}}`,
+		},
+		{
+			[]string{"textDocument/semanticTokens/range"},
+			nil,
+			`{"semanticTokensProvider":{"legend":{"tokenTypes":[],"tokenModifiers":[]},"range":true,"full":false}}`,
+		},
+		{
+			[]string{"textDocument/diagnostic", "workspace/diagnostic"},
+			&CapabilityOptions{InterFileDependencies: true},
+			`{"diagnosticProvider":{"interFileDependencies":true,"workspaceDiagnostics":true}}`,
+		},
+
+		// Text document synchronization.
+		{
+			[]string{"textDocument/didOpen", "textDocument/didClose", "textDocument/didChange", "textDocument/didSave"},
+			&CapabilityOptions{IncludeText: true},
+			`{"textDocumentSync":{"openClose":true,"change":2,"willSave":false,"willSaveWaitUntil":false,"save":{"includeText":true}}}`,
+		},
+		{
+			[]string{"textDocument/didChange"},
+			&CapabilityOptions{SyncKind: TextDocumentSyncKindFull},
+			`{"textDocumentSync":{"openClose":false,"change":1,"willSave":false,"willSaveWaitUntil":false}}`,
+		},
+		{
+			[]string{"textDocument/willSaveWaitUntil"},
+			nil,
+			`{"textDocumentSync":{"openClose":false,"change":0,"willSave":false,"willSaveWaitUntil":true}}`,
+		},
+
+		{
+			[]string{"workspace/didChangeWorkspaceFolders", "workspace/willRenameFiles"},
+			nil,
+			`{"workspace":{"workspaceFolders":{"supported":true,"changeNotifications":true},"fileOperations":{"willRename":{"filters":[]}}}}`,
+		},
+		{
+			[]string{"notebookDocument/didOpen", "notebookDocument/didSave"},
+			nil,
+			`{"notebookDocumentSync":{"notebookSelector":[{"notebook":{"pattern":"**"}}],"save":true}}`,
+		},
+		{
+			[]string{"textDocument/hover", "textDocument/definition"},
+			&CapabilityOptions{
+				PositionEncoding: PositionEncodingKindUTF8,
+				Overrides: map[string]interface{}{
+					"hoverProvider":                      false,
+					"workspace.fileOperations.didCreate": map[string]interface{}{"filters": []interface{}{}},
+				},
+			},
+			`{"positionEncoding":"utf-8","hoverProvider":false,"definitionProvider":true,"workspace":{"fileOperations":{"didCreate":{"filters":[]}}}}`,
+		},
+	}
+	for _, tt := range tests {
+		caps, err := NewServerCapabilities(tt.methods, tt.opts)
+		if err != nil {
+			t.Errorf("%v: %v", tt.methods, err)
+			continue
+		}
+		b, err := json.Marshal(caps)
+		if err != nil {
+			t.Errorf("%v: %v", tt.methods, err)
+			continue
+		}
+		if string(b) != tt.want {
+			t.Errorf("%v:\ngot  %s\nwant %s", tt.methods, b, tt.want)
+		}
+	}
+}
+
+func TestNewServerCapabilitiesInvalidOverride(t *testing.T) {
+	_, err := NewServerCapabilities(nil, &CapabilityOptions{Overrides: map[string]interface{}{"hoverProvider": "yes"}})
+	if err == nil {
+		t.Error("invalid override accepted")
+	}
+}
+
+func TestClientFeatures(t *testing.T) {
+	f, err := NewClientFeatures(&ClientCapabilities{
+		TextDocument: &TextDocumentClientCapabilities{
+			Hover: &HoverClientCapabilities{
+				DynamicRegistration: ptr(true),
+				ContentFormat:       []MarkupKind{MarkupKindMarkdown},
+			},
+			Completion: &CompletionClientCapabilities{
+				CompletionItem: &CompletionClientCapabilitiesCompletionItem{SnippetSupport: ptr(true)},
+			},
+			Synchronization: &TextDocumentSyncClientCapabilities{DynamicRegistration: ptr(false)},
+			Diagnostic:      &DiagnosticClientCapabilities{},
+		},
+		Window:  &WindowClientCapabilities{WorkDoneProgress: ptr(true)},
+		General: &GeneralClientCapabilities{PositionEncodings: []PositionEncodingKind{PositionEncodingKindUTF8, PositionEncodingKindUTF16}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !f.Has("textDocument.hover") || f.Has("textDocument.definition") || f.Has("textDocument.hover.contentFormat.markdown") {
+		t.Error("Has")
+	}
+	if !f.Bool("textDocument.hover.dynamicRegistration") || f.Bool("textDocument.synchronization.dynamicRegistration") || f.Bool("textDocument.hover") {
+		t.Error("Bool")
+	}
+	if got := f.Strings("textDocument.hover.contentFormat"); !reflect.DeepEqual(got, []string{"markdown"}) {
+		t.Errorf("Strings returned %v", got)
+	}
+	if !f.Contains("textDocument.hover.contentFormat", "markdown") || f.Contains("textDocument.hover.contentFormat", "plaintext") {
+		t.Error("Contains")
+	}
+
+	methods := []struct {
+		method   string
+		supports bool
+		dynamic  bool
+	}{
+		{"textDocument/hover", true, true},
+		{"textDocument/definition", false, false},
+		{"textDocument/didOpen", true, false},
+		{"textDocument/diagnostic", true, false},
+		{"notebookDocument/didOpen", false, false},
+		{"initialize", true, false},
+		{"shutdown", true, false},
+	}
+	for _, m := range methods {
+		if got := f.Supports(m.method); got != m.supports {
+			t.Errorf("Supports(%s) = %v, want %v", m.method, got, m.supports)
+		}
+		if got := f.DynamicRegistration(m.method); got != m.dynamic {
+			t.Errorf("DynamicRegistration(%s) = %v, want %v", m.method, got, m.dynamic)
+		}
+	}
+
+	if !f.HoverMarkup(MarkupKindMarkdown) || f.HoverMarkup(MarkupKindPlainText) {
+		t.Error("HoverMarkup")
+	}
+	if !f.Snippets() || !f.PullDiagnostics() || !f.WorkDoneProgress() {
+		t.Error("Snippets, PullDiagnostics or WorkDoneProgress")
+	}
+	if got, want := f.PositionEncodings(), []PositionEncodingKind{PositionEncodingKindUTF8, PositionEncodingKindUTF16}; !reflect.DeepEqual(got, want) {
+		t.Errorf("PositionEncodings returned %v, want %v", got, want)
+	}
+}
+
+func TestClientFeaturesNil(t *testing.T) {
+	f, err := NewClientFeatures(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f.Has("textDocument") || f.Snippets() || f.PullDiagnostics() || f.WorkDoneProgress() || f.DynamicRegistration("textDocument/hover") {
+		t.Error("features without capabilities")
+	}
+	if f.Supports("textDocument/hover") || !f.Supports("initialize") {
+		t.Error("Supports")
+	}
+	if got, want := f.PositionEncodings(), []PositionEncodingKind{PositionEncodingKindUTF16}; !reflect.DeepEqual(got, want) {
+		t.Errorf("PositionEncodings returned %v, want %v", got, want)
+	}
+}
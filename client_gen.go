@@ -0,0 +1,195 @@
+package lsp
+
+import "context"
+
+// Client is the interface of language clients as seen by a server. It has a
+// method for every request and notification sent from the server to the
+// client.
+type Client interface {
+	// The `workspace/workspaceFolders` is sent from the server to the client to fetch the open workspace folders.
+	WorkspaceFolders(ctx context.Context) ([]WorkspaceFolder, error)
+
+	// The 'workspace/configuration' request is sent from the server to the client to fetch a certain
+	// configuration setting.
+	//
+	// This pull model replaces the old push model were the client signaled configuration change via an
+	// event. If the server still needs to react to configuration changes (since the server caches the
+	// result of `workspace/configuration` requests) the server should register for an empty configuration
+	// change event and empty the cache if such an event is received.
+	Configuration(ctx context.Context, params *And_ConfigurationParams_PartialResultParams) ([]interface{}, error)
+
+	// The `window/workDoneProgress/create` request is sent from the server to the client to initiate progress
+	// reporting from the server.
+	WorkDoneProgressCreate(ctx context.Context, params *WorkDoneProgressCreateParams) error
+
+	// @since 3.16.0
+	SemanticTokensRefresh(ctx context.Context) error
+
+	// A request to show a document. This request might open an
+	// external program depending on the value of the URI to open.
+	// For example a request to open `https://code.visualstudio.com/`
+	// will very likely open the URI in a WEB browser.
+	//
+	// @since 3.16.0
+	ShowDocument(ctx context.Context, params *ShowDocumentParams) (*ShowDocumentResult, error)
+
+	// @since 3.17.0
+	InlineValueRefresh(ctx context.Context) error
+
+	// @since 3.17.0
+	InlayHintRefresh(ctx context.Context) error
+
+	// The diagnostic refresh request definition.
+	//
+	// @since 3.17.0
+	DiagnosticRefresh(ctx context.Context) error
+
+	// The `client/registerCapability` request is sent from the server to the client to register a new capability
+	// handler on the client side.
+	RegisterCapability(ctx context.Context, params *RegistrationParams) error
+
+	// The `client/unregisterCapability` request is sent from the server to the client to unregister a previously registered capability
+	// handler on the client side.
+	UnregisterCapability(ctx context.Context, params *UnregistrationParams) error
+
+	// The show message request is sent from the server to the client to show a message
+	// and a set of options actions to the user.
+	ShowMessageRequest(ctx context.Context, params *ShowMessageRequestParams) (*MessageActionItem, error)
+
+	// A request to refresh all code actions
+	//
+	// @since 3.16.0
+	CodeLensRefresh(ctx context.Context) error
+
+	// A request sent from the server to the client to modified certain resources.
+	ApplyEdit(ctx context.Context, params *ApplyWorkspaceEditParams) (*ApplyWorkspaceEditResult, error)
+
+	// The show message notification is sent from a server to a client to ask
+	// the client to display a particular message in the user interface.
+	ShowMessage(ctx context.Context, params *ShowMessageParams) error
+
+	// The log message notification is sent from the server to the client to ask
+	// the client to log a particular message.
+	LogMessage(ctx context.Context, params *LogMessageParams) error
+
+	// The telemetry event notification is sent from the server to the client to ask
+	// the client to log telemetry data.
+	Event(ctx context.Context, params interface{}) error
+
+	// Diagnostics notification are sent from the server to the client to signal
+	// results of validation runs.
+	PublishDiagnostics(ctx context.Context, params *PublishDiagnosticsParams) error
+	LogTrace(ctx context.Context, params *LogTraceParams) error
+	CancelRequest(ctx context.Context, params *CancelParams) error
+	Progress(ctx context.Context, params *ProgressParams) error
+}
+
+// A Caller sends requests and notifications to the other end of a
+// connection.
+type Caller interface {
+	// Call sends a request and waits for the response. The result of the
+	// response is decoded into result, unless result is nil.
+	Call(ctx context.Context, method string, params interface{}, result interface{}) error
+
+	// Notify sends a notification.
+	Notify(ctx context.Context, method string, params interface{}) error
+}
+
+// NewClient returns a Client sending its requests and notifications to c.
+func NewClient(c Caller) Client {
+	return &client{c}
+}
+
+type client struct {
+	conn Caller
+}
+
+func (c *client) WorkspaceFolders(ctx context.Context) ([]WorkspaceFolder, error) {
+	var result []WorkspaceFolder
+	err := c.conn.Call(ctx, MethodWorkspaceWorkspaceFolders, nil, &result)
+	return result, err
+}
+
+func (c *client) Configuration(ctx context.Context, params *And_ConfigurationParams_PartialResultParams) ([]interface{}, error) {
+	var result []interface{}
+	err := c.conn.Call(ctx, MethodWorkspaceConfiguration, params, &result)
+	return result, err
+}
+
+func (c *client) WorkDoneProgressCreate(ctx context.Context, params *WorkDoneProgressCreateParams) error {
+	return c.conn.Call(ctx, MethodWindowWorkDoneProgressCreate, params, nil)
+}
+
+func (c *client) SemanticTokensRefresh(ctx context.Context) error {
+	return c.conn.Call(ctx, MethodWorkspaceSemanticTokensRefresh, nil, nil)
+}
+
+func (c *client) ShowDocument(ctx context.Context, params *ShowDocumentParams) (*ShowDocumentResult, error) {
+	var result *ShowDocumentResult
+	err := c.conn.Call(ctx, MethodWindowShowDocument, params, &result)
+	return result, err
+}
+
+func (c *client) InlineValueRefresh(ctx context.Context) error {
+	return c.conn.Call(ctx, MethodWorkspaceInlineValueRefresh, nil, nil)
+}
+
+func (c *client) InlayHintRefresh(ctx context.Context) error {
+	return c.conn.Call(ctx, MethodWorkspaceInlayHintRefresh, nil, nil)
+}
+
+func (c *client) DiagnosticRefresh(ctx context.Context) error {
+	return c.conn.Call(ctx, MethodWorkspaceDiagnosticRefresh, nil, nil)
+}
+
+func (c *client) RegisterCapability(ctx context.Context, params *RegistrationParams) error {
+	return c.conn.Call(ctx, MethodClientRegisterCapability, params, nil)
+}
+
+func (c *client) UnregisterCapability(ctx context.Context, params *UnregistrationParams) error {
+	return c.conn.Call(ctx, MethodClientUnregisterCapability, params, nil)
+}
+
+func (c *client) ShowMessageRequest(ctx context.Context, params *ShowMessageRequestParams) (*MessageActionItem, error) {
+	var result *MessageActionItem
+	err := c.conn.Call(ctx, MethodWindowShowMessageRequest, params, &result)
+	return result, err
+}
+
+func (c *client) CodeLensRefresh(ctx context.Context) error {
+	return c.conn.Call(ctx, MethodWorkspaceCodeLensRefresh, nil, nil)
+}
+
+func (c *client) ApplyEdit(ctx context.Context, params *ApplyWorkspaceEditParams) (*ApplyWorkspaceEditResult, error) {
+	var result *ApplyWorkspaceEditResult
+	err := c.conn.Call(ctx, MethodWorkspaceApplyEdit, params, &result)
+	return result, err
+}
+
+func (c *client) ShowMessage(ctx context.Context, params *ShowMessageParams) error {
+	return c.conn.Notify(ctx, MethodWindowShowMessage, params)
+}
+
+func (c *client) LogMessage(ctx context.Context, params *LogMessageParams) error {
+	return c.conn.Notify(ctx, MethodWindowLogMessage, params)
+}
+
+func (c *client) Event(ctx context.Context, params interface{}) error {
+	return c.conn.Notify(ctx, MethodTelemetryEvent, params)
+}
+
+func (c *client) PublishDiagnostics(ctx context.Context, params *PublishDiagnosticsParams) error {
+	return c.conn.Notify(ctx, MethodTextDocumentPublishDiagnostics, params)
+}
+
+func (c *client) LogTrace(ctx context.Context, params *LogTraceParams) error {
+	return c.conn.Notify(ctx, MethodLogTrace, params)
+}
+
+func (c *client) CancelRequest(ctx context.Context, params *CancelParams) error {
+	return c.conn.Notify(ctx, MethodCancelRequest, params)
+}
+
+func (c *client) Progress(ctx context.Context, params *ProgressParams) error {
+	return c.conn.Notify(ctx, MethodProgress, params)
+}
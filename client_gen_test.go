@@ -0,0 +1,70 @@
+package lsp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestNewClient(t *testing.T) {
+	logged := make(chan string, 1)
+	handler := HandlerFunc(func(ctx context.Context, method string, params json.RawMessage) (json.RawMessage, error) {
+		switch method {
+		case MethodWindowShowMessageRequest:
+			var p ShowMessageRequestParams
+			if err := json.Unmarshal(params, &p); err != nil {
+				return nil, err
+			}
+			if len(p.Actions) == 0 {
+				return json.RawMessage("null"), nil
+			}
+			return json.Marshal(p.Actions[0])
+		case MethodWorkspaceWorkspaceFolders:
+			return json.Marshal([]WorkspaceFolder{{Uri: "file:///a", Name: "a"}})
+		case MethodClientRegisterCapability:
+			return json.RawMessage("null"), nil
+		case MethodWindowLogMessage:
+			var p LogMessageParams
+			if err := json.Unmarshal(params, &p); err != nil {
+				return nil, err
+			}
+			logged <- p.Message
+			return nil, nil
+		}
+		return nil, errors.New("failed")
+	})
+	s, _ := connect(t, echo, handler)
+	client := NewClient(s)
+	ctx := context.Background()
+
+	item, err := client.ShowMessageRequest(ctx, &ShowMessageRequestParams{Message: "m", Actions: []MessageActionItem{{Title: "ok"}}})
+	if err != nil || item == nil || item.Title != "ok" {
+		t.Errorf("showMessageRequest: got %+v, %v", item, err)
+	}
+	item, err = client.ShowMessageRequest(ctx, &ShowMessageRequestParams{Message: "m"})
+	if err != nil || item != nil {
+		t.Errorf("showMessageRequest without actions: got %+v, %v", item, err)
+	}
+
+	folders, err := client.WorkspaceFolders(ctx)
+	if want := []WorkspaceFolder{{Uri: "file:///a", Name: "a"}}; err != nil || !reflect.DeepEqual(folders, want) {
+		t.Errorf("workspaceFolders: got %+v, %v", folders, err)
+	}
+
+	if err := client.RegisterCapability(ctx, &RegistrationParams{}); err != nil {
+		t.Errorf("registerCapability: %v", err)
+	}
+	var e *Error
+	if err := client.UnregisterCapability(ctx, &UnregistrationParams{}); !errors.As(err, &e) || e.Message != "failed" {
+		t.Errorf("unregisterCapability: got %v", err)
+	}
+
+	if err := client.LogMessage(ctx, &LogMessageParams{Type: MessageTypeInfo, Message: "hello"}); err != nil {
+		t.Fatal(err)
+	}
+	if m := <-logged; m != "hello" {
+		t.Errorf("logMessage: got %q", m)
+	}
+}
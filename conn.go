@@ -0,0 +1,465 @@
+package lsp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"sync"
+)
+
+// ErrClosed is returned by calls on a closed connection.
+var ErrClosed = errors.New("connection closed")
+
+// A Stream reads and writes encoded JSON-RPC messages.
+type Stream interface {
+	// Read returns the next message. It returns io.EOF when the stream
+	// ended.
+	Read() ([]byte, error)
+
+	// Write writes a message.
+	Write(msg []byte) error
+
+	// Close closes the stream. A blocked Read returns an error.
+	Close() error
+}
+
+// A Handler responds to requests and notifications. The result of
+// notifications is ignored.
+type Handler interface {
+	Handle(ctx context.Context, method string, params json.RawMessage) (result json.RawMessage, err error)
+}
+
+// The HandlerFunc type is an adapter to allow the use of ordinary functions
+// as handlers.
+type HandlerFunc func(ctx context.Context, method string, params json.RawMessage) (json.RawMessage, error)
+
+// Handle calls f(ctx, method, params).
+func (f HandlerFunc) Handle(ctx context.Context, method string, params json.RawMessage) (json.RawMessage, error) {
+	return f(ctx, method, params)
+}
+
+// ServerHandler returns a Handler dispatching messages to server.
+func ServerHandler(server Server) Handler {
+	return HandlerFunc(func(ctx context.Context, method string, params json.RawMessage) (json.RawMessage, error) {
+		return DispatchServer(ctx, server, method, params)
+	})
+}
+
+// A Conn is a JSON-RPC connection. It sends requests and notifications to
+// the remote end and dispatches incoming messages to a Handler.
+//
+// Notifications are handled one after another in the order they arrive,
+// by a goroutine other than the one reading messages. So notification
+// handlers may call the remote end and wait for the response. Requests are
+// handled concurrently, but a request is not handled before all
+// notifications received before it. So a request sees the effects of a
+// preceding textDocument/didChange, for example.
+//
+// The connection handles $/cancelRequest notifications itself by
+// canceling the context of the handler of the request. Requests whose
+// handlers fail after cancellation are answered with the error code
+// LSPErrorCodesRequestCancelled. Likewise, if the context of a Call is
+// canceled, the remote end is notified by $/cancelRequest.
+type Conn struct {
+	stream Stream
+
+	writeMu sync.Mutex
+
+	mu      sync.Mutex
+	seq     int64
+	pending map[ID]chan *Response
+	running map[ID]context.CancelFunc
+	notes   []note        // queued for the handler
+	busy    bool          // a notification is being handled
+	queued  chan struct{} // signals queued notifications
+	closed  bool
+
+	handlers sync.WaitGroup
+	done     chan struct{}
+}
+
+// NewConn returns a connection sending and receiving messages over s. Run
+// must be called once to receive messages.
+func NewConn(s Stream) *Conn {
+	return &Conn{
+		stream:  s,
+		pending: make(map[ID]chan *Response),
+		running: make(map[ID]context.CancelFunc),
+		queued:  make(chan struct{}, 1),
+		done:    make(chan struct{}),
+	}
+}
+
+// A note is a queued notification or a barrier. A barrier is closed when
+// all notifications queued before it have been handled.
+type note struct {
+	n       *Notification
+	barrier chan struct{}
+}
+
+type idKey struct{}
+
+// RequestID returns the ID of the request handled with ctx. It returns
+// false for notifications.
+func RequestID(ctx context.Context) (ID, bool) {
+	id, ok := ctx.Value(idKey{}).(ID)
+	return id, ok
+}
+
+// Run reads messages and passes requests and notifications to h, until the
+// stream ends, the connection is closed or ctx is done. Messages exceeding
+// the size limit of the stream are answered with an error. Before returning,
+// Run cancels the context of all running handlers and waits for them to
+// return. Run returns nil, if the stream ended or the connection was
+// closed. Notifications received before are still handled.
+func (c *Conn) Run(ctx context.Context, h Handler) error {
+	ctx, cancel := context.WithCancel(ctx)
+	stop := make(chan struct{})
+	c.handlers.Add(1)
+	go func() {
+		defer c.handlers.Done()
+		c.notify(ctx, h, stop)
+	}()
+	defer func() {
+		cancel()
+		close(stop)
+		c.handlers.Wait()
+		close(c.done)
+	}()
+
+	go func() {
+		<-ctx.Done()
+		c.Close()
+	}()
+
+	for {
+		b, err := c.stream.Read()
+		if errors.Is(err, ErrMessageTooLarge) {
+			c.send(&Response{Error: errorf(int32(ErrorCodesInvalidRequest), "%v", err)})
+			continue
+		}
+		if err != nil {
+			closed := c.isClosed()
+			c.Close()
+			switch {
+			case ctx.Err() != nil:
+				return ctx.Err()
+			case closed, errors.Is(err, io.EOF):
+				return nil
+			}
+			return err
+		}
+		c.receive(ctx, h, b)
+	}
+}
+
+// Done returns a channel, which is closed when Run returned.
+func (c *Conn) Done() <-chan struct{} {
+	return c.done
+}
+
+// Close closes the stream. Pending calls fail with ErrClosed.
+func (c *Conn) Close() error {
+	c.mu.Lock()
+	if c.closed {
+		c.mu.Unlock()
+		return nil
+	}
+	c.closed = true
+	for id, ch := range c.pending {
+		close(ch)
+		delete(c.pending, id)
+	}
+	c.mu.Unlock()
+	return c.stream.Close()
+}
+
+func (c *Conn) isClosed() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.closed
+}
+
+// Call sends a request and waits for the response. The result is decoded
+// into result, unless it is nil. Error responses are returned as *Error.
+func (c *Conn) Call(ctx context.Context, method string, params interface{}, result interface{}) error {
+	p, err := encodeParams(params)
+	if err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	if c.closed {
+		c.mu.Unlock()
+		return ErrClosed
+	}
+	c.seq++
+	id := NumberID(c.seq)
+	ch := make(chan *Response, 1)
+	c.pending[id] = ch
+	c.mu.Unlock()
+
+	if err := c.send(&Request{ID: id, Method: method, Params: p}); err != nil {
+		c.forget(id)
+		return err
+	}
+
+	select {
+	case r, ok := <-ch:
+		if !ok {
+			return ErrClosed
+		}
+		if r.Error != nil {
+			return r.Error
+		}
+		if result == nil {
+			return nil
+		}
+		return json.Unmarshal(r.Result, result)
+	case <-ctx.Done():
+		c.forget(id)
+		c.send(&Notification{Method: MethodCancelRequest, Params: mustEncode(cancelParams{id})})
+		return ctx.Err()
+	}
+}
+
+// cancelParams are the parameters of $/cancelRequest.
+type cancelParams struct {
+	ID ID `json:"id"`
+}
+
+// mustEncode encodes v, which must not fail.
+func mustEncode(v interface{}) json.RawMessage {
+	b, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// Notify sends a notification.
+func (c *Conn) Notify(ctx context.Context, method string, params interface{}) error {
+	p, err := encodeParams(params)
+	if err != nil {
+		return err
+	}
+	if c.isClosed() {
+		return ErrClosed
+	}
+	return c.send(&Notification{Method: method, Params: p})
+}
+
+// forget removes a pending call.
+func (c *Conn) forget(id ID) {
+	c.mu.Lock()
+	delete(c.pending, id)
+	c.mu.Unlock()
+}
+
+// encodeParams encodes params. Nil params are omitted.
+func encodeParams(params interface{}) (json.RawMessage, error) {
+	if params == nil {
+		return nil, nil
+	}
+	b, err := json.Marshal(params)
+	if err != nil || isNull(b) {
+		return nil, err
+	}
+	return b, nil
+}
+
+// send writes a message or a batch of messages.
+func (c *Conn) send(m interface{}) error {
+	b, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+	return c.stream.Write(b)
+}
+
+// receive handles a message or a batch of messages read from the stream.
+func (c *Conn) receive(ctx context.Context, h Handler, b []byte) {
+	msgs, batch, err := splitBatch(b)
+	if err != nil {
+		c.send(&Response{Error: toError(err)})
+		return
+	}
+
+	var (
+		mu        sync.Mutex
+		responses []*Response
+		requests  sync.WaitGroup
+	)
+	reply := func(r *Response) {
+		if !batch {
+			c.send(r)
+			return
+		}
+		mu.Lock()
+		responses = append(responses, r)
+		mu.Unlock()
+	}
+
+	for _, b := range msgs {
+		m, id, err := decodeMessage(b)
+		if err != nil {
+			reply(&Response{ID: id, Error: toError(err)})
+			continue
+		}
+		switch m := m.(type) {
+		case *Notification:
+			if m.Method == MethodCancelRequest {
+				c.cancel(m.Params)
+				continue
+			}
+			c.mu.Lock()
+			c.enqueue(note{n: m})
+			c.mu.Unlock()
+		case *Request:
+			ctx, cancel := context.WithCancel(ctx)
+			c.mu.Lock()
+			c.running[m.ID] = cancel
+			// Wait for the notifications received before.
+			var ready chan struct{}
+			if len(c.notes) > 0 || c.busy {
+				ready = make(chan struct{})
+				c.enqueue(note{barrier: ready})
+			}
+			c.mu.Unlock()
+
+			requests.Add(1)
+			c.handlers.Add(1)
+			go func() {
+				defer c.handlers.Done()
+				defer requests.Done()
+				r := c.handle(ctx, h, m, ready)
+
+				c.mu.Lock()
+				delete(c.running, m.ID)
+				c.mu.Unlock()
+				cancel()
+
+				reply(r)
+			}()
+		case *Response:
+			c.mu.Lock()
+			ch, ok := c.pending[m.ID]
+			delete(c.pending, m.ID)
+			c.mu.Unlock()
+			if ok {
+				ch <- m
+			}
+		}
+	}
+
+	if batch {
+		c.handlers.Add(1)
+		go func() {
+			defer c.handlers.Done()
+			requests.Wait()
+			if len(responses) > 0 {
+				c.send(responses)
+			}
+		}()
+	}
+}
+
+// enqueue queues a notification or a barrier for notify. c.mu must be
+// held.
+func (c *Conn) enqueue(n note) {
+	c.notes = append(c.notes, n)
+	select {
+	case c.queued <- struct{}{}:
+	default:
+	}
+}
+
+// notify handles queued notifications one after another and closes
+// barriers, until stop is closed and nothing is left.
+func (c *Conn) notify(ctx context.Context, h Handler, stop <-chan struct{}) {
+	stopped := false
+	for {
+		c.mu.Lock()
+		c.busy = false
+		var n note
+		ok := len(c.notes) > 0
+		if ok {
+			n = c.notes[0]
+			c.notes[0] = note{}
+			c.notes = c.notes[1:]
+			c.busy = n.n != nil
+		}
+		c.mu.Unlock()
+
+		switch {
+		case n.barrier != nil:
+			close(n.barrier)
+			continue
+		case ok:
+			h.Handle(ctx, n.n.Method, n.n.Params)
+			continue
+		case stopped:
+			return
+		}
+		select {
+		case <-c.queued:
+		case <-stop:
+			stopped = true
+		}
+	}
+}
+
+// cancel cancels the context of the request identified by the parameters
+// of a $/cancelRequest notification. Requests that already finished are
+// ignored.
+func (c *Conn) cancel(params json.RawMessage) {
+	var p cancelParams
+	if err := json.Unmarshal(params, &p); err != nil {
+		return
+	}
+	c.mu.Lock()
+	cancel, ok := c.running[p.ID]
+	c.mu.Unlock()
+	if ok {
+		cancel()
+	}
+}
+
+// handle calls the handler for a request, once ready is closed, and returns
+// the response. Ready is nil if the request need not wait.
+func (c *Conn) handle(ctx context.Context, h Handler, r *Request, ready <-chan struct{}) *Response {
+	if ready != nil {
+		select {
+		case <-ready:
+		case <-ctx.Done():
+			return &Response{ID: r.ID, Error: errorf(int32(LSPErrorCodesRequestCancelled), "request %s cancelled", r.Method)}
+		}
+	}
+	result, err := h.Handle(context.WithValue(ctx, idKey{}, r.ID), r.Method, r.Params)
+	if err != nil {
+		if ctx.Err() != nil {
+			return &Response{ID: r.ID, Error: errorf(int32(LSPErrorCodesRequestCancelled), "request %s cancelled", r.Method)}
+		}
+		return &Response{ID: r.ID, Error: toError(err)}
+	}
+	return &Response{ID: r.ID, Result: result}
+}
+
+// toError converts errors returned by handlers into error objects.
+func toError(err error) *Error {
+	var e *Error
+	switch {
+	case errors.As(err, &e):
+		return e
+	case errors.Is(err, ErrMethodNotFound):
+		return errorf(int32(ErrorCodesMethodNotFound), "%v", err)
+	case errors.Is(err, ErrInvalidParams):
+		return errorf(int32(ErrorCodesInvalidParams), "%v", err)
+	case errors.Is(err, context.Canceled):
+		return errorf(int32(LSPErrorCodesRequestCancelled), "%v", err)
+	}
+	return errorf(int32(LSPErrorCodesRequestFailed), "%v", err)
+}
@@ -0,0 +1,340 @@
+package lsp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// pipeStream is one end of an in-memory stream.
+type pipeStream struct {
+	in   chan []byte
+	out  chan []byte
+	once sync.Once
+	done chan struct{}
+}
+
+// pipe returns the two ends of an in-memory stream. Closing the out channel
+// of one end ends the stream of the other end.
+func pipe() (*pipeStream, *pipeStream) {
+	a, b := make(chan []byte, 16), make(chan []byte, 16)
+	return &pipeStream{in: a, out: b, done: make(chan struct{})},
+		&pipeStream{in: b, out: a, done: make(chan struct{})}
+}
+
+func (p *pipeStream) Read() ([]byte, error) {
+	select {
+	case b, ok := <-p.in:
+		if !ok {
+			return nil, io.EOF
+		}
+		return b, nil
+	case <-p.done:
+		return nil, errors.New("stream closed")
+	}
+}
+
+func (p *pipeStream) Write(b []byte) error {
+	select {
+	case p.out <- b:
+		return nil
+	case <-p.done:
+		return errors.New("stream closed")
+	}
+}
+
+func (p *pipeStream) Close() error {
+	p.once.Do(func() { close(p.done) })
+	return nil
+}
+
+// connect runs connections on both ends of a pipe. They are closed when
+// the test ends.
+func connect(t *testing.T, server, client Handler) (*Conn, *Conn) {
+	a, b := pipe()
+	s, c := NewConn(a), NewConn(b)
+	go s.Run(context.Background(), server)
+	go c.Run(context.Background(), client)
+	t.Cleanup(func() {
+		s.Close()
+		c.Close()
+		<-s.Done()
+		<-c.Done()
+	})
+	return s, c
+}
+
+// echo is a handler returning the params of requests. It fails for the
+// method "fail" and does not know the method "unknown".
+var echo = HandlerFunc(func(ctx context.Context, method string, params json.RawMessage) (json.RawMessage, error) {
+	switch method {
+	case "fail":
+		return nil, errors.New("failed")
+	case "unknown":
+		return nil, fmt.Errorf("%w: %s", ErrMethodNotFound, method)
+	case "sleep":
+		var d []time.Duration
+		if err := json.Unmarshal(params, &d); err != nil {
+			return nil, err
+		}
+		time.Sleep(d[0])
+	}
+	return params, nil
+})
+
+func TestConnCall(t *testing.T) {
+	_, c := connect(t, echo, echo)
+	ctx := context.Background()
+
+	// Later calls return first.
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(d time.Duration) {
+			defer wg.Done()
+			var got []time.Duration
+			if err := c.Call(ctx, "sleep", []time.Duration{d}, &got); err != nil {
+				t.Error(err)
+				return
+			}
+			if len(got) != 1 || got[0] != d {
+				t.Errorf("got reply %v to call %v", got, d)
+			}
+		}(time.Duration(10-i) * time.Millisecond)
+	}
+	wg.Wait()
+
+	if err := c.Call(ctx, "echo", nil, nil); err != nil {
+		t.Errorf("call without params and result: %v", err)
+	}
+	var e *Error
+	if err := c.Call(ctx, "fail", nil, nil); !errors.As(err, &e) || e.Code != int32(LSPErrorCodesRequestFailed) || e.Message != "failed" {
+		t.Errorf("fail: got %v", err)
+	}
+	if err := c.Call(ctx, "unknown", nil, nil); !errors.As(err, &e) || e.Code != int32(ErrorCodesMethodNotFound) {
+		t.Errorf("unknown: got %v", err)
+	}
+	var s string
+	if err := c.Call(ctx, "echo", []int{1}, &s); err == nil {
+		t.Errorf("decoding array into string: got %q", s)
+	}
+}
+
+func TestConnNotify(t *testing.T) {
+	got := make(chan string, 10)
+	server := HandlerFunc(func(ctx context.Context, method string, params json.RawMessage) (json.RawMessage, error) {
+		if _, ok := RequestID(ctx); ok {
+			t.Errorf("notification %s has a request ID", method)
+		}
+		got <- method
+		return nil, nil
+	})
+	_, c := connect(t, server, echo)
+	for i := 0; i < 10; i++ {
+		if err := c.Notify(context.Background(), fmt.Sprint(i), nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for i := 0; i < 10; i++ {
+		if m := <-got; m != fmt.Sprint(i) {
+			t.Fatalf("got notification %s, want %d", m, i)
+		}
+	}
+}
+
+// TestConnNotifyBeforeCall checks that requests are handled after the
+// notifications received before them, like a completion after the
+// didChange of the document.
+func TestConnNotifyBeforeCall(t *testing.T) {
+	var (
+		mu    sync.Mutex
+		state int
+	)
+	server := HandlerFunc(func(ctx context.Context, method string, params json.RawMessage) (json.RawMessage, error) {
+		switch method {
+		case "set":
+			var v []int
+			if err := json.Unmarshal(params, &v); err != nil {
+				return nil, err
+			}
+			time.Sleep(time.Millisecond)
+			mu.Lock()
+			state = v[0]
+			mu.Unlock()
+		case "get":
+			mu.Lock()
+			defer mu.Unlock()
+			return json.Marshal(state)
+		}
+		return nil, nil
+	})
+	_, c := connect(t, server, echo)
+	ctx := context.Background()
+	for i := 1; i <= 10; i++ {
+		if err := c.Notify(ctx, "set", []int{i}); err != nil {
+			t.Fatal(err)
+		}
+		var got int
+		if err := c.Call(ctx, "get", nil, &got); err != nil {
+			t.Fatal(err)
+		}
+		if got != i {
+			t.Errorf("get after set %d returned %d", i, got)
+		}
+	}
+}
+
+// TestConnNotificationCall checks that notification handlers can call the
+// remote end, as required to register capabilities on initialized.
+func TestConnNotificationCall(t *testing.T) {
+	var s *Conn
+	done := make(chan error, 1)
+	server := HandlerFunc(func(ctx context.Context, method string, params json.RawMessage) (json.RawMessage, error) {
+		if method == MethodInitialized {
+			done <- s.Call(ctx, MethodClientRegisterCapability, &RegistrationParams{}, nil)
+		}
+		return nil, nil
+	})
+	s, c := connect(t, server, echo)
+	if err := c.Notify(context.Background(), MethodInitialized, &InitializedParams{}); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("call from notification handler did not return")
+	}
+}
+
+func TestConnRequestID(t *testing.T) {
+	a, b := pipe()
+	s := NewConn(a)
+	go s.Run(context.Background(), HandlerFunc(func(ctx context.Context, method string, params json.RawMessage) (json.RawMessage, error) {
+		id, ok := RequestID(ctx)
+		if !ok {
+			return nil, nil
+		}
+		return json.Marshal(id.String())
+	}))
+	for _, tt := range []struct{ in, want string }{
+		{`{"jsonrpc":"2.0","id":7,"method":"m"}`, `{"jsonrpc":"2.0","id":7,"result":"7"}`},
+		{`{"jsonrpc":"2.0","id":"a","method":"m"}`, `{"jsonrpc":"2.0","id":"a","result":"\"a\""}`},
+		{`{"jsonrpc":"2.0","id":1.5,"method":"m"}`, `{"jsonrpc":"2.0","id":null,"error":{"code":-32600`},
+		{`{"jsonrpc":"1.0","id":3,"method":"m"}`, `{"jsonrpc":"2.0","id":3,"error":{"code":-32600`},
+		{`{`, `{"jsonrpc":"2.0","id":null,"error":{"code":-32700`},
+		{`[]`, `{"jsonrpc":"2.0","id":null,"error":{"code":-32600`},
+		{`[{"jsonrpc":"2.0","id":1,"method":"m"},{"jsonrpc":"2.0","method":"n"},5]`, `[`},
+	} {
+		b.out <- []byte(tt.in)
+		if got := string(<-b.in); !strings.HasPrefix(got, tt.want) {
+			t.Errorf("%s: got %s, want %s...", tt.in, got, tt.want)
+		}
+	}
+	close(b.out)
+	<-s.Done()
+}
+
+func TestConnClose(t *testing.T) {
+	a, b := pipe()
+	c := NewConn(a)
+	errc := make(chan error, 1)
+	go func() { errc <- c.Run(context.Background(), echo) }()
+
+	// The remote end never replies.
+	called := make(chan error, 1)
+	go func() { called <- c.Call(context.Background(), "m", nil, nil) }()
+	<-b.in
+	c.Close()
+	if err := <-called; err != ErrClosed {
+		t.Errorf("pending call: got %v, want ErrClosed", err)
+	}
+	if err := <-errc; err != nil {
+		t.Errorf("Run: %v", err)
+	}
+	<-c.Done()
+	if err := c.Call(context.Background(), "m", nil, nil); err != ErrClosed {
+		t.Errorf("Call after Close: got %v, want ErrClosed", err)
+	}
+	if err := c.Notify(context.Background(), "m", nil); err != ErrClosed {
+		t.Errorf("Notify after Close: got %v, want ErrClosed", err)
+	}
+}
+
+func TestConnRunContext(t *testing.T) {
+	a, _ := pipe()
+	c := NewConn(a)
+	ctx, cancel := context.WithCancel(context.Background())
+	errc := make(chan error, 1)
+	go func() { errc <- c.Run(ctx, echo) }()
+	cancel()
+	if err := <-errc; !errors.Is(err, context.Canceled) {
+		t.Fatalf("got %v, want context.Canceled", err)
+	}
+}
+
+func TestConnCancelIncoming(t *testing.T) {
+	a, b := pipe()
+	s := NewConn(a)
+	started := make(chan struct{})
+	go s.Run(context.Background(), HandlerFunc(func(ctx context.Context, method string, params json.RawMessage) (json.RawMessage, error) {
+		close(started)
+		<-ctx.Done()
+		return nil, errors.New("stopped")
+	}))
+	b.out <- []byte(`{"jsonrpc":"2.0","id":"x","method":"slow"}`)
+	<-started
+	b.out <- []byte(`{"jsonrpc":"2.0","method":"$/cancelRequest","params":{"id":"y"}}`)
+	b.out <- []byte(`{"jsonrpc":"2.0","method":"$/cancelRequest","params":{"id":"x"}}`)
+	want := `{"jsonrpc":"2.0","id":"x","error":{"code":-32800,"message":"request slow cancelled"}}`
+	if got := string(<-b.in); got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+	close(b.out)
+	<-s.Done()
+}
+
+func TestConnCancelOutgoing(t *testing.T) {
+	started := make(chan struct{})
+	canceled := make(chan error, 1)
+	server := HandlerFunc(func(ctx context.Context, method string, params json.RawMessage) (json.RawMessage, error) {
+		if method != "slow" {
+			return nil, nil
+		}
+		close(started)
+		<-ctx.Done()
+		canceled <- ctx.Err()
+		return nil, ctx.Err()
+	})
+	_, c := connect(t, server, echo)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-started
+		cancel()
+	}()
+	if err := c.Call(ctx, "slow", nil, nil); !errors.Is(err, context.Canceled) {
+		t.Fatalf("got %v, want context.Canceled", err)
+	}
+	select {
+	case err := <-canceled:
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("server handler: got %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("server handler was not canceled")
+	}
+
+	// The late reply of the canceled call is dropped.
+	if err := c.Call(context.Background(), "echo", nil, nil); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,270 @@
+package lsp
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+// An Analyzer computes the diagnostics of a document snapshot.
+type Analyzer func(ctx context.Context, doc *Document) ([]Diagnostic, error)
+
+// Diagnostics runs analyzers on the documents of a DocumentStore and
+// reports their diagnostics to the client. Depending on the client
+// capabilities, diagnostics are pushed with textDocument/publishDiagnostics
+// or pulled by the client with textDocument/diagnostic and
+// workspace/diagnostic.
+//
+// Results are cached per document version. Every distinct set of
+// diagnostics of a document gets a new result ID, so pull requests whose
+// previous result ID is still current are answered with unchanged reports.
+type Diagnostics struct {
+	client   Client
+	features *ClientFeatures
+	store    *DocumentStore
+
+	mu        sync.Mutex
+	analyzers []analyzer
+	seq       int
+	results   map[DocumentURI]*diagnosticResult // by normalized URI
+}
+
+type analyzer struct {
+	selector DocumentSelector
+	analyze  Analyzer
+}
+
+// diagnosticResult is the result of the analysis of a document version.
+type diagnosticResult struct {
+	version   int32
+	resultID  string
+	items     []Diagnostic
+	published bool
+}
+
+// NewDiagnostics returns a diagnostics engine for the documents of store,
+// reporting to client, whose capabilities are described by features.
+func NewDiagnostics(client Client, features *ClientFeatures, store *DocumentStore) *Diagnostics {
+	return &Diagnostics{
+		client:   client,
+		features: features,
+		store:    store,
+		results:  make(map[DocumentURI]*diagnosticResult),
+	}
+}
+
+// AddAnalyzer adds an analyzer for the documents selected by selector. A
+// nil selector selects all documents. The diagnostics of a document are
+// the diagnostics of all analyzers selecting it, in the order the
+// analyzers were added.
+func (d *Diagnostics) AddAnalyzer(selector DocumentSelector, a Analyzer) {
+	d.mu.Lock()
+	d.analyzers = append(d.analyzers, analyzer{selector: selector, analyze: a})
+	d.mu.Unlock()
+}
+
+// Pull reports whether the client pulls diagnostics. Otherwise diagnostics
+// are pushed.
+func (d *Diagnostics) Pull() bool {
+	return d.features.PullDiagnostics()
+}
+
+// Register registers the handlers of the diagnostic pull requests with m.
+// They are only registered if the client pulls diagnostics.
+func (d *Diagnostics) Register(m *Mux) {
+	if !d.Pull() {
+		return
+	}
+	HandleRequest(m, TextDocumentDiagnosticRequest, d.DocumentDiagnostic)
+	HandleRequest(m, WorkspaceDiagnosticRequest, d.WorkspaceDiagnostic)
+}
+
+// Update analyzes the current snapshot of the document uri. If the client
+// does not pull diagnostics, changed diagnostics are published.
+func (d *Diagnostics) Update(ctx context.Context, uri DocumentURI) error {
+	doc, ok := d.store.Get(uri)
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrUnknownDocument, uri)
+	}
+	res, err := d.analyze(ctx, doc)
+	if err != nil {
+		return err
+	}
+	if d.Pull() {
+		return nil
+	}
+	return d.publish(ctx, doc.URI, res)
+}
+
+// Clear forgets the diagnostics of the document uri, which usually has
+// been closed. If the client does not pull diagnostics, it publishes an
+// empty set of diagnostics.
+func (d *Diagnostics) Clear(ctx context.Context, uri DocumentURI) error {
+	d.mu.Lock()
+	delete(d.results, uri.Normalize())
+	d.mu.Unlock()
+	if d.Pull() {
+		return nil
+	}
+	return d.client.PublishDiagnostics(ctx, &PublishDiagnosticsParams{Uri: uri, Diagnostics: []Diagnostic{}})
+}
+
+// Refresh asks the client to pull the diagnostics of all documents again,
+// for example after a change of the configuration or of a document other
+// documents depend on. It does nothing, if the client does not pull
+// diagnostics or does not support refreshing them.
+func (d *Diagnostics) Refresh(ctx context.Context) error {
+	if !d.Pull() || !d.features.Bool("workspace.diagnostics.refreshSupport") {
+		return nil
+	}
+	return d.client.DiagnosticRefresh(ctx)
+}
+
+// Invalidate forgets all cached results, so all documents are analyzed
+// again. Use it, if analyzers depend on state other than the document.
+func (d *Diagnostics) Invalidate() {
+	d.mu.Lock()
+	for uri := range d.results {
+		delete(d.results, uri)
+	}
+	d.mu.Unlock()
+}
+
+// DocumentDiagnostic handles textDocument/diagnostic. Documents unknown to
+// the store have no diagnostics.
+func (d *Diagnostics) DocumentDiagnostic(ctx context.Context, params *DocumentDiagnosticParams) (*DocumentDiagnosticReport, error) {
+	doc, ok := d.store.Get(params.TextDocument.Uri)
+	if !ok {
+		return &DocumentDiagnosticReport{Value: RelatedFullDocumentDiagnosticReport{
+			FullDocumentDiagnosticReport: FullDocumentDiagnosticReport{
+				Kind:  string(DocumentDiagnosticReportKindFull),
+				Items: []Diagnostic{},
+			},
+		}}, nil
+	}
+	res, err := d.analyze(ctx, doc)
+	if err != nil {
+		return nil, err
+	}
+	if value(params.PreviousResultId) == res.resultID {
+		return &DocumentDiagnosticReport{Value: RelatedUnchangedDocumentDiagnosticReport{
+			UnchangedDocumentDiagnosticReport: UnchangedDocumentDiagnosticReport{
+				Kind:     string(DocumentDiagnosticReportKindUnchanged),
+				ResultId: res.resultID,
+			},
+		}}, nil
+	}
+	return &DocumentDiagnosticReport{Value: RelatedFullDocumentDiagnosticReport{
+		FullDocumentDiagnosticReport: FullDocumentDiagnosticReport{
+			Kind:     string(DocumentDiagnosticReportKindFull),
+			ResultId: optional(res.resultID),
+			Items:    res.items,
+		},
+	}}, nil
+}
+
+// WorkspaceDiagnostic handles workspace/diagnostic. It reports the
+// diagnostics of all documents of the store.
+func (d *Diagnostics) WorkspaceDiagnostic(ctx context.Context, params *WorkspaceDiagnosticParams) (*WorkspaceDiagnosticReport, error) {
+	previous := make(map[DocumentURI]string)
+	for _, p := range params.PreviousResultIds {
+		previous[p.Uri.Normalize()] = p.Value
+	}
+	report := &WorkspaceDiagnosticReport{Items: []WorkspaceDocumentDiagnosticReport{}}
+	for _, doc := range d.store.Documents() {
+		res, err := d.analyze(ctx, doc)
+		if err != nil {
+			return nil, err
+		}
+		var item interface{}
+		if previous[doc.URI.Normalize()] == res.resultID {
+			item = WorkspaceUnchangedDocumentDiagnosticReport{
+				UnchangedDocumentDiagnosticReport: UnchangedDocumentDiagnosticReport{
+					Kind:     string(DocumentDiagnosticReportKindUnchanged),
+					ResultId: res.resultID,
+				},
+				Uri:     doc.URI,
+				Version: ptr(doc.Version),
+			}
+		} else {
+			item = WorkspaceFullDocumentDiagnosticReport{
+				FullDocumentDiagnosticReport: FullDocumentDiagnosticReport{
+					Kind:     string(DocumentDiagnosticReportKindFull),
+					ResultId: optional(res.resultID),
+					Items:    res.items,
+				},
+				Uri:     doc.URI,
+				Version: ptr(doc.Version),
+			}
+		}
+		report.Items = append(report.Items, WorkspaceDocumentDiagnosticReport{Value: item})
+	}
+	return report, nil
+}
+
+// analyze returns the result of the analysis of doc. Results of the same
+// version are reused. A new result gets the result ID of the previous
+// result, if the diagnostics did not change. If ctx is canceled during the
+// analysis, nothing is cached, since the analyzers may have stopped early.
+func (d *Diagnostics) analyze(ctx context.Context, doc *Document) (*diagnosticResult, error) {
+	uri := doc.URI.Normalize()
+	d.mu.Lock()
+	prev := d.results[uri]
+	analyzers := d.analyzers
+	d.mu.Unlock()
+	if prev != nil && prev.version == doc.Version {
+		return prev, nil
+	}
+
+	items := []Diagnostic{}
+	for _, a := range analyzers {
+		if a.selector != nil && !a.selector.Match(doc.URI, doc.LanguageID) {
+			continue
+		}
+		diags, err := a.analyze(ctx, doc)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, diags...)
+	}
+	if err := ctx.Err(); err != nil {
+		// The diagnostics may be incomplete.
+		return nil, err
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	cur := d.results[uri]
+	if cur != nil && cur.version >= doc.Version {
+		// Analyzed concurrently.
+		return cur, nil
+	}
+	res := &diagnosticResult{version: doc.Version, items: items}
+	if cur != nil && reflect.DeepEqual(cur.items, items) {
+		res.resultID = cur.resultID
+		res.published = cur.published
+	} else {
+		d.seq++
+		res.resultID = fmt.Sprint(d.seq)
+	}
+	d.results[uri] = res
+	return res, nil
+}
+
+// publish publishes the diagnostics of res, unless they were published
+// before.
+func (d *Diagnostics) publish(ctx context.Context, uri DocumentURI, res *diagnosticResult) error {
+	d.mu.Lock()
+	published := res.published
+	res.published = true
+	d.mu.Unlock()
+	if published {
+		return nil
+	}
+	return d.client.PublishDiagnostics(ctx, &PublishDiagnosticsParams{
+		Uri:         uri,
+		Version:     ptr(res.version),
+		Diagnostics: res.items,
+	})
+}
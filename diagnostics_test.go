@@ -0,0 +1,310 @@
+package lsp
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// diagnosticsClient is a client recording published diagnostics, refresh
+// requests and logged messages.
+type diagnosticsClient struct {
+	Client
+
+	mu        sync.Mutex
+	published []*PublishDiagnosticsParams
+	refreshed int
+	logged    []string
+}
+
+func (c *diagnosticsClient) PublishDiagnostics(ctx context.Context, params *PublishDiagnosticsParams) error {
+	c.mu.Lock()
+	c.published = append(c.published, params)
+	c.mu.Unlock()
+	return nil
+}
+
+func (c *diagnosticsClient) DiagnosticRefresh(ctx context.Context) error {
+	c.mu.Lock()
+	c.refreshed++
+	c.mu.Unlock()
+	return nil
+}
+
+func (c *diagnosticsClient) LogMessage(ctx context.Context, params *LogMessageParams) error {
+	c.mu.Lock()
+	c.logged = append(c.logged, params.Message)
+	c.mu.Unlock()
+	return nil
+}
+
+// todoAnalyzer reports lines containing TODO and counts its calls.
+type todoAnalyzer struct {
+	mu    sync.Mutex
+	calls int
+}
+
+func (a *todoAnalyzer) analyze(ctx context.Context, doc *Document) ([]Diagnostic, error) {
+	a.mu.Lock()
+	a.calls++
+	a.mu.Unlock()
+	var diags []Diagnostic
+	for i := 0; i < doc.LineCount(); i++ {
+		if strings.Contains(doc.Line(i), "TODO") {
+			diags = append(diags, Diagnostic{Range: Range{Start: Position{Line: uint32(i)}, End: Position{Line: uint32(i)}}, Message: "todo"})
+		}
+	}
+	return diags, nil
+}
+
+func (a *todoAnalyzer) count() int {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.calls
+}
+
+// setText opens or changes the document uri of s.
+func setText(t *testing.T, s *DocumentStore, uri DocumentURI, version int32, text string) {
+	t.Helper()
+	ctx := context.Background()
+	if _, ok := s.Get(uri); !ok {
+		if err := s.DidOpen(ctx, &DidOpenTextDocumentParams{TextDocument: TextDocumentItem{Uri: uri, LanguageId: "go", Version: version, Text: text}}); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	err := s.DidChange(ctx, &DidChangeTextDocumentParams{
+		TextDocument:   VersionedTextDocumentIdentifier{TextDocumentIdentifier: TextDocumentIdentifier{Uri: uri}, Version: version},
+		ContentChanges: []TextDocumentContentChangeEvent{{Value: TextDocumentContentChangeEventText{Text: text}}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDiagnosticsPush(t *testing.T) {
+	ctx := context.Background()
+	c := &diagnosticsClient{}
+	features, _ := NewClientFeatures(nil)
+	s := NewDocumentStore()
+	d := NewDiagnostics(c, features, s)
+	a := &todoAnalyzer{}
+	d.AddAnalyzer(nil, a.analyze)
+	m := NewMux()
+	d.Register(m)
+	if d.Pull() || m.Handles(MethodTextDocumentDiagnostic) || m.Handles(MethodWorkspaceDiagnostic) {
+		t.Fatal("pull diagnostics without client capability")
+	}
+
+	steps := []struct {
+		version int32
+		text    string
+		calls   int   // of the analyzer afterwards
+		publish []int // lines of the published diagnostics, nil if nothing is published
+	}{
+		{1, "x\nTODO\n", 1, []int{1}},
+		{1, "", 1, nil}, // the same version again
+		{2, "y\nTODO\n", 2, nil},
+		{3, "TODO\ny\n", 3, []int{0}},
+		{4, "y\n", 4, []int{}},
+	}
+	for _, st := range steps {
+		if st.text != "" {
+			setText(t, s, "file:///a", st.version, st.text)
+		}
+		n := len(c.published)
+		if err := d.Update(ctx, "file:///a"); err != nil {
+			t.Fatal(err)
+		}
+		if got := a.count(); got != st.calls {
+			t.Errorf("version %d: analyzer called %d times, want %d", st.version, got, st.calls)
+		}
+		switch {
+		case st.publish == nil && len(c.published) != n:
+			t.Errorf("version %d: published %+v", st.version, c.published[n:])
+		case st.publish != nil && len(c.published) != n+1:
+			t.Errorf("version %d: published %d times, want once", st.version, len(c.published)-n)
+		case st.publish != nil:
+			p := c.published[n]
+			var lines []int
+			for _, diag := range p.Diagnostics {
+				lines = append(lines, int(diag.Range.Start.Line))
+			}
+			if p.Uri != "file:///a" || p.Version == nil || *p.Version != st.version || p.Diagnostics == nil || len(lines) != len(st.publish) || len(lines) > 0 && lines[0] != st.publish[0] {
+				t.Errorf("version %d: published %+v, want lines %v", st.version, p, st.publish)
+			}
+		}
+	}
+
+	n := len(c.published)
+	if err := d.Clear(ctx, "file:///a"); err != nil {
+		t.Fatal(err)
+	}
+	if len(c.published) != n+1 || c.published[n].Diagnostics == nil || len(c.published[n].Diagnostics) != 0 {
+		t.Errorf("Clear published %+v", c.published[n:])
+	}
+	if err := d.Update(ctx, "file:///b"); !errors.Is(err, ErrUnknownDocument) {
+		t.Errorf("update of unknown document: got %v", err)
+	}
+	if err := d.Refresh(ctx); err != nil || c.refreshed != 0 {
+		t.Errorf("Refresh of pushed diagnostics: %v, %d refreshes", err, c.refreshed)
+	}
+}
+
+func TestDiagnosticsPull(t *testing.T) {
+	ctx := context.Background()
+	c := &diagnosticsClient{}
+	features, _ := NewClientFeatures(&ClientCapabilities{
+		TextDocument: &TextDocumentClientCapabilities{Diagnostic: &DiagnosticClientCapabilities{}},
+		Workspace:    &WorkspaceClientCapabilities{Diagnostics: &DiagnosticWorkspaceClientCapabilities{RefreshSupport: ptr(true)}},
+	})
+	s := NewDocumentStore()
+	d := NewDiagnostics(c, features, s)
+	a := &todoAnalyzer{}
+	d.AddAnalyzer(DocumentSelector{{Value: "go"}}, a.analyze)
+	d.AddAnalyzer(DocumentSelector{{Value: "markdown"}}, func(ctx context.Context, doc *Document) ([]Diagnostic, error) {
+		t.Errorf("markdown analyzer called for %s", doc.URI)
+		return nil, nil
+	})
+	m := NewMux()
+	d.Register(m)
+	if !d.Pull() || !m.Handles(MethodTextDocumentDiagnostic) || !m.Handles(MethodWorkspaceDiagnostic) {
+		t.Fatal("diagnostic requests not registered")
+	}
+
+	report := func(previous string) (kind string, resultID string, items []Diagnostic) {
+		t.Helper()
+		r, err := d.DocumentDiagnostic(ctx, &DocumentDiagnosticParams{
+			TextDocument:     TextDocumentIdentifier{Uri: "file:///a"},
+			PreviousResultId: optional(previous),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		switch v := r.Value.(type) {
+		case RelatedFullDocumentDiagnosticReport:
+			return v.Kind, value(v.ResultId), v.Items
+		case RelatedUnchangedDocumentDiagnosticReport:
+			return v.Kind, v.ResultId, nil
+		}
+		t.Fatalf("unexpected report %#v", r.Value)
+		return
+	}
+
+	if kind, id, items := report(""); kind != "full" || id != "" || items == nil || len(items) != 0 {
+		t.Errorf("unknown document: got %s report %q with %v", kind, id, items)
+	}
+
+	setText(t, s, "file:///a", 1, "TODO\n")
+	if err := d.Update(ctx, "file:///a"); err != nil {
+		t.Fatal(err)
+	}
+	if len(c.published) != 0 {
+		t.Errorf("pulled diagnostics were published: %+v", c.published)
+	}
+	kind, id, items := report("")
+	if kind != "full" || id == "" || len(items) != 1 {
+		t.Fatalf("got %s report %q with %v", kind, id, items)
+	}
+	if kind, got, _ := report(id); kind != "unchanged" || got != id || a.count() != 1 {
+		t.Errorf("same version: got %s report %q after %d analyses", kind, got, a.count())
+	}
+
+	// An edit not changing the diagnostics keeps the result ID.
+	setText(t, s, "file:///a", 2, "TODO\nx\n")
+	if kind, got, _ := report(id); kind != "unchanged" || got != id || a.count() != 2 {
+		t.Errorf("unchanged diagnostics: got %s report %q after %d analyses", kind, got, a.count())
+	}
+	if kind, got, items := report("other"); kind != "full" || got != id || len(items) != 1 {
+		t.Errorf("outdated previous result: got %s report %q with %v", kind, got, items)
+	}
+
+	// Forgotten results get a new result ID.
+	d.Invalidate()
+	kind, id, items = report(id)
+	if kind != "full" || id == "" || len(items) != 1 || a.count() != 3 {
+		t.Errorf("after Invalidate: got %s report %q with %v after %d analyses", kind, id, items, a.count())
+	}
+
+	setText(t, s, "file:///a", 3, "x\n")
+	kind, newID, items := report(id)
+	if kind != "full" || newID == id || items == nil || len(items) != 0 {
+		t.Errorf("changed diagnostics: got %s report %q with %v", kind, newID, items)
+	}
+
+	setText(t, s, "file:///b", 7, "TODO")
+	b, _ := d.DocumentDiagnostic(ctx, &DocumentDiagnosticParams{TextDocument: TextDocumentIdentifier{Uri: "file:///b"}})
+	bID := value(b.Value.(RelatedFullDocumentDiagnosticReport).ResultId)
+	w, err := d.WorkspaceDiagnostic(ctx, &WorkspaceDiagnosticParams{
+		PreviousResultIds: []PreviousResultId{{Uri: "file:///a", Value: id}, {Uri: "file:///b", Value: bID}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(w.Items) != 2 {
+		t.Fatalf("got %d workspace reports, want 2", len(w.Items))
+	}
+	if r, ok := w.Items[0].Value.(WorkspaceFullDocumentDiagnosticReport); !ok || r.Uri != "file:///a" || value(r.Version) != 3 || value(r.ResultId) != newID {
+		t.Errorf("got %#v, want full report of file:///a", w.Items[0].Value)
+	}
+	if r, ok := w.Items[1].Value.(WorkspaceUnchangedDocumentDiagnosticReport); !ok || r.Uri != "file:///b" || value(r.Version) != 7 || r.ResultId != bID {
+		t.Errorf("got %#v, want unchanged report of file:///b", w.Items[1].Value)
+	}
+
+	if err := d.Refresh(ctx); err != nil || c.refreshed != 1 {
+		t.Errorf("Refresh: %v, %d refreshes", err, c.refreshed)
+	}
+}
+
+func TestDiagnosticsRefreshSupport(t *testing.T) {
+	c := &diagnosticsClient{}
+	features, _ := NewClientFeatures(&ClientCapabilities{
+		TextDocument: &TextDocumentClientCapabilities{Diagnostic: &DiagnosticClientCapabilities{}},
+	})
+	d := NewDiagnostics(c, features, NewDocumentStore())
+	if err := d.Refresh(context.Background()); err != nil || c.refreshed != 0 {
+		t.Errorf("Refresh without refresh support: %v, %d refreshes", err, c.refreshed)
+	}
+}
+
+func TestDiagnosticsCanceled(t *testing.T) {
+	c := &diagnosticsClient{}
+	features, _ := NewClientFeatures(nil)
+	s := NewDocumentStore()
+	d := NewDiagnostics(c, features, s)
+	ctx, cancel := context.WithCancel(context.Background())
+	calls := 0
+	d.AddAnalyzer(nil, func(context.Context, *Document) ([]Diagnostic, error) {
+		calls++
+		cancel()
+		return []Diagnostic{{Message: "partial"}}, nil
+	})
+	failed := errors.New("failed")
+	d.AddAnalyzer(nil, func(ctx context.Context, doc *Document) ([]Diagnostic, error) {
+		if doc.Version == 2 {
+			return nil, failed
+		}
+		return nil, nil
+	})
+	setText(t, s, "file:///a", 1, "x")
+
+	if err := d.Update(ctx, "file:///a"); !errors.Is(err, context.Canceled) {
+		t.Fatalf("got %v, want context.Canceled", err)
+	}
+	if len(c.published) != 0 {
+		t.Fatalf("canceled analysis published %+v", c.published)
+	}
+	if err := d.Update(context.Background(), "file:///a"); err != nil {
+		t.Fatal(err)
+	}
+	if calls != 2 || len(c.published) != 1 {
+		t.Errorf("canceled analysis was cached: %d analyses, %d publications", calls, len(c.published))
+	}
+
+	setText(t, s, "file:///a", 2, "y")
+	if err := d.Update(context.Background(), "file:///a"); !errors.Is(err, failed) {
+		t.Errorf("got %v, want the error of the analyzer", err)
+	}
+}
@@ -0,0 +1,198 @@
+package lsp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+var (
+	// ErrUnknownDocument is returned for documents not opened by the
+	// client.
+	ErrUnknownDocument = errors.New("unknown document")
+
+	// ErrOutdatedVersion is returned for changes whose version is not
+	// newer than the version of the document.
+	ErrOutdatedVersion = errors.New("outdated version")
+)
+
+// A Document is an immutable snapshot of a text document opened by the
+// client. Its embedded LineIndex converts between positions in the
+// negotiated position encoding and offsets.
+type Document struct {
+	URI        DocumentURI
+	LanguageID string
+	Version    int32
+
+	*LineIndex
+}
+
+// NewDocument returns a document with the given text, whose positions are
+// counted in encoding enc. The empty encoding is UTF-16.
+func NewDocument(uri DocumentURI, languageID string, version int32, text string, enc PositionEncodingKind) *Document {
+	return &Document{
+		URI:        uri,
+		LanguageID: languageID,
+		Version:    version,
+		LineIndex:  NewLineIndex(text, enc),
+	}
+}
+
+// apply returns a new document with the changes applied. Ranged changes
+// are applied to the rope of the document in O(log n) time.
+func (d *Document) apply(version int32, changes []TextDocumentContentChangeEvent) (*Document, error) {
+	x := d.LineIndex
+	for i, c := range changes {
+		switch c := c.Value.(type) {
+		case TextDocumentContentChangeEventText:
+			x = NewLineIndex(c.Text, x.Encoding())
+		case *TextDocumentContentChangeEventText:
+			x = NewLineIndex(c.Text, x.Encoding())
+		case TextDocumentContentChangeEventRange:
+			x = splice(x, c.Range, c.Text)
+		case *TextDocumentContentChangeEventRange:
+			x = splice(x, c.Range, c.Text)
+		default:
+			return nil, fmt.Errorf("%s: change %d: unexpected value %T", d.URI, i, c)
+		}
+	}
+	return &Document{URI: d.URI, LanguageID: d.LanguageID, Version: version, LineIndex: x}, nil
+}
+
+// splice returns x with the range r replaced by s.
+func splice(x *LineIndex, r Range, s string) *LineIndex {
+	start, end := x.Offsets(r)
+	if end < start {
+		start, end = end, start
+	}
+	return x.Replace(start, end, s)
+}
+
+// A DocumentStore keeps the text documents opened by the client in sync
+// with the client. It handles the text document synchronization
+// notifications with full and incremental changes. Documents are keyed by
+// their normalized URI, so differently encoded URIs of the same document
+// refer to the same entry. Snapshots keep the URI sent by the client.
+//
+// Handlers get immutable snapshots of the documents, which can be used
+// concurrently to changes.
+type DocumentStore struct {
+	// Encoding is the position encoding negotiated with the client. The
+	// empty encoding is UTF-16. It must be set before the first document
+	// is opened.
+	Encoding PositionEncodingKind
+
+	mu   sync.RWMutex
+	docs map[DocumentURI]*Document
+}
+
+// NewDocumentStore returns an empty store.
+func NewDocumentStore() *DocumentStore {
+	return &DocumentStore{docs: make(map[DocumentURI]*Document)}
+}
+
+// Register registers the handlers of the text document synchronization
+// notifications with m.
+func (s *DocumentStore) Register(m *Mux) {
+	HandleNotification(m, TextDocumentDidOpenNotification, s.DidOpen)
+	HandleNotification(m, TextDocumentDidChangeNotification, s.DidChange)
+	HandleNotification(m, TextDocumentDidSaveNotification, s.DidSave)
+	HandleNotification(m, TextDocumentWillSaveNotification, s.WillSave)
+	HandleNotification(m, TextDocumentDidCloseNotification, s.DidClose)
+}
+
+// Get returns the current snapshot of the document uri.
+func (s *DocumentStore) Get(uri DocumentURI) (*Document, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	doc, ok := s.docs[uri.Normalize()]
+	return doc, ok
+}
+
+// Documents returns snapshots of all open documents sorted by URI.
+func (s *DocumentStore) Documents() []*Document {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	docs := make([]*Document, 0, len(s.docs))
+	for _, doc := range s.docs {
+		docs = append(docs, doc)
+	}
+	sort.Slice(docs, func(i, j int) bool { return docs[i].URI < docs[j].URI })
+	return docs
+}
+
+// DidOpen handles textDocument/didOpen. Opening a document again replaces
+// it.
+func (s *DocumentStore) DidOpen(ctx context.Context, params *DidOpenTextDocumentParams) error {
+	item := params.TextDocument
+	doc := NewDocument(item.Uri, item.LanguageId, item.Version, item.Text, s.Encoding)
+	s.mu.Lock()
+	s.docs[doc.URI.Normalize()] = doc
+	s.mu.Unlock()
+	return nil
+}
+
+// DidChange handles textDocument/didChange. The changes are applied in
+// order. Changes with a version not newer than the document are rejected
+// with ErrOutdatedVersion.
+func (s *DocumentStore) DidChange(ctx context.Context, params *DidChangeTextDocumentParams) error {
+	uri := params.TextDocument.Uri.Normalize()
+	version := params.TextDocument.Version
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	doc, ok := s.docs[uri]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrUnknownDocument, uri)
+	}
+	if version <= doc.Version {
+		return fmt.Errorf("%w: %s: version %d is not newer than %d", ErrOutdatedVersion, uri, version, doc.Version)
+	}
+	doc, err := doc.apply(version, params.ContentChanges)
+	if err != nil {
+		return err
+	}
+	doc.Version = version
+	s.docs[uri] = doc
+	return nil
+}
+
+// DidSave handles textDocument/didSave. If the notification includes the
+// text, it replaces the text of the document, even if it is empty.
+func (s *DocumentStore) DidSave(ctx context.Context, params *DidSaveTextDocumentParams) error {
+	uri := params.TextDocument.Uri.Normalize()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	doc, ok := s.docs[uri]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrUnknownDocument, uri)
+	}
+	if params.Text != nil && *params.Text != doc.Text() {
+		s.docs[uri] = NewDocument(doc.URI, doc.LanguageID, doc.Version, *params.Text, doc.Encoding())
+	}
+	return nil
+}
+
+// WillSave handles textDocument/willSave.
+func (s *DocumentStore) WillSave(ctx context.Context, params *WillSaveTextDocumentParams) error {
+	if _, ok := s.Get(params.TextDocument.Uri); !ok {
+		return fmt.Errorf("%w: %s", ErrUnknownDocument, params.TextDocument.Uri)
+	}
+	return nil
+}
+
+// DidClose handles textDocument/didClose.
+func (s *DocumentStore) DidClose(ctx context.Context, params *DidCloseTextDocumentParams) error {
+	uri := params.TextDocument.Uri.Normalize()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.docs[uri]; !ok {
+		return fmt.Errorf("%w: %s", ErrUnknownDocument, uri)
+	}
+	delete(s.docs, uri)
+	return nil
+}
@@ -0,0 +1,112 @@
+package lsp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestDocumentStore(t *testing.T) {
+	store := NewDocumentStore()
+	m := NewMux()
+	store.Register(m)
+
+	steps := []struct {
+		method  string
+		params  string
+		err     error
+		text    string // of file:///a afterwards
+		version int32
+	}{
+		{
+			method:  MethodTextDocumentDidOpen,
+			params:  `{"textDocument":{"uri":"file:///a","languageId":"go","version":1,"text":"a😀b\r\nline2\rline3\n"}}`,
+			text:    "a😀b\r\nline2\rline3\n",
+			version: 1,
+		},
+		{
+			// Incremental changes are applied in order. Characters past
+			// the end of a line refer to the end of the line.
+			method: MethodTextDocumentDidChange,
+			params: `{"textDocument":{"uri":"file:///a","version":2},"contentChanges":[
+				{"range":{"start":{"line":0,"character":1},"end":{"line":0,"character":3}},"text":"X"},
+				{"range":{"start":{"line":1,"character":0},"end":{"line":2,"character":0}},"text":""},
+				{"range":{"start":{"line":1,"character":100},"end":{"line":1,"character":100}},"text":"!"}
+			]}`,
+			text:    "aXb\r\nline3!\n",
+			version: 2,
+		},
+		{
+			method:  MethodTextDocumentDidChange,
+			params:  `{"textDocument":{"uri":"file:///a","version":2},"contentChanges":[{"text":"x"}]}`,
+			err:     ErrOutdatedVersion,
+			text:    "aXb\r\nline3!\n",
+			version: 2,
+		},
+		{
+			method:  MethodTextDocumentDidChange,
+			params:  `{"textDocument":{"uri":"file:///a","version":1},"contentChanges":[{"text":"x"}]}`,
+			err:     ErrOutdatedVersion,
+			text:    "aXb\r\nline3!\n",
+			version: 2,
+		},
+		{
+			// A full change followed by an incremental one.
+			method: MethodTextDocumentDidChange,
+			params: `{"textDocument":{"uri":"file:///%61","version":5},"contentChanges":[
+				{"text":"full\ntext"},
+				{"range":{"start":{"line":1,"character":0},"end":{"line":1,"character":4}},"text":"change"}
+			]}`,
+			text:    "full\nchange",
+			version: 5,
+		},
+		{
+			method:  MethodTextDocumentDidSave,
+			params:  `{"textDocument":{"uri":"file:///a"}}`,
+			text:    "full\nchange",
+			version: 5,
+		},
+		{
+			method:  MethodTextDocumentDidSave,
+			params:  `{"textDocument":{"uri":"file:///a"},"text":"saved"}`,
+			text:    "saved",
+			version: 5,
+		},
+		{
+			method:  MethodTextDocumentDidSave,
+			params:  `{"textDocument":{"uri":"file:///a"},"text":""}`,
+			text:    "",
+			version: 5,
+		},
+		{
+			method:  MethodTextDocumentWillSave,
+			params:  `{"textDocument":{"uri":"file:///a"},"reason":1}`,
+			version: 5,
+		},
+
+		{method: MethodTextDocumentDidChange, params: `{"textDocument":{"uri":"file:///b","version":2},"contentChanges":[]}`, err: ErrUnknownDocument, version: 5},
+		{method: MethodTextDocumentDidSave, params: `{"textDocument":{"uri":"file:///b"},"text":""}`, err: ErrUnknownDocument, version: 5},
+		{method: MethodTextDocumentWillSave, params: `{"textDocument":{"uri":"file:///b"},"reason":1}`, err: ErrUnknownDocument, version: 5},
+		{method: MethodTextDocumentDidClose, params: `{"textDocument":{"uri":"file:///b"}}`, err: ErrUnknownDocument, version: 5},
+	}
+	for i, s := range steps {
+		if _, err := m.Handle(context.Background(), s.method, json.RawMessage(s.params)); !errors.Is(err, s.err) {
+			t.Errorf("%d: %s: got error %v, want %v", i, s.method, err, s.err)
+		}
+		doc, ok := store.Get("file:///a")
+		if !ok {
+			t.Fatalf("%d: %s: document closed", i, s.method)
+		}
+		if doc.Text() != s.text || doc.Version != s.version {
+			t.Errorf("%d: %s: got %q version %d, want %q version %d", i, s.method, doc.Text(), doc.Version, s.text, s.version)
+		}
+	}
+
+	if _, err := m.Handle(context.Background(), MethodTextDocumentDidClose, json.RawMessage(`{"textDocument":{"uri":"file:///a"}}`)); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := store.Get("file:///a"); ok {
+		t.Error("document still open after didClose")
+	}
+}
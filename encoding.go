@@ -0,0 +1,269 @@
+package lsp
+
+import (
+	"go/token"
+	"sync"
+	"unicode/utf8"
+)
+
+// NegotiatePositionEncoding returns the first encoding preferred by the
+// client, which is supported by the server. If supported is empty, all
+// encodings are supported. UTF-16 is returned, if there is no common
+// encoding, since every client and server must support it.
+func NegotiatePositionEncoding(client []PositionEncodingKind, supported ...PositionEncodingKind) PositionEncodingKind {
+	for _, c := range client {
+		switch c {
+		case PositionEncodingKindUTF8, PositionEncodingKindUTF16, PositionEncodingKindUTF32:
+		default:
+			continue
+		}
+		if len(supported) == 0 {
+			return c
+		}
+		for _, s := range supported {
+			if c == s {
+				return c
+			}
+		}
+	}
+	return PositionEncodingKindUTF16
+}
+
+// NegotiatePositionEncoding returns the position encoding to use with the
+// client. See the function NegotiatePositionEncoding.
+func (f *ClientFeatures) NegotiatePositionEncoding(supported ...PositionEncodingKind) PositionEncodingKind {
+	return NegotiatePositionEncoding(f.PositionEncodings(), supported...)
+}
+
+// units returns the number of code units of the character at the start of
+// s in encoding enc and its size in bytes. Invalid UTF-8 bytes count as a
+// single character.
+func units(s string, enc PositionEncodingKind) (n int, size int) {
+	r, size := utf8.DecodeRuneInString(s)
+	switch enc {
+	case PositionEncodingKindUTF8:
+		return size, size
+	case PositionEncodingKindUTF32:
+		return 1, size
+	}
+	if r >= 0x10000 && r <= utf8.MaxRune {
+		return 2, size
+	}
+	return 1, size
+}
+
+// charOffset returns the byte offset of the character char of line, which
+// is counted in code units of enc. Characters beyond the end of line are
+// clamped to its end. Characters pointing into the middle of a character,
+// like the second half of a surrogate pair, are rounded down to the start
+// of the character.
+func charOffset(line string, char uint32, enc PositionEncodingKind) int {
+	n := 0
+	for i := 0; i < len(line); {
+		if n >= int(char) {
+			return i
+		}
+		u, size := units(line[i:], enc)
+		if n+u > int(char) {
+			return i
+		}
+		n += u
+		i += size
+	}
+	return len(line)
+}
+
+// charCount returns the length of s in code units of enc. A character cut
+// off at the end of s is not counted, so offsets into the middle of a
+// character are rounded down like in charOffset.
+func charCount(s string, enc PositionEncodingKind) uint32 {
+	n := 0
+	for i := 0; i < len(s); {
+		if !utf8.FullRuneInString(s[i:]) {
+			break
+		}
+		u, size := units(s[i:], enc)
+		n += u
+		i += size
+	}
+	return uint32(n)
+}
+
+// A LineIndex converts between positions in a text: LSP positions, whose
+// character is counted in the code units of a position encoding, byte
+// offsets, rune offsets and go/token positions. Lines end with "\n", "\r\n"
+// or a lone "\r".
+//
+// The text is stored in a rope, so line lookups and edits take O(log n)
+// time, independent of the size of the text.
+type LineIndex struct {
+	rope *rope
+	enc  PositionEncodingKind
+
+	once sync.Once
+	text string
+}
+
+// NewLineIndex returns an index of text for positions in encoding enc. The
+// empty encoding is UTF-16, the default of LSP.
+func NewLineIndex(text string, enc PositionEncodingKind) *LineIndex {
+	x := newLineIndex(newRope(text), enc)
+	x.once.Do(func() { x.text = text })
+	return x
+}
+
+func newLineIndex(r *rope, enc PositionEncodingKind) *LineIndex {
+	if enc == "" {
+		enc = PositionEncodingKindUTF16
+	}
+	return &LineIndex{rope: r, enc: enc}
+}
+
+// Replace returns a new index with the text between the byte offsets start
+// and end replaced by s. The index x is not modified.
+func (x *LineIndex) Replace(start, end int, s string) *LineIndex {
+	return newLineIndex(x.rope.replace(start, end, s), x.enc)
+}
+
+// Text returns the text. The text is assembled from the rope on first use.
+func (x *LineIndex) Text() string {
+	x.once.Do(func() { x.text = x.rope.String() })
+	return x.text
+}
+
+// Len returns the length of the text in bytes.
+func (x *LineIndex) Len() int {
+	return x.rope.len()
+}
+
+// Slice returns the text between the byte offsets start and end.
+func (x *LineIndex) Slice(start, end int) string {
+	return x.rope.slice(start, end)
+}
+
+// Encoding returns the position encoding.
+func (x *LineIndex) Encoding() PositionEncodingKind {
+	return x.enc
+}
+
+// LineCount returns the number of lines. A text always has at least one
+// line.
+func (x *LineIndex) LineCount() int {
+	return x.rope.lines()
+}
+
+// Line returns the text of line i without its line terminator.
+func (x *LineIndex) Line(i int) string {
+	return x.Slice(x.lineBounds(i))
+}
+
+// lineStart returns the offset of line i.
+func (x *LineIndex) lineStart(i int) int {
+	return x.rope.lineStart(i, 0)
+}
+
+// lineBounds returns the byte range of line i without its line terminator.
+func (x *LineIndex) lineBounds(i int) (start int, end int) {
+	start = x.lineStart(i)
+	end = x.Len()
+	if i+1 < x.LineCount() {
+		end = x.lineStart(i + 1)
+	}
+	for end > start {
+		if c := x.rope.byteAt(end - 1); c != '\n' && c != '\r' {
+			break
+		}
+		end--
+	}
+	return start, end
+}
+
+// line returns the line containing the byte offset.
+func (x *LineIndex) line(offset int) int {
+	return x.rope.line(offset)
+}
+
+// Offset returns the byte offset of p. Positions beyond the end of a line
+// or the text are clamped.
+func (x *LineIndex) Offset(p Position) int {
+	if int(p.Line) >= x.LineCount() {
+		return x.Len()
+	}
+	start, end := x.lineBounds(int(p.Line))
+	return start + charOffset(x.Slice(start, end), p.Character, x.enc)
+}
+
+// Position returns the position of a byte offset. Offsets beyond the text
+// are clamped. Offsets within a line terminator are mapped to the end of
+// the line.
+func (x *LineIndex) Position(offset int) Position {
+	if offset > x.Len() {
+		offset = x.Len()
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	line := x.line(offset)
+	start, end := x.lineBounds(line)
+	if offset > end {
+		offset = end
+	}
+	return Position{Line: uint32(line), Character: charCount(x.Slice(start, offset), x.enc)}
+}
+
+// Range returns the range between two byte offsets.
+func (x *LineIndex) Range(start, end int) Range {
+	return Range{Start: x.Position(start), End: x.Position(end)}
+}
+
+// Offsets returns the byte offsets of the start and end of r.
+func (x *LineIndex) Offsets(r Range) (start int, end int) {
+	return x.Offset(r.Start), x.Offset(r.End)
+}
+
+// RuneOffset returns the offset of p counted in runes.
+func (x *LineIndex) RuneOffset(p Position) int {
+	return utf8.RuneCountInString(x.Slice(0, x.Offset(p)))
+}
+
+// RunePosition returns the position of an offset counted in runes.
+func (x *LineIndex) RunePosition(n int) Position {
+	text := x.Text()
+	offset := 0
+	for ; n > 0 && offset < len(text); n-- {
+		_, size := utf8.DecodeRuneInString(text[offset:])
+		offset += size
+	}
+	return x.Position(offset)
+}
+
+// TokenPosition returns p as go/token position with the given file name.
+// Like positions of go/token, lines and columns start at 1 and columns are
+// counted in bytes.
+func (x *LineIndex) TokenPosition(filename string, p Position) token.Position {
+	offset := x.Offset(p)
+	line := x.line(offset)
+	return token.Position{
+		Filename: filename,
+		Offset:   offset,
+		Line:     line + 1,
+		Column:   offset - x.lineStart(line) + 1,
+	}
+}
+
+// FromTokenPosition returns the LSP position of a go/token position. If
+// the line of tp is not set, its offset is used.
+func (x *LineIndex) FromTokenPosition(tp token.Position) Position {
+	if tp.Line < 1 || tp.Line > x.LineCount() {
+		return x.Position(tp.Offset)
+	}
+	start, end := x.lineBounds(tp.Line - 1)
+	offset := start + tp.Column - 1
+	if offset > end {
+		offset = end
+	}
+	if offset < start {
+		offset = start
+	}
+	return x.Position(offset)
+}
@@ -0,0 +1,195 @@
+package lsp
+
+import (
+	"go/token"
+	"testing"
+)
+
+const (
+	utf8Enc  = PositionEncodingKindUTF8
+	utf16Enc = PositionEncodingKindUTF16
+	utf32Enc = PositionEncodingKindUTF32
+)
+
+func TestNegotiatePositionEncoding(t *testing.T) {
+	tests := []struct {
+		client    []PositionEncodingKind
+		supported []PositionEncodingKind
+		want      PositionEncodingKind
+	}{
+		{nil, nil, utf16Enc},
+		{[]PositionEncodingKind{utf32Enc}, nil, utf32Enc},
+		{[]PositionEncodingKind{"x", utf8Enc, utf16Enc}, nil, utf8Enc},
+		{[]PositionEncodingKind{utf32Enc, utf8Enc}, []PositionEncodingKind{utf8Enc, utf16Enc}, utf8Enc},
+		{[]PositionEncodingKind{utf32Enc}, []PositionEncodingKind{utf8Enc}, utf16Enc},
+		{[]PositionEncodingKind{"x"}, []PositionEncodingKind{"x"}, utf16Enc},
+	}
+	for _, tt := range tests {
+		if got := NegotiatePositionEncoding(tt.client, tt.supported...); got != tt.want {
+			t.Errorf("NegotiatePositionEncoding(%q, %q) = %s, want %s", tt.client, tt.supported, got, tt.want)
+		}
+	}
+
+	f, err := NewClientFeatures(&ClientCapabilities{General: &GeneralClientCapabilities{
+		PositionEncodings: []PositionEncodingKind{utf8Enc},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := f.NegotiatePositionEncoding(); got != utf8Enc {
+		t.Errorf("client features: got %s, want %s", got, utf8Enc)
+	}
+}
+
+// sample has a surrogate pair in UTF-16, a CRLF, a lone CR and a two byte
+// character. Its byte offsets are:
+//
+//	a 0, 😀 1-4, b 5, \r\n 6-7, c 8, \r 9, d 10, é 11-12, \n 13, end 14
+const sample = "a😀b\r\nc\rdé\n"
+
+func TestLineIndexOffset(t *testing.T) {
+	tests := []struct {
+		enc    PositionEncodingKind
+		pos    Position
+		offset int
+		back   Position // position of offset
+	}{
+		{utf8Enc, Position{0, 1}, 1, Position{0, 1}},
+		{utf8Enc, Position{0, 3}, 1, Position{0, 1}},
+		{utf8Enc, Position{0, 5}, 5, Position{0, 5}},
+		{utf8Enc, Position{0, 7}, 6, Position{0, 6}},
+		{utf8Enc, Position{2, 2}, 11, Position{2, 1}},
+		{utf8Enc, Position{2, 3}, 13, Position{2, 3}},
+
+		{utf16Enc, Position{0, 1}, 1, Position{0, 1}},
+		{utf16Enc, Position{0, 2}, 1, Position{0, 1}}, // inside the surrogate pair
+		{utf16Enc, Position{0, 3}, 5, Position{0, 3}},
+		{utf16Enc, Position{0, 4}, 6, Position{0, 4}},
+		{utf16Enc, Position{0, 9}, 6, Position{0, 4}},
+		{utf16Enc, Position{1, 0}, 8, Position{1, 0}},
+		{utf16Enc, Position{1, 1}, 9, Position{1, 1}},
+		{utf16Enc, Position{1, 5}, 9, Position{1, 1}},
+		{utf16Enc, Position{2, 2}, 13, Position{2, 2}},
+		{utf16Enc, Position{3, 0}, 14, Position{3, 0}},
+		{utf16Enc, Position{7, 0}, 14, Position{3, 0}},
+		{"", Position{0, 3}, 5, Position{0, 3}},
+
+		{utf32Enc, Position{0, 1}, 1, Position{0, 1}},
+		{utf32Enc, Position{0, 2}, 5, Position{0, 2}},
+		{utf32Enc, Position{0, 3}, 6, Position{0, 3}},
+		{utf32Enc, Position{2, 2}, 13, Position{2, 2}},
+	}
+	for _, tt := range tests {
+		x := NewLineIndex(sample, tt.enc)
+		if got := x.Offset(tt.pos); got != tt.offset {
+			t.Errorf("%s: Offset(%v) = %d, want %d", tt.enc, tt.pos, got, tt.offset)
+		}
+		if got := x.Position(tt.offset); got != tt.back {
+			t.Errorf("%s: Position(%d) = %v, want %v", tt.enc, tt.offset, got, tt.back)
+		}
+	}
+}
+
+func TestLineIndexPosition(t *testing.T) {
+	tests := []struct {
+		enc    PositionEncodingKind
+		offset int
+		want   Position
+	}{
+		{utf8Enc, 7, Position{0, 6}}, // inside CRLF
+		{utf16Enc, 7, Position{0, 4}},
+		{utf32Enc, 7, Position{0, 3}},
+		{utf16Enc, 9, Position{1, 1}}, // lone CR
+		{utf16Enc, 10, Position{2, 0}},
+		{utf16Enc, 2, Position{0, 1}}, // inside 😀
+		{utf8Enc, 12, Position{2, 1}}, // inside é
+		{utf16Enc, -1, Position{0, 0}},
+		{utf16Enc, 100, Position{3, 0}},
+	}
+	for _, tt := range tests {
+		x := NewLineIndex(sample, tt.enc)
+		if got := x.Position(tt.offset); got != tt.want {
+			t.Errorf("%s: Position(%d) = %v, want %v", tt.enc, tt.offset, got, tt.want)
+		}
+	}
+
+	x := NewLineIndex(sample, "")
+	if x.LineCount() != 4 {
+		t.Errorf("got %d lines, want 4", x.LineCount())
+	}
+	for i, want := range []string{"a😀b", "c", "dé", ""} {
+		if got := x.Line(i); got != want {
+			t.Errorf("Line(%d) = %q, want %q", i, got, want)
+		}
+	}
+}
+
+// Runes of sample: a 0, 😀 1, b 2, \r 3, \n 4, c 5, \r 6, d 7, é 8, \n 9, end 10.
+func TestLineIndexRunes(t *testing.T) {
+	tests := []struct {
+		pos  Position // in UTF-16
+		rune int
+		back Position // position of rune
+	}{
+		{Position{0, 0}, 0, Position{0, 0}},
+		{Position{0, 2}, 1, Position{0, 1}},
+		{Position{0, 3}, 2, Position{0, 3}},
+		{Position{1, 0}, 5, Position{1, 0}},
+		{Position{2, 2}, 9, Position{2, 2}},
+		{Position{3, 0}, 10, Position{3, 0}},
+		{Position{9, 0}, 10, Position{3, 0}},
+	}
+	x := NewLineIndex(sample, utf16Enc)
+	for _, tt := range tests {
+		if got := x.RuneOffset(tt.pos); got != tt.rune {
+			t.Errorf("RuneOffset(%v) = %d, want %d", tt.pos, got, tt.rune)
+		}
+		if got := x.RunePosition(tt.rune); got != tt.back {
+			t.Errorf("RunePosition(%d) = %v, want %v", tt.rune, got, tt.back)
+		}
+	}
+	if got := x.RunePosition(4); got != (Position{0, 4}) {
+		t.Errorf("RunePosition(4) = %v, want end of line 0", got)
+	}
+	if got := x.RunePosition(100); got != (Position{3, 0}) {
+		t.Errorf("RunePosition(100) = %v, want end of text", got)
+	}
+}
+
+func TestLineIndexTokenPosition(t *testing.T) {
+	tests := []struct {
+		pos Position // in UTF-16
+		tp  token.Position
+	}{
+		{Position{0, 0}, token.Position{Filename: "f", Offset: 0, Line: 1, Column: 1}},
+		{Position{0, 3}, token.Position{Filename: "f", Offset: 5, Line: 1, Column: 6}},
+		{Position{1, 1}, token.Position{Filename: "f", Offset: 9, Line: 2, Column: 2}},
+		{Position{2, 2}, token.Position{Filename: "f", Offset: 13, Line: 3, Column: 4}},
+		{Position{3, 0}, token.Position{Filename: "f", Offset: 14, Line: 4, Column: 1}},
+	}
+	x := NewLineIndex(sample, utf16Enc)
+	for _, tt := range tests {
+		tp := x.TokenPosition("f", tt.pos)
+		if tp != tt.tp {
+			t.Errorf("TokenPosition(%v) = %v, want %v", tt.pos, tp, tt.tp)
+		}
+		if got := x.FromTokenPosition(tp); got != tt.pos {
+			t.Errorf("FromTokenPosition(%v) = %v, want %v", tp, got, tt.pos)
+		}
+	}
+
+	from := []struct {
+		tp   token.Position
+		want Position
+	}{
+		{token.Position{Offset: 11}, Position{2, 1}},
+		{token.Position{Line: 3, Column: 100}, Position{2, 2}},
+		{token.Position{Line: 2, Column: 0}, Position{1, 0}},
+		{token.Position{Line: 9, Column: 1, Offset: 5}, Position{0, 3}},
+	}
+	for _, tt := range from {
+		if got := x.FromTokenPosition(tt.tp); got != tt.want {
+			t.Errorf("FromTokenPosition(%+v) = %v, want %v", tt.tp, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,353 @@
+package lsp
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// maxGlobAlternatives limits the number of alternatives a glob pattern
+// expands to.
+const maxGlobAlternatives = 1024
+
+// A Glob is a compiled glob pattern as used by document filters, file
+// system watchers and file operation filters. The syntax is:
+//
+//   - * matches zero or more characters in a path segment
+//   - ? matches one character in a path segment
+//   - ** matches any number of path segments, including none
+//   - {a,b} matches one of the alternatives, which may contain patterns
+//   - [a-z] matches a character of a range in a path segment
+//   - [!a-z] matches a character not in a range in a path segment
+//
+// A ** not forming a whole path segment matches like *. Paths are
+// separated by forward slashes.
+type Glob struct {
+	pattern    string
+	base       string // of relative patterns, without trailing slash
+	ignoreCase bool
+	kind       FileOperationPatternKind
+	re         *regexp.Regexp
+}
+
+// CompileGlob compiles a glob pattern. If ignoreCase is set, the pattern
+// matches paths regardless of their case.
+func CompileGlob(pattern string, ignoreCase bool) (*Glob, error) {
+	alts, err := expandBraces(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid glob pattern %q: %w", pattern, err)
+	}
+	var b strings.Builder
+	b.WriteString("(?s")
+	if ignoreCase {
+		b.WriteString("i")
+	}
+	b.WriteString(")^(?:")
+	for i, alt := range alts {
+		if i > 0 {
+			b.WriteString("|")
+		}
+		if err := translateGlob(&b, alt); err != nil {
+			return nil, fmt.Errorf("invalid glob pattern %q: %w", pattern, err)
+		}
+	}
+	b.WriteString(")$")
+	re, err := regexp.Compile(b.String())
+	if err != nil {
+		return nil, fmt.Errorf("invalid glob pattern %q: %w", pattern, err)
+	}
+	return &Glob{pattern: pattern, ignoreCase: ignoreCase, re: re}, nil
+}
+
+// CompileGlobPattern compiles a glob pattern of a file system watcher,
+// which is either a Pattern or a RelativePattern. The pattern of a
+// RelativePattern is matched against paths relative to its base URI or
+// workspace folder.
+func CompileGlobPattern(p GlobPattern) (*Glob, error) {
+	switch v := p.Value.(type) {
+	case Pattern:
+		return CompileGlob(string(v), false)
+	case string:
+		return CompileGlob(v, false)
+	case RelativePattern:
+		return compileRelative(v)
+	case *RelativePattern:
+		return compileRelative(*v)
+	}
+	return nil, fmt.Errorf("invalid glob pattern of type %T", p.Value)
+}
+
+func compileRelative(p RelativePattern) (*Glob, error) {
+	var base URI
+	switch v := p.BaseUri.Value.(type) {
+	case URI:
+		base = v
+	case string:
+		base = URI(v)
+	case WorkspaceFolder:
+		base = v.Uri
+	case *WorkspaceFolder:
+		base = v.Uri
+	default:
+		return nil, fmt.Errorf("invalid base URI of type %T", p.BaseUri.Value)
+	}
+	g, err := CompileGlob(string(p.Pattern), false)
+	if err != nil {
+		return nil, err
+	}
+	g.base = strings.TrimSuffix(matchPath(DocumentURI(base)), "/")
+	return g, nil
+}
+
+// CompileFileOperationPattern compiles the pattern of a file operation
+// filter. The pattern ignores case, if requested by its options, and
+// matches files or folders only, if requested by its kind.
+func CompileFileOperationPattern(p FileOperationPattern) (*Glob, error) {
+	g, err := CompileGlob(p.Glob, p.Options != nil && value(p.Options.IgnoreCase))
+	if err != nil {
+		return nil, err
+	}
+	g.kind = value(p.Matches)
+	return g, nil
+}
+
+// String returns the pattern of g.
+func (g *Glob) String() string {
+	return g.pattern
+}
+
+// Match reports whether g matches path. Paths of relative patterns must be
+// below the base of the pattern.
+func (g *Glob) Match(path string) bool {
+	path = filepath.ToSlash(path)
+	if g.base != "" {
+		n := len(g.base)
+		if len(path) <= n || path[n] != '/' || !g.equal(path[:n], g.base) {
+			return false
+		}
+		path = path[n+1:]
+	}
+	return g.re.MatchString(path)
+}
+
+// MatchURI reports whether g matches the path of uri. The paths of file
+// URIs are file system paths.
+func (g *Glob) MatchURI(uri DocumentURI) bool {
+	return g.Match(matchPath(uri))
+}
+
+// MatchFile reports whether g matches path, which is a folder if isDir is
+// set. Patterns of file operations match files or folders only, if their
+// kind requests it.
+func (g *Glob) MatchFile(path string, isDir bool) bool {
+	return matchKind(g.kind, isDir) && g.Match(path)
+}
+
+// matchKind reports whether a file or folder is matched by a pattern of
+// the given kind.
+func matchKind(kind FileOperationPatternKind, isDir bool) bool {
+	switch kind {
+	case FileOperationPatternKindFile:
+		return !isDir
+	case FileOperationPatternKindFolder:
+		return isDir
+	}
+	return true
+}
+
+func (g *Glob) equal(a, b string) bool {
+	if g.ignoreCase {
+		return strings.EqualFold(a, b)
+	}
+	return a == b
+}
+
+// Match reports whether the filter matches the file or folder uri.
+func (f FileOperationFilter) Match(uri DocumentURI, isDir bool) bool {
+	if f.Scheme != nil && *f.Scheme != uriScheme(uri) {
+		return false
+	}
+	g, ok := cachedGlob(f.Pattern.Glob, f.Pattern.Options != nil && value(f.Pattern.Options.IgnoreCase))
+	return ok && matchKind(value(f.Pattern.Matches), isDir) && g.MatchURI(uri)
+}
+
+// globs caches compiled glob patterns of filters.
+var globs sync.Map // map[globKey]*Glob
+
+type globKey struct {
+	pattern    string
+	ignoreCase bool
+}
+
+// cachedGlob returns the compiled glob pattern. It reports false for
+// invalid patterns.
+func cachedGlob(pattern string, ignoreCase bool) (*Glob, bool) {
+	k := globKey{pattern, ignoreCase}
+	g, ok := globs.Load(k)
+	if !ok {
+		c, _ := CompileGlob(pattern, ignoreCase)
+		g, _ = globs.LoadOrStore(k, c)
+	}
+	return g.(*Glob), g.(*Glob) != nil
+}
+
+// matchGlob reports whether path matches the glob pattern. Invalid
+// patterns match nothing.
+func matchGlob(pattern, path string) bool {
+	g, ok := cachedGlob(pattern, false)
+	return ok && g.Match(path)
+}
+
+// expandBraces returns the alternatives of pattern without braces. Braces
+// may nest. Braces within character ranges are literals.
+func expandBraces(pattern string) ([]string, error) {
+	open := -1
+	for i := 0; i < len(pattern); i++ {
+		switch pattern[i] {
+		case '[':
+			if _, n, err := globClass(pattern[i:]); err == nil && n > 0 {
+				i += n - 1
+			}
+		case '{':
+			open = i
+		}
+		if open >= 0 {
+			break
+		}
+	}
+	if open < 0 {
+		return []string{pattern}, nil
+	}
+
+	var alts []string
+	depth, start := 0, open+1
+	for i := open; i < len(pattern); i++ {
+		switch pattern[i] {
+		case '[':
+			if _, n, err := globClass(pattern[i:]); err == nil && n > 0 {
+				i += n - 1
+			}
+		case '{':
+			depth++
+		case ',':
+			if depth == 1 {
+				alts = append(alts, pattern[start:i])
+				start = i + 1
+			}
+		case '}':
+			if depth--; depth > 0 {
+				break
+			}
+			alts = append(alts, pattern[start:i])
+			var res []string
+			for _, alt := range alts {
+				exp, err := expandBraces(pattern[:open] + alt + pattern[i+1:])
+				if err != nil {
+					return nil, err
+				}
+				res = append(res, exp...)
+				if len(res) > maxGlobAlternatives {
+					return nil, fmt.Errorf("more than %d alternatives", maxGlobAlternatives)
+				}
+			}
+			return res, nil
+		}
+	}
+	return nil, fmt.Errorf("unclosed {")
+}
+
+// translateGlob writes the regular expression of a pattern without braces
+// to b.
+func translateGlob(b *strings.Builder, pattern string) error {
+	for i := 0; i < len(pattern); {
+		c := pattern[i]
+		segmentStart := i == 0 || pattern[i-1] == '/'
+		switch {
+		case segmentStart && strings.HasPrefix(pattern[i:], "**/"):
+			b.WriteString("(?:.*/)?")
+			i += 3
+		case segmentStart && pattern[i:] == "**":
+			b.WriteString(".*")
+			i += 2
+		case pattern[i:] == "/**":
+			b.WriteString("(?:/.*)?")
+			i += 3
+		case c == '*':
+			b.WriteString("[^/]*")
+			i++
+			if segmentStart && i < len(pattern) && pattern[i] == '*' {
+				i++ // ** within a segment
+			}
+		case c == '?':
+			b.WriteString("[^/]")
+			i++
+		case c == '[':
+			class, n, err := globClass(pattern[i:])
+			if err != nil {
+				return err
+			}
+			if n == 0 {
+				b.WriteString(`\[`)
+				i++
+				break
+			}
+			b.WriteString(class)
+			i += n
+		default:
+			_, size := utf8.DecodeRuneInString(pattern[i:])
+			b.WriteString(regexp.QuoteMeta(pattern[i : i+size]))
+			i += size
+		}
+	}
+	return nil
+}
+
+// globClass translates the character range at the start of s, returning
+// the regular expression and the length of the range. A length of 0 means
+// s does not start with a complete range and the [ is a literal.
+//
+// A range consists of characters and intervals like a-z. A leading ] and a
+// leading or trailing - are literals. A leading ! or ^ negates the range.
+// Negated ranges never match /.
+func globClass(s string) (string, int, error) {
+	i := 1
+	negate := i < len(s) && (s[i] == '!' || s[i] == '^')
+	if negate {
+		i++
+	}
+	start := i
+	if i < len(s) && s[i] == ']' {
+		i++
+	}
+	for i < len(s) && s[i] != ']' {
+		i++
+	}
+	if i >= len(s) {
+		return "", 0, nil
+	}
+
+	var b strings.Builder
+	b.WriteString("[")
+	if negate {
+		b.WriteString("^/")
+	}
+	rs := []rune(s[start:i])
+	for j := 0; j < len(rs); j++ {
+		lo := rs[j]
+		if j+2 < len(rs) && rs[j+1] == '-' {
+			hi := rs[j+2]
+			if hi < lo {
+				return "", 0, fmt.Errorf("invalid range %c-%c", lo, hi)
+			}
+			fmt.Fprintf(&b, `\x{%x}-\x{%x}`, lo, hi)
+			j += 2
+			continue
+		}
+		fmt.Fprintf(&b, `\x{%x}`, lo)
+	}
+	b.WriteString("]")
+	return b.String(), i + 1, nil
+}
@@ -0,0 +1,278 @@
+package lsp
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+var globTests = []struct {
+	pattern string
+	path    string
+	want    bool
+}{
+	{"*.go", "main.go", true},
+	{"*.go", "a/main.go", false},
+	{"*.go", ".go", true},
+	{"**/*.go", "main.go", true},
+	{"**/*.go", "/a/b/main.go", true},
+	{"**/*.go", "a/main.gox", false},
+	{"/a/*.go", "/a/main.go", true},
+	{"/a/*.go", "/a/b/main.go", false},
+	{"a/**", "a", true},
+	{"a/**", "a/b/c", true},
+	{"a/**", "ab", false},
+	{"a/**/b", "a/b", true},
+	{"a/**/b", "a/x/y/b", true},
+	{"a/**/b", "ax/b", false},
+	{"a**b", "axxb", true},
+	{"a**b", "ax/b", false},
+	{"**", "", true},
+	{"**", "a/b/c", true},
+	{"a?c", "abc", true},
+	{"a?c", "a/c", false},
+	{"a?c", "aéc", true},
+	{"a?c", "ac", false},
+	{"**/*.{ts,js}", "src/a.ts", true},
+	{"**/*.{ts,js}", "src/a.js", true},
+	{"**/*.{ts,js}", "src/a.jsx", false},
+	{"{**/a,b/*}", "x/y/a", true},
+	{"{**/a,b/*}", "b/c", true},
+	{"{**/a,b/*}", "c/b/c", false},
+	{"x{**/a,b}", "x/y/a", false},
+	{"x{**/a,b}", "xy/a", true},
+	{"{a,{b,c}d}", "cd", true},
+	{"{a,{b,c}d}", "c", false},
+	{"{,a}b", "b", true},
+	{"example.[0-9]", "example.0", true},
+	{"example.[0-9]", "example.a", false},
+	{"example.[!0-9]", "example.a", true},
+	{"example.[!0-9]", "example.0", false},
+	{"a[!b]c", "a/c", false},
+	{"[]]", "]", true},
+	{"[a-]", "-", true},
+	{"[{,]", ",", true},
+	{"[é-ë]", "ê", true},
+	{"[a", "[a", true},
+	{"a}", "a}", true},
+	{"a,b", "a,b", true},
+	{"a.b", "axb", false},
+	{"(a|b)+", "(a|b)+", true},
+}
+
+func TestGlob(t *testing.T) {
+	for _, tt := range globTests {
+		g, err := CompileGlob(tt.pattern, false)
+		if err != nil {
+			t.Errorf("CompileGlob(%q): %v", tt.pattern, err)
+			continue
+		}
+		if got := g.Match(tt.path); got != tt.want {
+			t.Errorf("%q.Match(%q) = %v, want %v", tt.pattern, tt.path, got, tt.want)
+		}
+		if got := refGlob(tt.pattern, tt.path); got != tt.want {
+			t.Errorf("refGlob(%q, %q) = %v, want %v", tt.pattern, tt.path, got, tt.want)
+		}
+	}
+
+	for _, p := range []string{"{a", "{a,{b}", "[z-a]"} {
+		if _, err := CompileGlob(p, false); err == nil {
+			t.Errorf("CompileGlob(%q) succeeded", p)
+		}
+	}
+}
+
+func TestGlobIgnoreCase(t *testing.T) {
+	g, err := CompileFileOperationPattern(FileOperationPattern{
+		Glob:    "**/*.{TS,js}",
+		Matches: ptr(FileOperationPatternKindFile),
+		Options: &FileOperationPatternOptions{IgnoreCase: ptr(true)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !g.MatchFile("/src/A.ts", false) || !g.MatchFile("/src/b.JS", false) {
+		t.Error("case ignored files not matched")
+	}
+	if g.MatchFile("/src/a.ts", true) {
+		t.Error("folder matched by file pattern")
+	}
+
+	f := FileOperationFilter{Scheme: ptr("file"), Pattern: FileOperationPattern{Glob: "**/*.ts"}}
+	if !f.Match("file:///src/a.ts", false) || f.Match("file:///src/A.TS", false) || f.Match("untitled:a.ts", false) {
+		t.Error("file operation filter")
+	}
+}
+
+func TestRelativePattern(t *testing.T) {
+	for _, base := range []interface{}{
+		URI("file:///work/space/"),
+		WorkspaceFolder{Uri: "file:///work/space", Name: "space"},
+	} {
+		g, err := CompileGlobPattern(GlobPattern{Value: RelativePattern{
+			BaseUri: Or_WorkspaceFolder_URI{Value: base},
+			Pattern: "**/*.go",
+		}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !g.MatchURI("file:///work/space/a/b.go") || !g.Match("/work/space/b.go") {
+			t.Errorf("%v: files below base not matched", base)
+		}
+		if g.MatchURI("file:///work/other/b.go") || g.Match("/work/spaceb.go") {
+			t.Errorf("%v: files outside base matched", base)
+		}
+	}
+}
+
+// refGlob is a backtracking reference implementation of glob matching.
+func refGlob(pattern, path string) bool {
+	for _, alt := range refExpand(pattern) {
+		if refMatch(alt, path) {
+			return true
+		}
+	}
+	return false
+}
+
+// refExpand expands the braces of pattern.
+func refExpand(pattern string) []string {
+	depth, open, start := 0, 0, 0
+	var alts []string
+	for i := 0; i < len(pattern); i++ {
+		switch pattern[i] {
+		case '[':
+			if n := refClassLen(pattern[i:]); n > 0 {
+				i += n - 1
+			}
+		case '{':
+			if depth == 0 {
+				open, start = i, i+1
+			}
+			depth++
+		case ',':
+			if depth == 1 {
+				alts = append(alts, pattern[start:i])
+				start = i + 1
+			}
+		case '}':
+			if depth == 0 {
+				break
+			}
+			if depth--; depth == 0 {
+				var res []string
+				for _, alt := range append(alts, pattern[start:i]) {
+					res = append(res, refExpand(pattern[:open]+alt+pattern[i+1:])...)
+				}
+				return res
+			}
+		}
+	}
+	return []string{pattern}
+}
+
+// refClassLen returns the length of the character range at the start of s.
+func refClassLen(s string) int {
+	i := 1
+	if i < len(s) && (s[i] == '!' || s[i] == '^') {
+		i++
+	}
+	if i < len(s) && s[i] == ']' {
+		i++
+	}
+	if j := strings.IndexByte(s[i:], ']'); j >= 0 {
+		return i + j + 1
+	}
+	return 0
+}
+
+// refMatch matches a pattern without braces. Results are memoized, so
+// patterns with many stars take polynomial time.
+func refMatch(pattern, path string) bool {
+	type state struct {
+		p, s         int
+		segmentStart bool
+	}
+	memo := make(map[state]bool)
+	var match func(p, s string, segmentStart bool) bool
+	match = func(p, s string, segmentStart bool) bool {
+		k := state{len(p), len(s), segmentStart}
+		if m, ok := memo[k]; ok {
+			return m
+		}
+		m := false
+		switch {
+		case p == "":
+			m = s == ""
+		case segmentStart && strings.HasPrefix(p, "**/"):
+			m = match(p[3:], s, true)
+			for i := 0; i < len(s) && !m; i++ {
+				m = s[i] == '/' && match(p[3:], s[i+1:], true)
+			}
+		case segmentStart && p == "**":
+			m = true
+		case p == "/**":
+			m = s == "" || s[0] == '/'
+		case p[0] == '*':
+			for i := 0; !m; i++ {
+				m = match(p[1:], s[i:], false)
+				if i == len(s) || s[i] == '/' {
+					break
+				}
+			}
+		case p[0] == '?':
+			r, size := utf8.DecodeRuneInString(s)
+			m = s != "" && r != '/' && match(p[1:], s[size:], false)
+		case p[0] == '[' && refClassLen(p) > 0:
+			n := refClassLen(p)
+			r, size := utf8.DecodeRuneInString(s)
+			m = s != "" && refClass(p[:n], r) && match(p[n:], s[size:], false)
+		default:
+			m = s != "" && s[0] == p[0] && match(p[1:], s[1:], p[0] == '/')
+		}
+		memo[k] = m
+		return m
+	}
+	return match(pattern, path, true)
+}
+
+// refClass reports whether the character range class matches r.
+func refClass(class string, r rune) bool {
+	class = class[1 : len(class)-1]
+	negate := class[0] == '!' || class[0] == '^'
+	if negate {
+		class = class[1:]
+	}
+	rs := []rune(class)
+	match := false
+	for i := 0; i < len(rs); i++ {
+		if i+2 < len(rs) && rs[i+1] == '-' {
+			match = match || rs[i] <= r && r <= rs[i+2]
+			i += 2
+			continue
+		}
+		match = match || rs[i] == r
+	}
+	if negate {
+		return !match && r != '/'
+	}
+	return match
+}
+
+func FuzzGlob(f *testing.F) {
+	for _, tt := range globTests {
+		f.Add(tt.pattern, tt.path)
+	}
+	f.Fuzz(func(t *testing.T, pattern, path string) {
+		if !utf8.ValidString(pattern) || !utf8.ValidString(path) || len(pattern) > 64 || len(path) > 256 {
+			return
+		}
+		g, err := CompileGlob(pattern, false)
+		if err != nil {
+			return
+		}
+		if got, want := g.Match(path), refGlob(pattern, path); got != want {
+			t.Fatalf("%q.Match(%q) = %v, reference %v", pattern, path, got, want)
+		}
+	})
+}
@@ -0,0 +1,53 @@
+package lsp
+
+import "context"
+
+// Client is the interface of language clients as seen by a server. It has a
+// method for every request and notification sent from the server to the
+// client.
+type Client interface {
+	{{- range $i, $m := client}}{{with .Doc}}{{if $i}}
+	{{end}}
+	{{comment .}}{{end}}
+	{{template "signature" .}}
+	{{- end}}
+}
+
+// A Caller sends requests and notifications to the other end of a
+// connection.
+type Caller interface {
+	// Call sends a request and waits for the response. The result of the
+	// response is decoded into result, unless result is nil.
+	Call(ctx context.Context, method string, params interface{}, result interface{}) error
+
+	// Notify sends a notification.
+	Notify(ctx context.Context, method string, params interface{}) error
+}
+
+// NewClient returns a Client sending its requests and notifications to c.
+func NewClient(c Caller) Client {
+	return &client{c}
+}
+
+type client struct {
+	conn Caller
+}
+
+{{range client}}
+	func (c *client) {{template "signature" .}} {
+		{{- if eq .Kind "Notification"}}
+		return c.conn.Notify(ctx, Method{{.Name}}, {{if ne .Params "Null"}}params{{else}}nil{{end}})
+		{{- else if eq .Result "Null"}}
+		return c.conn.Call(ctx, Method{{.Name}}, {{if ne .Params "Null"}}params{{else}}nil{{end}}, nil)
+		{{- else}}
+		var result {{.Result}}
+		err := c.conn.Call(ctx, Method{{.Name}}, {{if ne .Params "Null"}}params{{else}}nil{{end}}, &result)
+		return result, err
+		{{- end}}
+	}
+{{end}}
+
+{{define "signature"}}
+	{{- .Func}}(ctx context.Context{{if ne .Params "Null"}}, params {{.Params}}{{end}})
+	{{- if or (eq .Kind "Notification") (eq .Result "Null")}} error{{else}} ({{.Result}}, error){{end}}
+{{- end}}
@@ -1,23 +1,167 @@
 package lsp
 
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// A URI is a uniform resource identifier as defined by RFC 3986.
+type URI string
+
+// A DocumentURI is the URI of a document. Clients and servers use it to
+// identify text documents and notebooks.
+type DocumentURI string
+
 {{range .TypeAliases}}
-	{{ $base := type .Type}}
-	{{ $typ := title .Name }}
+	{{ $typ := exported .Name }}
+	{{with sum .}}{{template "sum" .}}{{else}}
 	{{with .Documentation}}{{comment .}}{{end}}
-	type {{$typ}} {{$base}}
+	{{with override .Name}}type {{$typ}} = {{.}}{{else}}type {{$typ}} {{type .Type $typ}}{{end}}
+	{{end}}
 {{end}}
 
 {{range .Enumerations}}
 	{{ $base := type .Type }}
-	{{ $typ := title .Name }}
+	{{ $typ := exported .Name }}
 	{{with .Documentation}}{{comment .}}{{end}}
 	type {{$typ}} {{$base}}
 	const (
 		{{range .Values -}}{{with .Documentation}}
 
 		{{comment .}}{{end}}
-		{{ title .Name}} {{ $typ }} = {{if eq $base "string"}}{{printf "%q" .Value}}{{else}}{{.Value}}{{end}}
+		{{constant $typ .Name}} {{ $typ }} = {{if eq $base "string"}}{{printf "%q" .Value}}{{else}}{{.Value}}{{end}}
 		{{- end}}
 	)
 {{end}}
 
+{{range .Structures}}
+	{{if override .Name}}
+	{{with .Documentation}}{{comment .}}{{end}}
+	type {{exported .Name}} = {{override .Name}}
+	{{else}}{{template "struct" structure .}}{{end}}
+{{end}}
+
+{{range structs}}{{template "struct" .}}{{end}}
+
+{{range sums}}{{template "sum" .}}{{end}}
+
+{{define "struct"}}
+	{{with .Doc}}{{comment .}}{{end}}
+	type {{.Name}} struct {
+		{{- range .Embeds}}
+		{{.}}
+		{{- end}}
+		{{- range $i, $f := .Fields}}{{with .Doc}}{{if $i}}
+		{{end}}
+		{{comment .}}{{end}}
+		{{.Name}} {{.Type}} {{.Tag}}
+		{{- end}}
+	}
+{{end}}
+
+{{define "sum"}}
+	{{with .Doc}}{{comment .}}{{else}}// {{.Name}} holds a value of one of several types.{{end}}
+	type {{.Name}} struct {
+		// Value is one of {{range $i, $v := .Variants}}{{if $i}}, {{end}}{{.Type}}{{end}}.
+		Value interface{}
+	}
+
+	// MarshalJSON implements json.Marshaler.
+	func (t {{.Name}}) MarshalJSON() ([]byte, error) {
+		return json.Marshal(t.Value)
+	}
+
+	// UnmarshalJSON implements json.Unmarshaler. The type of the value is
+	// determined by the shape of the JSON value.
+	func (t *{{.Name}}) UnmarshalJSON(b []byte) error {
+		if isNull(b) {
+			t.Value = nil
+			return nil
+		}
+		{{- range .Variants}}
+		{{if ne .Guard "true"}}if {{.Guard}} {{end}}{
+			var v {{.Type}}
+			if err := json.Unmarshal(b, &v); err == nil {
+				t.Value = v
+				return nil
+			}
+		}
+		{{- end}}
+		return fmt.Errorf("cannot unmarshal %s into {{.Name}}", b)
+	}
+{{end}}
+
+// firstByte returns the first non-whitespace byte of a JSON value.
+func firstByte(b []byte) byte {
+	for _, c := range b {
+		switch c {
+		case ' ', '\t', '\r', '\n':
+		default:
+			return c
+		}
+	}
+	return 0
+}
+
+func isNull(b []byte) bool   { return firstByte(b) == 'n' }
+func isString(b []byte) bool { return firstByte(b) == '"' }
+func isObject(b []byte) bool { return firstByte(b) == '{' }
+
+func isBool(b []byte) bool {
+	c := firstByte(b)
+	return c == 't' || c == 'f'
+}
+
+func isNumber(b []byte) bool {
+	c := firstByte(b)
+	return c == '-' || '0' <= c && c <= '9'
+}
+
+func isInteger(b []byte) bool {
+	return isNumber(b) && !bytes.ContainsAny(b, ".eE")
+}
+
+// isValue reports whether b is the JSON literal v.
+func isValue(b []byte, v string) bool {
+	return string(bytes.TrimSpace(b)) == v
+}
+
+// isArrayOf reports whether b is an array, which is either empty or whose
+// first element satisfies elem.
+func isArrayOf(b []byte, elem func(b []byte) bool) bool {
+	if firstByte(b) != '[' {
+		return false
+	}
+	var elems []json.RawMessage
+	if err := json.Unmarshal(b, &elems); err != nil {
+		return false
+	}
+	return len(elems) == 0 || elem(elems[0])
+}
+
+// hasFields reports whether b is an object containing all given fields.
+func hasFields(b []byte, fields ...string) bool {
+	if !isObject(b) {
+		return false
+	}
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return false
+	}
+	for _, f := range fields {
+		if _, ok := m[f]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// hasValue reports whether b is an object whose field is the JSON literal v.
+func hasValue(b []byte, field string, v string) bool {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return false
+	}
+	return isValue(m[field], v)
+}
@@ -0,0 +1,125 @@
+package lsp
+
+import "encoding/json"
+
+// MessageDirection is the direction in which a request or notification is
+// sent.
+type MessageDirection string
+
+const (
+	MessageDirectionClientToServer MessageDirection = "clientToServer"
+	MessageDirectionServerToClient MessageDirection = "serverToClient"
+	MessageDirectionBoth           MessageDirection = "both"
+)
+
+// Null is the type of absent parameters and results. It is encoded as JSON
+// null.
+type Null struct{}
+
+// MarshalJSON implements json.Marshaler.
+func (Null) MarshalJSON() ([]byte, error) {
+	return []byte("null"), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (*Null) UnmarshalJSON([]byte) error {
+	return nil
+}
+
+// The method names of all requests and notifications.
+const (
+	{{- range methods}}{{with .Doc}}
+
+	{{comment .}}{{end}}
+	Method{{.Name}} = {{printf "%q" .Method}}
+	{{- end}}
+)
+
+// A RequestType describes a request and the Go types of its parameters,
+// result, partial result, error data and registration options.
+type RequestType[Params, Result, PartialResult, ErrorData, RegistrationOptions any] struct {
+	// Method is the method name of the request.
+	Method string
+
+	// RegistrationMethod is the method used to register the request
+	// dynamically.
+	RegistrationMethod string
+
+	// Direction is the direction in which the request is sent.
+	Direction MessageDirection
+}
+
+// DecodeParams decodes the parameters of the request.
+func (RequestType[P, R, PR, E, O]) DecodeParams(b json.RawMessage) (P, error) {
+	return decode[P](b)
+}
+
+// DecodeResult decodes the result of the request.
+func (RequestType[P, R, PR, E, O]) DecodeResult(b json.RawMessage) (R, error) {
+	return decode[R](b)
+}
+
+// DecodePartialResult decodes a partial result of the request.
+func (RequestType[P, R, PR, E, O]) DecodePartialResult(b json.RawMessage) (PR, error) {
+	return decode[PR](b)
+}
+
+// DecodeErrorData decodes the data of an error response to the request.
+func (RequestType[P, R, PR, E, O]) DecodeErrorData(b json.RawMessage) (E, error) {
+	return decode[E](b)
+}
+
+// DecodeRegistrationOptions decodes the options of a registration of the
+// request.
+func (RequestType[P, R, PR, E, O]) DecodeRegistrationOptions(b json.RawMessage) (O, error) {
+	return decode[O](b)
+}
+
+// A NotificationType describes a notification and the Go types of its
+// parameters and registration options.
+type NotificationType[Params, RegistrationOptions any] struct {
+	// Method is the method name of the notification.
+	Method string
+
+	// RegistrationMethod is the method used to register the notification
+	// dynamically.
+	RegistrationMethod string
+
+	// Direction is the direction in which the notification is sent.
+	Direction MessageDirection
+}
+
+// DecodeParams decodes the parameters of the notification.
+func (NotificationType[P, O]) DecodeParams(b json.RawMessage) (P, error) {
+	return decode[P](b)
+}
+
+// DecodeRegistrationOptions decodes the options of a registration of the
+// notification.
+func (NotificationType[P, O]) DecodeRegistrationOptions(b json.RawMessage) (O, error) {
+	return decode[O](b)
+}
+
+// decode decodes a JSON value. Missing values are decoded as zero value.
+func decode[T any](b json.RawMessage) (T, error) {
+	var v T
+	if len(b) == 0 {
+		return v, nil
+	}
+	err := json.Unmarshal(b, &v)
+	return v, err
+}
+
+{{range methods}}
+	{{- if eq .Kind "Request"}}
+	// {{.Name}}Request describes the {{.Method}} request.
+	var {{.Name}}Request = RequestType[{{.Params}}, {{.Result}}, {{.PartialResult}}, {{.ErrorData}}, {{.RegistrationOptions}}]{
+	{{- else}}
+	// {{.Name}}Notification describes the {{.Method}} notification.
+	var {{.Name}}Notification = NotificationType[{{.Params}}, {{.RegistrationOptions}}]{
+	{{- end}}
+		Method:             Method{{.Name}},
+		RegistrationMethod: {{printf "%q" .RegistrationMethod}},
+		Direction:          {{.Direction}},
+	}
+{{end}}
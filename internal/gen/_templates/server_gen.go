@@ -0,0 +1,66 @@
+package lsp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrMethodNotFound is returned by DispatchServer for methods not
+	// handled by the Server interface.
+	ErrMethodNotFound = errors.New("method not found")
+
+	// ErrInvalidParams is returned by DispatchServer if the parameters
+	// of a message cannot be decoded.
+	ErrInvalidParams = errors.New("invalid params")
+)
+
+// Server is the interface implemented by language servers. It has a method
+// for every request and notification sent from the client to the server.
+type Server interface {
+	{{- range $i, $m := server}}{{with .Doc}}{{if $i}}
+	{{end}}
+	{{comment .}}{{end}}
+	{{template "signature" .}}
+	{{- end}}
+}
+
+// DispatchServer decodes the parameters of the request or notification
+// method, invokes the corresponding method of server and returns its
+// encoded result. Notifications have no result.
+func DispatchServer(ctx context.Context, server Server, method string, params json.RawMessage) (json.RawMessage, error) {
+	switch method {
+	{{- range server}}
+	case Method{{.Name}}:
+		{{- if ne .Params "Null"}}
+		p, err := {{.Name}}{{.Kind}}.DecodeParams(params)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrInvalidParams, method, err)
+		}
+		{{- end}}
+		{{- if eq .Kind "Notification"}}
+		return nil, server.{{.Func}}(ctx{{if ne .Params "Null"}}, p{{end}})
+		{{- else if eq .Result "Null"}}
+		return encodeResult(nil, server.{{.Func}}(ctx{{if ne .Params "Null"}}, p{{end}}))
+		{{- else}}
+		return encodeResult(server.{{.Func}}(ctx{{if ne .Params "Null"}}, p{{end}}))
+		{{- end}}
+	{{- end}}
+	}
+	return nil, fmt.Errorf("%w: %s", ErrMethodNotFound, method)
+}
+
+// encodeResult encodes the result v of a request, unless the request failed.
+func encodeResult(v interface{}, err error) (json.RawMessage, error) {
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(v)
+}
+
+{{define "signature"}}
+	{{- .Func}}(ctx context.Context{{if ne .Params "Null"}}, params {{.Params}}{{end}})
+	{{- if or (eq .Kind "Notification") (eq .Result "Null")}} error{{else}} ({{.Result}}, error){{end}}
+{{- end}}
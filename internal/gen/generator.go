@@ -0,0 +1,804 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// overrides maps meta model types to predeclared Go types. The LSP any types
+// are recursive unions, which are better represented by Go's empty interface.
+var overrides = map[string]string{
+	"LSPAny":    "interface{}",
+	"LSPObject": "map[string]interface{}",
+	"LSPArray":  "[]interface{}",
+}
+
+// baseTypes maps the base types of the meta model to Go types.
+var baseTypes = map[BaseTypes]string{
+	BaseTypesURI:         "URI",
+	BaseTypesDocumentUri: "DocumentURI",
+	BaseTypesInteger:     "int32",
+	BaseTypesUinteger:    "uint32",
+	BaseTypesDecimal:     "float64",
+	BaseTypesRegExp:      "string",
+	BaseTypesString:      "string",
+	BaseTypesBoolean:     "bool",
+	BaseTypesNull:        "interface{}",
+}
+
+// predeclared are the names declared by the templates independent of the
+// meta model.
+var predeclared = []string{
+	"URI",
+	"DocumentURI",
+	"Null",
+	"MessageDirection",
+	"MessageDirectionClientToServer",
+	"MessageDirectionServerToClient",
+	"MessageDirectionBoth",
+	"RequestType",
+	"NotificationType",
+	"Server",
+	"DispatchServer",
+	"ErrMethodNotFound",
+	"ErrInvalidParams",
+	"Client",
+	"NewClient",
+	"Caller",
+}
+
+// A generator resolves meta model types into Go types. Anonymous types, like
+// `or` types and structure literals, are given a name and collected, so the
+// templates can emit declarations for them.
+type generator struct {
+	structures map[string]*Structure
+	aliases    map[string]*TypeAlias
+	enums      map[string]*Enumeration
+
+	// decls are the synthesized declarations in order of appearance. An
+	// element is either a *sumType or a *structType.
+	decls []interface{}
+
+	model *MetaModel
+
+	// names maps the names of synthesized declarations to the type they
+	// were declared for.
+	names map[string]interface{}
+
+	// errs are the naming conflicts found while resolving types.
+	errs []string
+}
+
+// A sumType is a struct holding a value of one of several Go types.
+type sumType struct {
+	Name     string
+	Doc      string
+	Variants []variant
+}
+
+// A variant is a possible type of a sumType value.
+type variant struct {
+	Type string
+
+	// Guard is a Go expression reporting whether the JSON value b may be
+	// decoded into Type.
+	Guard string
+
+	// weight orders variants, so that variants with a more specific guard
+	// are tried first.
+	weight int
+}
+
+// A structType is a struct synthesized from a structure literal or an `and`
+// type.
+type structType struct {
+	Name   string
+	Doc    string
+	Embeds []string
+	Fields []field
+}
+
+// A method describes a request or notification for the templates. The
+// types are Go types; absent types are represented by Null.
+type method struct {
+	Name               string
+	Func               string
+	Kind               string
+	Method             string
+	RegistrationMethod string
+	Doc                string
+	Direction          string
+
+	Params              string
+	Result              string
+	PartialResult       string
+	ErrorData           string
+	RegistrationOptions string
+}
+
+// A field is a field of a generated struct.
+type field struct {
+	Name string
+	Type string
+	Tag  string
+	Doc  string
+}
+
+func newGenerator(model *MetaModel) (*generator, error) {
+	g := &generator{
+		model:      model,
+		structures: make(map[string]*Structure),
+		aliases:    make(map[string]*TypeAlias),
+		enums:      make(map[string]*Enumeration),
+		names:      make(map[string]interface{}),
+	}
+	for i := range model.Structures {
+		g.structures[model.Structures[i].Name] = &model.Structures[i]
+	}
+	for i := range model.TypeAliases {
+		g.aliases[model.TypeAliases[i].Name] = &model.TypeAliases[i]
+	}
+	for i := range model.Enumerations {
+		g.enums[model.Enumerations[i].Name] = &model.Enumerations[i]
+	}
+
+	var types []TypeNode
+	for _, a := range model.TypeAliases {
+		types = append(types, node(a.Type))
+	}
+	for _, s := range model.Structures {
+		types = append(types, embeds(&s)...)
+		for _, p := range s.Properties {
+			types = append(types, node(p.Type))
+		}
+	}
+	for _, r := range model.Requests {
+		types = append(types, node(r.Params), node(r.Result), node(r.PartialResult), node(r.ErrorData), node(r.RegistrationOptions))
+	}
+	for _, n := range model.Notifications {
+		types = append(types, node(n.Params), node(n.RegistrationOptions))
+	}
+	for _, t := range types {
+		if t == nil {
+			continue
+		}
+		if err := g.check(t); err != nil {
+			return nil, err
+		}
+	}
+
+	// Resolve all types once up front, so every anonymous type is declared
+	// before the templates are executed.
+	for _, a := range model.TypeAliases {
+		g.alias(a)
+	}
+	for _, s := range model.Structures {
+		g.fields(exported(s.Name), s.Properties)
+	}
+	g.methods()
+	if err := g.checkNames(model); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+// methods returns all requests followed by all notifications.
+func (g *generator) methods() []*method {
+	var methods []*method
+	for _, r := range g.model.Requests {
+		m := g.method("Request", r.Method, r.MessageDirection, r.RegistrationMethod, r.Documentation)
+		m.Params = g.valueType(m.Name+"Params", node(r.Params))
+		m.Result = g.valueType(m.Name+"Result", node(r.Result))
+		m.PartialResult = g.valueType(m.Name+"PartialResult", node(r.PartialResult))
+		m.ErrorData = g.valueType(m.Name+"ErrorData", node(r.ErrorData))
+		m.RegistrationOptions = g.valueType(m.Name+"RegistrationOptions", node(r.RegistrationOptions))
+		methods = append(methods, m)
+	}
+	for _, n := range g.model.Notifications {
+		m := g.method("Notification", n.Method, n.MessageDirection, n.RegistrationMethod, n.Documentation)
+		m.Params = g.valueType(m.Name+"Params", node(n.Params))
+		m.RegistrationOptions = g.valueType(m.Name+"RegistrationOptions", node(n.RegistrationOptions))
+		methods = append(methods, m)
+	}
+	return methods
+}
+
+// handlers returns the methods sent in one of the given directions. Func is
+// set to the name of the Go method handling it: the method name without its
+// leading scope, like Hover for `textDocument/hover`, or the complete method
+// name if the short name is ambiguous.
+func (g *generator) handlers(dirs ...MessageDirection) []*method {
+	var methods []*method
+	count := make(map[string]int)
+	for _, m := range g.methods() {
+		for _, dir := range dirs {
+			if m.Direction == "MessageDirection"+strings.Title(string(dir)) {
+				m.Func = shortName(m.Method)
+				count[m.Func]++
+				methods = append(methods, m)
+			}
+		}
+	}
+	for _, m := range methods {
+		if count[m.Func] > 1 {
+			m.Func = m.Name
+		}
+	}
+	return methods
+}
+
+// server returns the methods handled by servers.
+func (g *generator) server() []*method {
+	return g.handlers(MessageDirectionClientToServer, MessageDirectionBoth)
+}
+
+// client returns the methods handled by clients.
+func (g *generator) client() []*method {
+	return g.handlers(MessageDirectionServerToClient, MessageDirectionBoth)
+}
+
+// method returns the common part of the description of a request or
+// notification.
+func (g *generator) method(kind string, name string, dir MessageDirection, reg *string, doc *string) *method {
+	m := &method{
+		Name:               methodName(name),
+		Kind:               kind,
+		Method:             name,
+		RegistrationMethod: name,
+		Direction:          "MessageDirection" + strings.Title(string(dir)),
+	}
+	if reg != nil {
+		m.RegistrationMethod = *reg
+	}
+	if doc != nil {
+		m.Doc = *doc
+	}
+	return m
+}
+
+// valueType returns the Go type used for parameters and results. Structs
+// are passed as pointers, so they can be nil. Absent types and null are
+// represented by Null.
+func (g *generator) valueType(ctx string, t TypeNode) string {
+	if t == nil || isNull(t) {
+		return "Null"
+	}
+	typ := g.goType(ctx, t)
+	if g.isStruct(t) {
+		typ = "*" + typ
+	}
+	return typ
+}
+
+// methodName turns a method like `textDocument/hover` or `$/cancelRequest`
+// into a Go name.
+func methodName(method string) string {
+	var name string
+	for _, s := range strings.Split(method, "/") {
+		name += strings.Title(strings.TrimPrefix(s, "$"))
+	}
+	return name
+}
+
+// shortName returns the Go name of a method without its scope.
+func shortName(method string) string {
+	if i := strings.Index(method, "/"); i >= 0 {
+		method = method[i+1:]
+	}
+	return methodName(method)
+}
+
+// checkNames reports identifiers declared more than once in the generated
+// package.
+func (g *generator) checkNames(model *MetaModel) error {
+	seen := make(map[string]string)
+	for _, name := range predeclared {
+		seen[name] = "predeclared " + name
+	}
+	declare := func(name, what string) {
+		if prev, ok := seen[name]; ok {
+			g.errs = append(g.errs, fmt.Sprintf("%s is declared for %s and %s", name, prev, what))
+			return
+		}
+		seen[name] = what
+	}
+	for _, a := range model.TypeAliases {
+		declare(exported(a.Name), "type alias "+a.Name)
+	}
+	for _, s := range model.Structures {
+		declare(exported(s.Name), "structure "+s.Name)
+	}
+	for _, e := range model.Enumerations {
+		declare(exported(e.Name), "enumeration "+e.Name)
+		for _, v := range e.Values {
+			declare(constant(e.Name, v.Name), fmt.Sprintf("value %s of enumeration %s", v.Name, e.Name))
+		}
+	}
+	for _, m := range g.methods() {
+		declare("Method"+m.Name, "method "+m.Method)
+		declare(m.Name+m.Kind, "method "+m.Method)
+	}
+	for _, s := range g.sums() {
+		declare(s.Name, "or type")
+	}
+	for _, s := range g.structs() {
+		declare(s.Name, "structure literal")
+	}
+
+	if len(g.errs) > 0 {
+		return fmt.Errorf("name collisions:\n\t%s", strings.Join(g.errs, "\n\t"))
+	}
+	return nil
+}
+
+// exported returns the Go name of a type of the meta model. A leading
+// underscore, as in _InitializeParams, is replaced by an X.
+func exported(name string) string {
+	if strings.HasPrefix(name, "_") {
+		return "X" + name[1:]
+	}
+	return strings.Title(name)
+}
+
+// constant returns the name of the constant for an enumeration value. The
+// name is prefixed by the enumeration, because values like Text or Method
+// are shared by several enumerations.
+func constant(enum string, value string) string {
+	return exported(enum) + strings.Title(value)
+}
+
+// check reports references to undefined types.
+func (g *generator) check(t TypeNode) error {
+	var err error
+	Inspect(t, func(n TypeNode) bool {
+		if r, ok := n.(*ReferenceType); ok && err == nil {
+			_, isStruct := g.structures[r.Name]
+			_, isAlias := g.aliases[r.Name]
+			_, isEnum := g.enums[r.Name]
+			if !isStruct && !isAlias && !isEnum {
+				err = fmt.Errorf("reference to undefined type %s", r.Name)
+			}
+		}
+		return err == nil
+	})
+	return err
+}
+
+// alias returns the sum type of an alias for an `or` type, or nil.
+func (g *generator) alias(a TypeAlias) *sumType {
+	if _, ok := overrides[a.Name]; ok {
+		return nil
+	}
+	items := g.items(node(a.Type))
+	if len(items) < 2 {
+		return nil
+	}
+	s := g.sum(exported(a.Name), items)
+	if a.Documentation != nil {
+		s.Doc = *a.Documentation
+	}
+	return s
+}
+
+// fields returns the fields of a structure or a structure literal. Owner is
+// used to name literals nested in properties. Optional structs and scalars
+// are pointers, so an omitted property can be told apart from its zero
+// value. Nullable properties are pointers, so null can be told apart, too.
+func (g *generator) fields(owner string, props []Property) []field {
+	var fields []field
+	for _, p := range props {
+		name := strings.Title(p.Name)
+		t := node(p.Type)
+		typ := g.goType(owner+name, t)
+		optional := p.Optional != nil && *p.Optional
+		if optional && (g.isStruct(t) || g.isScalar(t)) || g.nullable(t) && typ != "interface{}" {
+			typ = "*" + typ
+		}
+		tag := p.Name
+		if optional {
+			tag += ",omitempty"
+		}
+		f := field{Name: name, Type: typ, Tag: fmt.Sprintf("`json:%q`", tag)}
+		if p.Documentation != nil {
+			f.Doc = *p.Documentation
+		}
+		fields = append(fields, f)
+	}
+	return fields
+}
+
+// goType returns the Go type for t. Ctx names the place t appears in and is
+// used to name anonymous structure literals.
+func (g *generator) goType(ctx string, t TypeNode) string {
+	switch t := t.(type) {
+	case *BaseType:
+		return baseTypes[t.Name]
+	case *ReferenceType:
+		if o, ok := overrides[t.Name]; ok {
+			return o
+		}
+		return exported(t.Name)
+	case *ArrayType:
+		return "[]" + g.goType(ctx, node(t.Element))
+	case *MapType:
+		return "map[" + g.goType(ctx, node(t.Key)) + "]" + g.goType(ctx, node(t.Value))
+	case *TupleType:
+		typ := g.goType(ctx, node(t.Items[0]))
+		for _, item := range t.Items[1:] {
+			if g.goType(ctx, node(item)) != typ {
+				return "[]interface{}"
+			}
+		}
+		return fmt.Sprintf("[%d]%s", len(t.Items), typ)
+	case *StringLiteralType:
+		return "string"
+	case *IntegerLiteralType:
+		return "int32"
+	case *BooleanLiteralType:
+		return "bool"
+	case *StructureLiteralType:
+		return g.literal(ctx, t)
+	case *AndType:
+		return g.and(ctx, t)
+	case *OrType:
+		items := g.items(t)
+		if len(items) == 1 {
+			return g.goType(ctx, items[0])
+		}
+		var names []string
+		for _, item := range items {
+			if _, ok := item.(*StructureLiteralType); ok {
+				return g.sum(ctx, items).Name
+			}
+			names = append(names, typeName(g.goType(ctx, item)))
+		}
+		return g.sum("Or_"+strings.Join(names, "_"), items).Name
+	}
+	panic(fmt.Sprintf("unexpected type %#v", t))
+}
+
+// items returns the items of an `or` type without `null` and without
+// items mapping to the same Go type. Other types are returned as a single
+// item.
+func (g *generator) items(t TypeNode) []TypeNode {
+	or, ok := t.(*OrType)
+	if !ok {
+		return []TypeNode{t}
+	}
+	var items []TypeNode
+	seen := make(map[string]bool)
+	for i, item := range or.Items {
+		item := node(item)
+		if isNull(item) {
+			continue
+		}
+		// Literals are always distinct types. Any name will do for
+		// the check.
+		typ := fmt.Sprintf("literal%d", i)
+		if _, ok := item.(*StructureLiteralType); !ok {
+			typ = g.goType("", item)
+		}
+		if !seen[typ] {
+			seen[typ] = true
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// sum returns the sum type name for the given items, declaring it if
+// necessary.
+func (g *generator) sum(name string, items []TypeNode) *sumType {
+	for _, d := range g.decls {
+		if s, ok := d.(*sumType); ok && s.Name == name {
+			return s
+		}
+	}
+	s := &sumType{Name: name}
+	g.declare(name, items, s)
+	for i, item := range items {
+		ctx := name
+		if lit, ok := item.(*StructureLiteralType); ok {
+			ctx = literalName(name, lit, i)
+		}
+		guard, weight := g.guard(item)
+		s.Variants = append(s.Variants, variant{
+			Type:   g.goType(ctx, item),
+			Guard:  guard,
+			weight: weight,
+		})
+	}
+	sort.SliceStable(s.Variants, func(i, j int) bool {
+		return s.Variants[i].weight > s.Variants[j].weight
+	})
+	return s
+}
+
+// literal declares a struct for a structure literal and returns its name.
+func (g *generator) literal(name string, t *StructureLiteralType) string {
+	if prev, ok := g.names[name]; ok {
+		if prev != t {
+			g.errs = append(g.errs, fmt.Sprintf("%s is declared for different structure literals", name))
+		}
+		return name
+	}
+	s := &structType{Name: name}
+	g.declare(name, t, s)
+	if t.Value.Documentation != nil {
+		s.Doc = *t.Value.Documentation
+	}
+	s.Fields = g.fields(name, t.Value.Properties)
+	return name
+}
+
+// and declares a struct embedding all items of an `and` type and returns
+// its name.
+func (g *generator) and(ctx string, t *AndType) string {
+	var names, embeds []string
+	for _, item := range t.Items {
+		typ := g.goType(ctx, node(item))
+		names = append(names, typeName(typ))
+		embeds = append(embeds, typ)
+	}
+	name := "And_" + strings.Join(names, "_")
+	if _, ok := g.names[name]; !ok {
+		g.declare(name, t, &structType{Name: name, Embeds: embeds})
+	}
+	return name
+}
+
+// declare adds a synthesized declaration for the type t.
+func (g *generator) declare(name string, t interface{}, decl interface{}) {
+	g.names[name] = t
+	g.decls = append(g.decls, decl)
+}
+
+// structure returns the struct declaration for a structure.
+func (g *generator) structure(s Structure) *structType {
+	name := exported(s.Name)
+	st := &structType{Name: name, Fields: g.fields(name, s.Properties)}
+	for _, t := range embeds(&s) {
+		st.Embeds = append(st.Embeds, g.goType(name, t))
+	}
+	if s.Documentation != nil {
+		st.Doc = *s.Documentation
+	}
+	return st
+}
+
+// sums returns the synthesized sum types, except those declared for type
+// aliases.
+func (g *generator) sums() []*sumType {
+	var sums []*sumType
+	for _, d := range g.decls {
+		if s, ok := d.(*sumType); ok {
+			if _, ok := g.aliases[s.Name]; !ok {
+				sums = append(sums, s)
+			}
+		}
+	}
+	return sums
+}
+
+// structs returns the synthesized structs.
+func (g *generator) structs() []*structType {
+	var structs []*structType
+	for _, d := range g.decls {
+		if s, ok := d.(*structType); ok {
+			structs = append(structs, s)
+		}
+	}
+	return structs
+}
+
+// guard returns a Go expression reporting whether a JSON value b may be
+// decoded into t. The weight is higher the more specific the guard is.
+func (g *generator) guard(t TypeNode) (string, int) {
+	switch t := t.(type) {
+	case *BaseType:
+		switch t.Name {
+		case BaseTypesInteger, BaseTypesUinteger:
+			return "isInteger(b)", 0
+		case BaseTypesDecimal:
+			return "isNumber(b)", 0
+		case BaseTypesBoolean:
+			return "isBool(b)", 0
+		default:
+			return "isString(b)", 0
+		}
+	case *StringLiteralType:
+		return fmt.Sprintf("isValue(b, %q)", fmt.Sprintf("%q", t.Value)), 1
+	case *IntegerLiteralType:
+		return fmt.Sprintf("isValue(b, \"%v\")", t.Value), 1
+	case *BooleanLiteralType:
+		return fmt.Sprintf("isValue(b, \"%v\")", t.Value), 1
+	case *ArrayType:
+		elem, weight := g.guard(node(t.Element))
+		return fmt.Sprintf("isArrayOf(b, func(b []byte) bool { return %s })", elem), weight
+	case *TupleType:
+		return "isArrayOf(b, func(b []byte) bool { return true })", 0
+	case *MapType, *AndType:
+		return "isObject(b)", 0
+	case *StructureLiteralType:
+		return hasFields(t.Value.Properties)
+	case *ReferenceType:
+		if _, ok := overrides[t.Name]; ok {
+			return "true", -1
+		}
+		if e, ok := g.enums[t.Name]; ok {
+			if e.Type.Name == EnumerationTypeNameString {
+				return "isString(b)", 0
+			}
+			return "isInteger(b)", 0
+		}
+		if a, ok := g.aliases[t.Name]; ok {
+			if len(g.items(node(a.Type))) > 1 {
+				// Let the alias' own UnmarshalJSON decide.
+				return "true", -1
+			}
+			return g.guard(node(a.Type))
+		}
+		return hasFields(g.properties(t.Name))
+	}
+	return "true", -1
+}
+
+// hasFields returns a guard checking for all required properties. Required
+// properties of a string literal type must have that value, which tells
+// apart structures like CreateFile and DeleteFile.
+func hasFields(props []Property) (string, int) {
+	var names, values []string
+	for _, p := range props {
+		if p.Optional != nil && *p.Optional {
+			continue
+		}
+		names = append(names, fmt.Sprintf("%q", p.Name))
+		if lit, ok := node(p.Type).(*StringLiteralType); ok {
+			values = append(values, fmt.Sprintf("hasValue(b, %q, %q)", p.Name, fmt.Sprintf("%q", lit.Value)))
+		}
+	}
+	if len(names) == 0 {
+		return "isObject(b)", 0
+	}
+	guard := fmt.Sprintf("hasFields(b, %s)", strings.Join(names, ", "))
+	for _, v := range values {
+		guard += " && " + v
+	}
+	return guard, len(names) + len(values)
+}
+
+// properties returns the properties of a structure, including the
+// properties of the structures it extends and mixes in. Properties of the
+// structure itself replace inherited properties of the same name.
+func (g *generator) properties(name string) []Property {
+	s := g.structures[name]
+	var props []Property
+	for _, ref := range embeds(s) {
+		props = append(props, g.properties(ref.(*ReferenceType).Name)...)
+	}
+	for _, p := range s.Properties {
+		i := 0
+		for i < len(props) && props[i].Name != p.Name {
+			i++
+		}
+		if i < len(props) {
+			props[i] = p
+		} else {
+			props = append(props, p)
+		}
+	}
+	return props
+}
+
+// isStruct reports whether t is represented by a Go struct.
+func (g *generator) isStruct(t TypeNode) bool {
+	switch t := t.(type) {
+	case *StructureLiteralType, *AndType:
+		return true
+	case *OrType:
+		items := g.items(t)
+		return len(items) > 1 || g.isStruct(items[0])
+	case *ReferenceType:
+		if _, ok := overrides[t.Name]; ok {
+			return false
+		}
+		if _, ok := g.structures[t.Name]; ok {
+			return true
+		}
+		if a, ok := g.aliases[t.Name]; ok {
+			return g.isStruct(node(a.Type))
+		}
+	}
+	return false
+}
+
+// isScalar reports whether t is represented by a Go basic type, like
+// numbers, strings and enumerations.
+func (g *generator) isScalar(t TypeNode) bool {
+	switch t := t.(type) {
+	case *BaseType:
+		return t.Name != BaseTypesNull
+	case *StringLiteralType, *IntegerLiteralType, *BooleanLiteralType:
+		return true
+	case *OrType:
+		items := g.items(t)
+		return len(items) == 1 && g.isScalar(items[0])
+	case *ReferenceType:
+		if _, ok := overrides[t.Name]; ok {
+			return false
+		}
+		if _, ok := g.enums[t.Name]; ok {
+			return true
+		}
+		if a, ok := g.aliases[t.Name]; ok {
+			return g.isScalar(node(a.Type))
+		}
+	}
+	return false
+}
+
+// nullable reports whether t is an `or` type including `null`.
+func (g *generator) nullable(t TypeNode) bool {
+	if or, ok := t.(*OrType); ok {
+		for _, item := range or.Items {
+			if isNull(node(item)) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func isNull(t TypeNode) bool {
+	b, ok := t.(*BaseType)
+	return ok && b.Name == BaseTypesNull
+}
+
+// node returns the TypeNode stored in a field of the meta model, or nil.
+func node(v interface{}) TypeNode {
+	n, _ := v.(TypeNode)
+	return n
+}
+
+// embeds returns the structures a structure extends and mixes in.
+func embeds(s *Structure) []TypeNode {
+	var refs []TypeNode
+	for _, ref := range s.Extends {
+		refs = append(refs, node(ref))
+	}
+	for _, ref := range s.Mixins {
+		refs = append(refs, node(ref))
+	}
+	return refs
+}
+
+// literalName names the i-th item of an `or` type, if it is a structure
+// literal. The literal is named after its first required property.
+func literalName(ctx string, t *StructureLiteralType, i int) string {
+	for _, p := range t.Value.Properties {
+		if p.Optional == nil || !*p.Optional {
+			return ctx + strings.Title(p.Name)
+		}
+	}
+	return fmt.Sprintf("%s%d", ctx, i+1)
+}
+
+// typeName turns a Go type into a name usable as part of an identifier.
+func typeName(typ string) string {
+	switch {
+	case strings.HasPrefix(typ, "*"):
+		return typeName(typ[1:])
+	case strings.HasPrefix(typ, "[]"):
+		return typeName(typ[2:]) + "Slice"
+	case strings.HasPrefix(typ, "map["):
+		i := strings.Index(typ, "]")
+		return "Map" + typeName(typ[4:i]) + typeName(typ[i+1:])
+	case strings.HasPrefix(typ, "["):
+		i := strings.Index(typ, "]")
+		return typeName(typ[i+1:]) + "Array"
+	case typ == "interface{}":
+		return "Any"
+	}
+	return strings.Title(typ)
+}
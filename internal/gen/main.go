@@ -1,4 +1,5 @@
-// This program reads the TTCN-3 grammar file and generates a parser for it.
+// This program reads the LSP meta model and generates the Go types and
+// method dispatchers of the lsp package from it.
 package main
 
 import (
@@ -15,51 +16,64 @@ import (
 )
 
 const (
-	modelFile = "../language-server-protocol/_specifications/lsp/3.17/metaModel/metaModel.json"
+	// modelFile is a copy of the meta model of LSP 3.17, relative to the
+	// root of the repository.
+	modelFile = "internal/gen/metaModel.json"
 )
 
 func main() {
+	if err := generate(modelFile, "./internal/gen/_templates", "."); err != nil {
+		log.Fatal(err)
+	}
+}
 
-	b, err := ioutil.ReadFile(modelFile)
+// generate executes all templates in dir with the meta model read from
+// file and writes the generated sources to the directory out.
+func generate(file string, dir string, out string) error {
+	b, err := ioutil.ReadFile(file)
 	if err != nil {
-		log.Fatal(err)
+		return err
 	}
 
 	var model MetaModel
 	if err := json.Unmarshal(b, &model); err != nil {
-		log.Fatal(err)
+		return err
+	}
+
+	if err := decodeTypes(&model); err != nil {
+		return err
 	}
 
-	files, err := filepath.Glob("./internal/gen/_templates/*")
+	g, err := newGenerator(&model)
 	if err != nil {
-		log.Fatal(err.Error())
+		return err
+	}
+
+	files, err := filepath.Glob(filepath.Join(dir, "*"))
+	if err != nil {
+		return err
 	}
 
 	for _, file := range files {
 		t, err := template.New(file).Funcs(template.FuncMap{
-			"type": func(v interface{}) string {
-				switch v := v.(type) {
-				case EnumerationType:
-					return string(v.Name)
-				case map[string]interface{}:
-					var ret []string
-					for _, val := range v {
-						ret = append(ret, fmt.Sprintf("%T", val))
-					}
-
-					return fmt.Sprintf("int //%s\n", strings.Join(ret, ", "))
-				//case string:
-				//	switch v {
-				//	case "uinteger", "integer":
-				//		return "int"
-				//	default:
-				//		return fmt.Sprintf("%q", v)
-				//	}
-				default:
-					return fmt.Sprintf("%T", v)
+			"type": func(t interface{}, ctx ...string) string {
+				if e, ok := t.(EnumerationType); ok {
+					return baseTypes[BaseTypes(e.Name)]
 				}
+				return g.goType(strings.Join(ctx, ""), node(t))
 			},
-			"title": strings.Title,
+			"sum":       g.alias,
+			"structure": g.structure,
+			"sums":      g.sums,
+			"structs":   g.structs,
+			"methods":   g.methods,
+			"server":    g.server,
+			"client":    g.client,
+			"override": func(name string) string {
+				return overrides[name]
+			},
+			"constant": constant,
+			"exported": exported,
 			"comment": func(s string) string {
 				var ret []string
 				for _, line := range strings.Split(s, "\n") {
@@ -69,26 +83,30 @@ func main() {
 			},
 		}).ParseFiles(file)
 		if err != nil {
-			log.Fatal(err.Error())
+			return err
 		}
 		var b bytes.Buffer
 		if err := t.ExecuteTemplate(&b, filepath.Base(file), model); err != nil {
-			log.Fatal(err.Error())
+			return err
+		}
+		if err := writeSource(filepath.Join(out, filepath.Base(file)), b.Bytes()); err != nil {
+			return err
 		}
-		writeSource(filepath.Base(file), b.Bytes())
 	}
+	return nil
 }
 
-func writeSource(file string, b []byte) {
+func writeSource(file string, b []byte) error {
 	b2, err := format.Source(b)
 	if err == nil {
 		b = b2
 	}
 	// Always write to file to help debugging.
 	if err := os.WriteFile(file, b, 0644); err != nil {
-		log.Fatal(err)
+		return err
 	}
 	if err != nil {
-		log.Fatalf("%s: error: %s\n", file, err.Error())
+		return fmt.Errorf("%s: %w", file, err)
 	}
+	return nil
 }
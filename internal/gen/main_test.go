@@ -0,0 +1,161 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestBuild generates the lsp package from the test model and from the
+// LSP meta model and checks that it builds. Only the LSP meta model is
+// complete enough to build the generated files together with the
+// hand-written sources of the package.
+func TestBuild(t *testing.T) {
+	tests := []struct {
+		name    string
+		model   string
+		sources bool
+	}{
+		{"testdata", "testdata/metaModel.json", false},
+		{"lsp", filepath.Join("..", "..", modelFile), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			if err := generate(tt.model, "_templates", dir); err != nil {
+				t.Fatal(err)
+			}
+			if tt.sources {
+				copySources(t, filepath.Join("..", ".."), dir)
+			}
+			mod := []byte("module github.com/5nord/lsp\n\ngo 1.18\n")
+			if err := os.WriteFile(filepath.Join(dir, "go.mod"), mod, 0644); err != nil {
+				t.Fatal(err)
+			}
+			cmd := exec.Command("go", "build", "./...")
+			cmd.Dir = dir
+			if out, err := cmd.CombinedOutput(); err != nil {
+				t.Fatalf("go build: %v\n%s", err, out)
+			}
+		})
+	}
+}
+
+// TestGenerated checks that the generated files checked into the
+// repository are up to date with the templates and the meta model.
+func TestGenerated(t *testing.T) {
+	dir := t.TempDir()
+	if err := generate(filepath.Join("..", "..", modelFile), "_templates", dir); err != nil {
+		t.Fatal(err)
+	}
+	files, err := filepath.Glob(filepath.Join(dir, "*"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, file := range files {
+		want, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		name := filepath.Base(file)
+		got, err := os.ReadFile(filepath.Join("..", "..", name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(got, want) {
+			t.Errorf("%s is out of date, run go generate", name)
+		}
+	}
+}
+
+// TestSum generates the lsp package from the test model and runs the
+// tests in testdata/sum_test.go against it, which check that sum types
+// round-trip their variants.
+func TestSum(t *testing.T) {
+	dir := t.TempDir()
+	if err := generate("testdata/metaModel.json", "_templates", dir); err != nil {
+		t.Fatal(err)
+	}
+	b, err := os.ReadFile("testdata/sum_test.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "sum_test.go"), b, 0644); err != nil {
+		t.Fatal(err)
+	}
+	mod := []byte("module github.com/5nord/lsp\n\ngo 1.18\n")
+	if err := os.WriteFile(filepath.Join(dir, "go.mod"), mod, 0644); err != nil {
+		t.Fatal(err)
+	}
+	cmd := exec.Command("go", "test", ".")
+	cmd.Dir = dir
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("go test: %v\n%s", err, out)
+	}
+}
+
+// copySources copies the hand-written Go files of the package in src to
+// dst.
+func copySources(t *testing.T, src string, dst string) {
+	files, err := filepath.Glob(filepath.Join(src, "*.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, file := range files {
+		if strings.HasSuffix(file, "_gen.go") || strings.HasSuffix(file, "_test.go") {
+			continue
+		}
+		b, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(dst, filepath.Base(file)), b, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// TestNameCollision checks that generation fails, when two declarations
+// get the same name.
+func TestNameCollision(t *testing.T) {
+	tests := []struct {
+		name  string
+		model MetaModel
+	}{
+		{"SymbolKindFile", MetaModel{
+			Structures: []Structure{{Name: "SymbolKindFile"}},
+			Enumerations: []Enumeration{{
+				Name:   "SymbolKind",
+				Type:   EnumerationType{Kind: "base", Name: EnumerationTypeNameUinteger},
+				Values: []EnumerationEntry{{Name: "File", Value: 1}},
+			}},
+		}},
+		{"XInitializeParams", MetaModel{
+			Structures: []Structure{{Name: "_InitializeParams"}, {Name: "XInitializeParams"}},
+		}},
+	}
+	for _, tt := range tests {
+		_, err := newGenerator(&tt.model)
+		if err == nil || !strings.Contains(err.Error(), tt.name) {
+			t.Errorf("expected name collision of %s, got %v", tt.name, err)
+		}
+	}
+}
+
+// TestUnknownTypeKind checks that decoding fails for types without a kind.
+func TestUnknownTypeKind(t *testing.T) {
+	model := MetaModel{
+		TypeAliases: []TypeAlias{{
+			Name: "Foo",
+			Type: map[string]interface{}{"name": "string"},
+		}},
+	}
+	err := decodeTypes(&model)
+	if err == nil || !strings.Contains(err.Error(), `unknown type kind ""`) {
+		t.Fatalf("expected unknown type kind, got %v", err)
+	}
+}
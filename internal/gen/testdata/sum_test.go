@@ -0,0 +1,95 @@
+package lsp
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+)
+
+// TestSumRoundTrip checks that values of sum types are decoded into the
+// variant matching their shape and encoded again without loss.
+func TestSumRoundTrip(t *testing.T) {
+	tests := []struct {
+		input   string
+		value   interface{}
+		variant string
+	}{
+		{`1`, new(ProgressToken), "int32"},
+		{`"token"`, new(ProgressToken), "string"},
+		{`"text"`, new(MarkedString), "string"},
+		{`{"language":"go","value":"x"}`, new(MarkedString), "lsp.MarkedStringLanguage"},
+		{`{"text":"x"}`, new(TextDocumentContentChangeEvent), "lsp.TextDocumentContentChangeEventText"},
+		{`{"range":{"start":{"line":0,"character":1},"end":{"line":0,"character":2}},"text":"x"}`, new(TextDocumentContentChangeEvent), "lsp.TextDocumentContentChangeEventRange"},
+		{`{"uri":"file:///a","range":{"start":{"line":0,"character":0},"end":{"line":0,"character":0}}}`, new(Definition), "lsp.Location"},
+		{`[]`, new(Definition), "[]lsp.Location"},
+		{`{"kind":"create","uri":"file:///a"}`, new(Or_CreateFile_DeleteFile), "lsp.CreateFile"},
+		{`{"kind":"delete","uri":"file:///a"}`, new(Or_CreateFile_DeleteFile), "lsp.DeleteFile"},
+		{`{"kind":"delete","uri":"file:///a","recursive":true}`, new(Or_CreateFile_DeleteFile), "lsp.DeleteFile"},
+		{`null`, new(ProgressToken), "<nil>"},
+	}
+	for _, tt := range tests {
+		if err := json.Unmarshal([]byte(tt.input), tt.value); err != nil {
+			t.Errorf("%T: unmarshal %s: %v", tt.value, tt.input, err)
+			continue
+		}
+		v, err := json.Marshal(tt.value)
+		if err != nil {
+			t.Errorf("%T: marshal %s: %v", tt.value, tt.input, err)
+			continue
+		}
+		if got := fmt.Sprintf("%T", variant(tt.value)); got != tt.variant {
+			t.Errorf("%T: %s decoded into %s, want %s", tt.value, tt.input, got, tt.variant)
+		}
+		if string(v) != tt.input {
+			t.Errorf("%T: %s encoded as %s", tt.value, tt.input, v)
+		}
+	}
+}
+
+func variant(v interface{}) interface{} {
+	switch v := v.(type) {
+	case *ProgressToken:
+		return v.Value
+	case *MarkedString:
+		return v.Value
+	case *TextDocumentContentChangeEvent:
+		return v.Value
+	case *Definition:
+		return v.Value
+	case *Or_CreateFile_DeleteFile:
+		return v.Value
+	}
+	return nil
+}
+
+// TestOptionalRoundTrip checks that omitted optional properties and null
+// are told apart from zero values.
+func TestOptionalRoundTrip(t *testing.T) {
+	tests := []struct {
+		input string
+		value interface{}
+	}{
+		{`{}`, new(WorkDoneProgressOptions)},
+		{`{"workDoneProgress":false}`, new(WorkDoneProgressOptions)},
+		{`{"uri":"file:///a","diagnostics":[]}`, new(PublishDiagnosticsParams)},
+		{`{"uri":"file:///a","version":0,"diagnostics":[]}`, new(PublishDiagnosticsParams)},
+		{`{"range":{"start":{"line":0,"character":0},"end":{"line":0,"character":0}},"rangeLength":0,"text":""}`, new(TextDocumentContentChangeEventRange)},
+		{`{"language":"go","scheme":""}`, new(TextDocumentFilterLanguage)},
+		{`{"processId":null,"rootUri":null,"capabilities":{}}`, new(InitializeParams)},
+		{`{"processId":0,"rootUri":"file:///a","capabilities":{}}`, new(InitializeParams)},
+	}
+	for _, tt := range tests {
+		if err := json.Unmarshal([]byte(tt.input), tt.value); err != nil {
+			t.Errorf("%T: unmarshal %s: %v", tt.value, tt.input, err)
+			continue
+		}
+		v, err := json.Marshal(tt.value)
+		if err != nil {
+			t.Errorf("%T: marshal %s: %v", tt.value, tt.input, err)
+			continue
+		}
+		if string(v) != tt.input {
+			t.Errorf("%T: %s encoded as %s", tt.value, tt.input, v)
+		}
+	}
+}
@@ -0,0 +1,264 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// A TypeNode is a node of the type graph of the meta model. It is one of
+// *BaseType, *ReferenceType, *ArrayType, *MapType, *AndType, *OrType,
+// *TupleType, *StructureLiteralType, *StringLiteralType, *IntegerLiteralType
+// or *BooleanLiteralType.
+//
+// After decodeTypes, all fields of the meta model holding a type contain a
+// TypeNode instead of the raw JSON value.
+type TypeNode interface {
+	typeNode()
+}
+
+func (*BaseType) typeNode()             {}
+func (*ReferenceType) typeNode()        {}
+func (*ArrayType) typeNode()            {}
+func (*MapType) typeNode()              {}
+func (*AndType) typeNode()              {}
+func (*OrType) typeNode()               {}
+func (*TupleType) typeNode()            {}
+func (*StructureLiteralType) typeNode() {}
+func (*StringLiteralType) typeNode()    {}
+func (*IntegerLiteralType) typeNode()   {}
+func (*BooleanLiteralType) typeNode()   {}
+
+// A Visitor's Visit method is invoked for each node encountered by Walk. If
+// the result visitor w is not nil, Walk visits each of the children of node
+// with the visitor w, followed by a call of w.Visit(nil).
+type Visitor interface {
+	Visit(node TypeNode) (w Visitor)
+}
+
+// Walk traverses a type graph in depth-first order. Types of the properties
+// of structure literals are children of the literal. References are not
+// followed.
+func Walk(v Visitor, node TypeNode) {
+	if v = v.Visit(node); v == nil {
+		return
+	}
+	switch n := node.(type) {
+	case *ArrayType:
+		Walk(v, n.Element.(TypeNode))
+	case *MapType:
+		Walk(v, n.Key.(TypeNode))
+		Walk(v, n.Value.(TypeNode))
+	case *AndType:
+		for _, item := range n.Items {
+			Walk(v, item.(TypeNode))
+		}
+	case *OrType:
+		for _, item := range n.Items {
+			Walk(v, item.(TypeNode))
+		}
+	case *TupleType:
+		for _, item := range n.Items {
+			Walk(v, item.(TypeNode))
+		}
+	case *StructureLiteralType:
+		for _, p := range n.Value.Properties {
+			Walk(v, p.Type.(TypeNode))
+		}
+	}
+	v.Visit(nil)
+}
+
+type inspector func(TypeNode) bool
+
+func (f inspector) Visit(node TypeNode) Visitor {
+	if f(node) {
+		return f
+	}
+	return nil
+}
+
+// Inspect traverses a type graph in depth-first order: It starts by calling
+// f(node); node must not be nil. If f returns true, Inspect invokes f
+// recursively for each of the non-nil children of node, followed by a call
+// of f(nil).
+func Inspect(node TypeNode, f func(TypeNode) bool) {
+	Walk(inspector(f), node)
+}
+
+// decodeTypes replaces the raw JSON values of all types in the model by
+// TypeNodes.
+func decodeTypes(model *MetaModel) error {
+	for i := range model.TypeAliases {
+		a := &model.TypeAliases[i]
+		if err := decode(&a.Type); err != nil {
+			return fmt.Errorf("type alias %s: %w", a.Name, err)
+		}
+	}
+	for i := range model.Structures {
+		s := &model.Structures[i]
+		for j := range s.Extends {
+			if err := decode(&s.Extends[j]); err != nil {
+				return fmt.Errorf("structure %s: %w", s.Name, err)
+			}
+		}
+		for j := range s.Mixins {
+			if err := decode(&s.Mixins[j]); err != nil {
+				return fmt.Errorf("structure %s: %w", s.Name, err)
+			}
+		}
+		if err := decodeProperties(s.Properties); err != nil {
+			return fmt.Errorf("structure %s: %w", s.Name, err)
+		}
+	}
+	for i := range model.Requests {
+		r := &model.Requests[i]
+		for _, t := range []interface{}{&r.Params, &r.Result, &r.PartialResult, &r.ErrorData, &r.RegistrationOptions} {
+			if err := decode(t); err != nil {
+				return fmt.Errorf("request %s: %w", r.Method, err)
+			}
+		}
+	}
+	for i := range model.Notifications {
+		n := &model.Notifications[i]
+		for _, t := range []interface{}{&n.Params, &n.RegistrationOptions} {
+			if err := decode(t); err != nil {
+				return fmt.Errorf("notification %s: %w", n.Method, err)
+			}
+		}
+	}
+	return nil
+}
+
+func decodeProperties(props []Property) error {
+	for i := range props {
+		if err := decode(&props[i].Type); err != nil {
+			return fmt.Errorf("property %s: %w", props[i].Name, err)
+		}
+	}
+	return nil
+}
+
+// decode replaces the raw JSON value pointed to by p with a TypeNode. P
+// points to a field of one of the interface types generated for the schema.
+// Missing optional types stay nil.
+func decode(p interface{}) error {
+	switch p := p.(type) {
+	case *interface{}:
+		return decodeInto(p)
+	case *StructureExtendsElem:
+		return decodeInto((*interface{})(p))
+	case *StructureMixinsElem:
+		return decodeInto((*interface{})(p))
+	case *PropertyType:
+		return decodeInto((*interface{})(p))
+	case *TypeAliasType:
+		return decodeInto((*interface{})(p))
+	case *ArrayTypeElement:
+		return decodeInto((*interface{})(p))
+	case *MapTypeKey:
+		return decodeInto((*interface{})(p))
+	case *MapTypeValue:
+		return decodeInto((*interface{})(p))
+	case *AndTypeItemsElem:
+		return decodeInto((*interface{})(p))
+	case *OrTypeItemsElem:
+		return decodeInto((*interface{})(p))
+	case *TupleTypeItemsElem:
+		return decodeInto((*interface{})(p))
+	case *RequestResult:
+		return decodeInto((*interface{})(p))
+	case *RequestPartialResult:
+		return decodeInto((*interface{})(p))
+	case *RequestErrorData:
+		return decodeInto((*interface{})(p))
+	case *RequestRegistrationOptions:
+		return decodeInto((*interface{})(p))
+	case *NotificationRegistrationOptions:
+		return decodeInto((*interface{})(p))
+	}
+	panic(fmt.Sprintf("cannot decode type into %T", p))
+}
+
+func decodeInto(p *interface{}) error {
+	if *p == nil {
+		return nil
+	}
+	if _, ok := (*p).(TypeNode); ok {
+		return nil
+	}
+	b, err := json.Marshal(*p)
+	if err != nil {
+		return err
+	}
+	var k struct {
+		Kind TypeKind `json:"kind"`
+	}
+	if err := json.Unmarshal(b, &k); err != nil {
+		return err
+	}
+
+	var n TypeNode
+	switch k.Kind {
+	case TypeKindBase:
+		n = new(BaseType)
+	case TypeKindReference:
+		n = new(ReferenceType)
+	case TypeKindArray:
+		n = new(ArrayType)
+	case TypeKindMap:
+		n = new(MapType)
+	case TypeKindAnd:
+		n = new(AndType)
+	case TypeKindOr:
+		n = new(OrType)
+	case TypeKindTuple:
+		n = new(TupleType)
+	case TypeKindLiteral:
+		n = new(StructureLiteralType)
+	case TypeKindStringLiteral:
+		n = new(StringLiteralType)
+	case TypeKindIntegerLiteral:
+		n = new(IntegerLiteralType)
+	case TypeKindBooleanLiteral:
+		n = new(BooleanLiteralType)
+	default:
+		return fmt.Errorf("unknown type kind %q", k.Kind)
+	}
+	if err := json.Unmarshal(b, n); err != nil {
+		return fmt.Errorf("%s type: %w", k.Kind, err)
+	}
+
+	switch n := n.(type) {
+	case *ArrayType:
+		err = decode(&n.Element)
+	case *MapType:
+		if err = decode(&n.Key); err == nil {
+			err = decode(&n.Value)
+		}
+	case *AndType:
+		for i := range n.Items {
+			if err = decode(&n.Items[i]); err != nil {
+				break
+			}
+		}
+	case *OrType:
+		for i := range n.Items {
+			if err = decode(&n.Items[i]); err != nil {
+				break
+			}
+		}
+	case *TupleType:
+		for i := range n.Items {
+			if err = decode(&n.Items[i]); err != nil {
+				break
+			}
+		}
+	case *StructureLiteralType:
+		err = decodeProperties(n.Value.Properties)
+	}
+	if err != nil {
+		return err
+	}
+	*p = n
+	return nil
+}
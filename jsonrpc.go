@@ -0,0 +1,239 @@
+package lsp
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// version is the JSON-RPC protocol version used by LSP.
+const version = "2.0"
+
+// An ID identifies a request. It is either a number, a string or null. The
+// zero value is the null ID, which is used for responses to messages whose
+// ID cannot be determined.
+type ID struct {
+	value interface{} // int64, string or nil
+}
+
+// NumberID returns a numeric ID.
+func NumberID(n int64) ID { return ID{n} }
+
+// StringID returns a string ID.
+func StringID(s string) ID { return ID{s} }
+
+// IsValid reports whether id is not the null ID.
+func (id ID) IsValid() bool { return id.value != nil }
+
+// String returns a readable representation of id.
+func (id ID) String() string {
+	switch v := id.value.(type) {
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case string:
+		return strconv.Quote(v)
+	}
+	return "null"
+}
+
+// MarshalJSON implements json.Marshaler.
+func (id ID) MarshalJSON() ([]byte, error) {
+	return json.Marshal(id.value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (id *ID) UnmarshalJSON(b []byte) error {
+	switch {
+	case isNull(b):
+		id.value = nil
+		return nil
+	case isString(b):
+		var s string
+		if err := json.Unmarshal(b, &s); err != nil {
+			return err
+		}
+		id.value = s
+		return nil
+	case isInteger(b):
+		n, err := strconv.ParseInt(string(bytes.TrimSpace(b)), 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid id %s: %w", b, err)
+		}
+		id.value = n
+		return nil
+	}
+	return fmt.Errorf("invalid id %s: must be an integer or a string", b)
+}
+
+// A Message is a JSON-RPC message. It is either a *Request, a *Notification
+// or a *Response.
+type Message interface {
+	message()
+}
+
+// A Request is a request message. The receiver must reply with a Response
+// with the same ID.
+type Request struct {
+	ID     ID
+	Method string
+	Params json.RawMessage
+}
+
+// A Notification is a message without ID. The receiver must not reply to
+// it.
+type Notification struct {
+	Method string
+	Params json.RawMessage
+}
+
+// A Response is the reply to a request. Either Result or Error is set.
+type Response struct {
+	ID     ID
+	Result json.RawMessage
+	Error  *Error
+}
+
+func (*Request) message()      {}
+func (*Notification) message() {}
+func (*Response) message()     {}
+
+// An Error is a JSON-RPC error object. Code is one of the ErrorCodes or
+// LSPErrorCodes.
+type Error struct {
+	Code    int32           `json:"code"`
+	Message string          `json:"message"`
+	Data    json.RawMessage `json:"data,omitempty"`
+}
+
+// Error implements the error interface.
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s (code %d)", e.Message, e.Code)
+}
+
+// errorf returns an Error with the given code and a formatted message.
+func errorf(code int32, format string, args ...interface{}) *Error {
+	return &Error{Code: code, Message: fmt.Sprintf(format, args...)}
+}
+
+// wireMessage is the encoding of all kinds of messages.
+type wireMessage struct {
+	Version string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Method  string          `json:"method,omitempty"`
+	Params  json.RawMessage `json:"params,omitempty"`
+	Result  json.RawMessage `json:"result,omitempty"`
+	Error   *Error          `json:"error,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (r *Request) MarshalJSON() ([]byte, error) {
+	id, err := json.Marshal(r.ID)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(wireMessage{
+		Version: version,
+		ID:      id,
+		Method:  r.Method,
+		Params:  r.Params,
+	})
+}
+
+// MarshalJSON implements json.Marshaler.
+func (n *Notification) MarshalJSON() ([]byte, error) {
+	return json.Marshal(wireMessage{
+		Version: version,
+		Method:  n.Method,
+		Params:  n.Params,
+	})
+}
+
+// MarshalJSON implements json.Marshaler. A response without result and
+// error has the result null.
+func (r *Response) MarshalJSON() ([]byte, error) {
+	id, err := json.Marshal(r.ID)
+	if err != nil {
+		return nil, err
+	}
+	msg := wireMessage{
+		Version: version,
+		ID:      id,
+		Result:  r.Result,
+		Error:   r.Error,
+	}
+	if msg.Error == nil && len(msg.Result) == 0 {
+		msg.Result = json.RawMessage("null")
+	}
+	if msg.Error != nil {
+		msg.Result = nil
+	}
+	return json.Marshal(msg)
+}
+
+// EncodeMessage returns the JSON encoding of m.
+func EncodeMessage(m Message) ([]byte, error) {
+	return json.Marshal(m)
+}
+
+// DecodeMessage decodes a single message. Errors are of type *Error with
+// code ErrorCodesParseError or ErrorCodesInvalidRequest, so they can be
+// sent as response.
+func DecodeMessage(b []byte) (Message, error) {
+	m, _, err := decodeMessage(b)
+	return m, err
+}
+
+// decodeMessage decodes a single message. If the message is invalid, but
+// has a valid ID, the ID is returned along with the error.
+func decodeMessage(b []byte) (Message, ID, error) {
+	var msg wireMessage
+	if err := json.Unmarshal(b, &msg); err != nil {
+		// Valid JSON of the wrong shape is an invalid request.
+		if json.Valid(b) {
+			return nil, ID{}, errorf(int32(ErrorCodesInvalidRequest), "invalid message: %v", err)
+		}
+		return nil, ID{}, errorf(int32(ErrorCodesParseError), "parse error: %v", err)
+	}
+
+	var id ID
+	if msg.ID != nil {
+		if err := id.UnmarshalJSON(msg.ID); err != nil {
+			return nil, ID{}, errorf(int32(ErrorCodesInvalidRequest), "%v", err)
+		}
+	}
+	if msg.Version != version {
+		return nil, id, errorf(int32(ErrorCodesInvalidRequest), "unsupported JSON-RPC version %q", msg.Version)
+	}
+	if len(msg.Params) > 0 && !isObject(msg.Params) && firstByte(msg.Params) != '[' && !isNull(msg.Params) {
+		return nil, id, errorf(int32(ErrorCodesInvalidRequest), "params must be an object or an array")
+	}
+
+	switch {
+	case msg.Method != "" && msg.ID != nil:
+		if !id.IsValid() {
+			return nil, id, errorf(int32(ErrorCodesInvalidRequest), "request %s without id", msg.Method)
+		}
+		return &Request{ID: id, Method: msg.Method, Params: msg.Params}, id, nil
+	case msg.Method != "":
+		return &Notification{Method: msg.Method, Params: msg.Params}, id, nil
+	case msg.ID != nil && (msg.Result != nil || msg.Error != nil):
+		return &Response{ID: id, Result: msg.Result, Error: msg.Error}, id, nil
+	}
+	return nil, id, errorf(int32(ErrorCodesInvalidRequest), "message is neither request, notification nor response")
+}
+
+// splitBatch returns the messages of a batch, or b itself if it is not a
+// batch.
+func splitBatch(b []byte) (msgs []json.RawMessage, batch bool, err error) {
+	if firstByte(b) != '[' {
+		return []json.RawMessage{b}, false, nil
+	}
+	if err := json.Unmarshal(b, &msgs); err != nil {
+		return nil, true, errorf(int32(ErrorCodesParseError), "parse error: %v", err)
+	}
+	if len(msgs) == 0 {
+		return nil, true, errorf(int32(ErrorCodesInvalidRequest), "empty batch")
+	}
+	return msgs, true, nil
+}
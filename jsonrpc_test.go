@@ -0,0 +1,99 @@
+package lsp
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestDecodeMessage(t *testing.T) {
+	tests := []struct {
+		input string
+		want  Message
+		id    ID
+		code  int32
+	}{
+		{input: `{"jsonrpc":"2.0","id":1,"method":"m","params":{"a":1}}`, want: &Request{ID: NumberID(1), Method: "m", Params: json.RawMessage(`{"a":1}`)}, id: NumberID(1)},
+		{input: `{"jsonrpc":"2.0","id":"x","method":"m","params":[1]}`, want: &Request{ID: StringID("x"), Method: "m", Params: json.RawMessage(`[1]`)}, id: StringID("x")},
+		{input: `{"jsonrpc":"2.0","method":"n"}`, want: &Notification{Method: "n"}},
+		{input: `{"jsonrpc":"2.0","id":2,"result":null}`, want: &Response{ID: NumberID(2), Result: json.RawMessage(`null`)}, id: NumberID(2)},
+		{input: `{"jsonrpc":"2.0","id":2,"error":{"code":-32601,"message":"m"}}`, want: &Response{ID: NumberID(2), Error: &Error{Code: -32601, Message: "m"}}, id: NumberID(2)},
+		{input: `{"jsonrpc":"2.0","id":null,"error":{"code":-32700,"message":"m"}}`, want: &Response{Error: &Error{Code: -32700, Message: "m"}}},
+
+		{input: `{`, code: int32(ErrorCodesParseError)},
+		{input: `[1]`, code: int32(ErrorCodesInvalidRequest)},
+		{input: `{"jsonrpc":"1.0","id":3,"method":"m"}`, id: NumberID(3), code: int32(ErrorCodesInvalidRequest)},
+		{input: `{"id":3,"method":"m"}`, id: NumberID(3), code: int32(ErrorCodesInvalidRequest)},
+		{input: `{"jsonrpc":"2.0","id":1.5,"method":"m"}`, code: int32(ErrorCodesInvalidRequest)},
+		{input: `{"jsonrpc":"2.0","id":true,"method":"m"}`, code: int32(ErrorCodesInvalidRequest)},
+		{input: `{"jsonrpc":"2.0","id":null,"method":"m"}`, code: int32(ErrorCodesInvalidRequest)},
+		{input: `{"jsonrpc":"2.0","id":4,"method":"m","params":1}`, id: NumberID(4), code: int32(ErrorCodesInvalidRequest)},
+		{input: `{"jsonrpc":"2.0","id":5}`, id: NumberID(5), code: int32(ErrorCodesInvalidRequest)},
+		{input: `{"jsonrpc":"2.0"}`, code: int32(ErrorCodesInvalidRequest)},
+	}
+	for _, tt := range tests {
+		m, id, err := decodeMessage([]byte(tt.input))
+		if id != tt.id {
+			t.Errorf("%s: got id %v, want %v", tt.input, id, tt.id)
+		}
+		if tt.code != 0 {
+			var e *Error
+			if !errors.As(err, &e) || e.Code != tt.code {
+				t.Errorf("%s: got error %v, want code %d", tt.input, err, tt.code)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: %v", tt.input, err)
+			continue
+		}
+		if !reflect.DeepEqual(m, tt.want) {
+			t.Errorf("%s: got %#v, want %#v", tt.input, m, tt.want)
+		}
+		b, err := EncodeMessage(m)
+		if err != nil || string(b) != tt.input {
+			t.Errorf("%s: encoded as %s, %v", tt.input, b, err)
+		}
+	}
+}
+
+func TestSplitBatch(t *testing.T) {
+	tests := []struct {
+		input string
+		msgs  []string
+		batch bool
+		code  int32
+	}{
+		{input: `{"a":1}`, msgs: []string{`{"a":1}`}},
+		{input: `  {}`, msgs: []string{`  {}`}},
+		{input: `[{"a":1}, {"b":2}]`, msgs: []string{`{"a":1}`, `{"b":2}`}, batch: true},
+		{input: ` [1]`, msgs: []string{`1`}, batch: true},
+		{input: `[]`, batch: true, code: int32(ErrorCodesInvalidRequest)},
+		{input: `[{}`, batch: true, code: int32(ErrorCodesParseError)},
+	}
+	for _, tt := range tests {
+		msgs, batch, err := splitBatch([]byte(tt.input))
+		if batch != tt.batch {
+			t.Errorf("%s: got batch %v, want %v", tt.input, batch, tt.batch)
+		}
+		if tt.code != 0 {
+			var e *Error
+			if !errors.As(err, &e) || e.Code != tt.code {
+				t.Errorf("%s: got error %v, want code %d", tt.input, err, tt.code)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: %v", tt.input, err)
+			continue
+		}
+		var got []string
+		for _, m := range msgs {
+			got = append(got, string(m))
+		}
+		if !reflect.DeepEqual(got, tt.msgs) {
+			t.Errorf("%s: got %q, want %q", tt.input, got, tt.msgs)
+		}
+	}
+}
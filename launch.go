@@ -0,0 +1,284 @@
+package lsp
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// TransportKind is the kind of connection between a server and its client.
+type TransportKind int
+
+const (
+	// TransportStdio uses standard input and output.
+	TransportStdio TransportKind = iota
+
+	// TransportSocket connects to a TCP socket the client listens on.
+	TransportSocket
+
+	// TransportListen listens on a TCP address and accepts a single
+	// client.
+	TransportListen
+
+	// TransportPipe connects to a Unix domain socket the client listens
+	// on. Windows named pipes (\\.\pipe\NAME) are not supported; on
+	// Windows, clients must pass the path of a Unix domain socket.
+	TransportPipe
+
+	// TransportNodeIPC uses the IPC channel of a server started by Node.js.
+	TransportNodeIPC
+)
+
+// A Transport describes the connection to the client as given by the
+// command line arguments editors pass to language servers.
+type Transport struct {
+	Kind TransportKind
+
+	// Address is the TCP address for TransportSocket and TransportListen
+	// and the socket path for TransportPipe.
+	Address string
+
+	// ClientProcessID is the process ID of the client given by
+	// --clientProcessId, or zero.
+	ClientProcessID int
+}
+
+// ParseTransport parses the transport flags in args and returns the
+// remaining arguments. Flags are accepted with one or two dashes, with
+// their value separated by `=` or as the next argument:
+//
+//	--stdio               use stdin and stdout (default)
+//	--socket=PORT         connect to PORT on the local host
+//	--port=PORT           same as --socket
+//	--listen=ADDR         listen on ADDR and accept one client
+//	--pipe=NAME           connect to the Unix domain socket NAME
+//	                      (Windows named pipes are not supported)
+//	--node-ipc            use the Node.js IPC channel
+//	--clientProcessId=PID process ID of the client
+//
+// Parsing stops at "--".
+func ParseTransport(args []string) (Transport, []string, error) {
+	var (
+		t     Transport
+		rest  []string
+		kinds []string
+	)
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if arg == "--" {
+			rest = append(rest, args[i:]...)
+			break
+		}
+		if !strings.HasPrefix(arg, "-") {
+			rest = append(rest, arg)
+			continue
+		}
+		name, value, hasValue := strings.Cut(strings.TrimLeft(arg, "-"), "=")
+
+		// next returns the value of the current flag.
+		next := func() (string, error) {
+			if hasValue {
+				return value, nil
+			}
+			if i+1 < len(args) {
+				i++
+				return args[i], nil
+			}
+			return "", fmt.Errorf("flag %s: missing value", arg)
+		}
+
+		var err error
+		switch name {
+		case "stdio":
+			t.Kind = TransportStdio
+		case "node-ipc":
+			t.Kind = TransportNodeIPC
+		case "socket", "port":
+			t.Kind = TransportSocket
+			if value, err = next(); err == nil {
+				if _, err = strconv.ParseUint(value, 10, 16); err != nil {
+					err = fmt.Errorf("flag %s: invalid port %q", arg, value)
+				}
+				t.Address = net.JoinHostPort("127.0.0.1", value)
+			}
+		case "listen":
+			t.Kind = TransportListen
+			t.Address, err = next()
+		case "pipe":
+			t.Kind = TransportPipe
+			t.Address, err = next()
+		case "clientProcessId":
+			if value, err = next(); err == nil {
+				if t.ClientProcessID, err = strconv.Atoi(value); err != nil {
+					err = fmt.Errorf("flag %s: invalid process id %q", arg, value)
+				}
+			}
+		default:
+			rest = append(rest, arg)
+			continue
+		}
+		if err != nil {
+			return t, nil, err
+		}
+		if name != "clientProcessId" {
+			kinds = append(kinds, "--"+name)
+		}
+	}
+	if len(kinds) > 1 {
+		return t, nil, fmt.Errorf("conflicting transport flags %s", strings.Join(kinds, ", "))
+	}
+	return t, rest, nil
+}
+
+// Open establishes the connection to the client and returns a stream for
+// it.
+func (t Transport) Open(ctx context.Context) (Stream, error) {
+	switch t.Kind {
+	case TransportStdio:
+		return NewHeaderStream(stdio{}), nil
+	case TransportSocket:
+		var d net.Dialer
+		c, err := d.DialContext(ctx, "tcp", t.Address)
+		if err != nil {
+			return nil, err
+		}
+		return NewHeaderStream(c), nil
+	case TransportListen:
+		c, err := accept(ctx, t.Address)
+		if err != nil {
+			return nil, err
+		}
+		return NewHeaderStream(c), nil
+	case TransportPipe:
+		if isNamedPipe(t.Address) {
+			return nil, fmt.Errorf("pipe %s: Windows named pipes are not supported", t.Address)
+		}
+		var d net.Dialer
+		c, err := d.DialContext(ctx, "unix", t.Address)
+		if err != nil {
+			return nil, err
+		}
+		return NewHeaderStream(c), nil
+	case TransportNodeIPC:
+		fd, err := strconv.Atoi(os.Getenv("NODE_CHANNEL_FD"))
+		if err != nil {
+			return nil, fmt.Errorf("node-ipc: NODE_CHANNEL_FD not set")
+		}
+		return newLineStream(os.NewFile(uintptr(fd), "node-ipc")), nil
+	}
+	return nil, fmt.Errorf("unknown transport kind %d", t.Kind)
+}
+
+// isNamedPipe reports whether name is the name of a Windows named pipe.
+func isNamedPipe(name string) bool {
+	name = strings.ReplaceAll(name, `/`, `\`)
+	return strings.HasPrefix(strings.ToLower(name), `\\.\pipe\`)
+}
+
+// accept listens on addr and returns the first connection.
+func accept(ctx context.Context, addr string) (net.Conn, error) {
+	var lc net.ListenConfig
+	l, err := lc.Listen(ctx, "tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+	defer l.Close()
+
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			l.Close()
+		case <-done:
+		}
+	}()
+
+	c, err := l.Accept()
+	if err != nil && ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	return c, err
+}
+
+// stdio reads from standard input and writes to standard output.
+type stdio struct{}
+
+func (stdio) Read(b []byte) (int, error)  { return os.Stdin.Read(b) }
+func (stdio) Write(b []byte) (int, error) { return os.Stdout.Write(b) }
+
+func (stdio) Close() error {
+	err := os.Stdin.Close()
+	if err2 := os.Stdout.Close(); err == nil {
+		err = err2
+	}
+	return err
+}
+
+// A lineStream is a Stream of newline delimited messages as used by the
+// Node.js IPC channel.
+type lineStream struct {
+	r  *bufio.Reader
+	wc io.WriteCloser
+	mu sync.Mutex
+}
+
+func newLineStream(rwc io.ReadWriteCloser) *lineStream {
+	return &lineStream{r: bufio.NewReader(rwc), wc: rwc}
+}
+
+func (s *lineStream) Read() ([]byte, error) {
+	for {
+		line, err := s.r.ReadBytes('\n')
+		if len(bytes.TrimSpace(line)) > 0 {
+			return line, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (s *lineStream) Write(msg []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, err := s.wc.Write(append(msg, '\n'))
+	return err
+}
+
+func (s *lineStream) Close() error {
+	return s.wc.Close()
+}
+
+// Launch serves a language server over the transport given by args, which
+// are typically os.Args[1:]. The server is created by newServer, which
+// receives the client of the connection. Messages are passed to the server
+// according to the lifecycle enforced by Lifecycle; the exit notification
+// terminates the process. Launch returns when the connection is closed.
+func Launch(ctx context.Context, args []string, newServer func(Client) Server) error {
+	return LaunchHandler(ctx, args, func(conn *Conn) Handler {
+		return ServerHandler(newServer(NewClient(conn)))
+	})
+}
+
+// LaunchHandler is like Launch, but serves the Handler returned by
+// newHandler, for example a Mux. Use NewClient(conn) to call the client.
+func LaunchHandler(ctx context.Context, args []string, newHandler func(conn *Conn) Handler) error {
+	t, _, err := ParseTransport(args)
+	if err != nil {
+		return err
+	}
+	s, err := t.Open(ctx)
+	if err != nil {
+		return err
+	}
+	conn := NewConn(s)
+	return conn.Run(ctx, NewLifecycle(newHandler(conn)))
+}
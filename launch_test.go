@@ -0,0 +1,133 @@
+package lsp
+
+import (
+	"context"
+	"net"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+func TestParseTransport(t *testing.T) {
+	tests := []struct {
+		args []string
+		want Transport
+		rest []string
+		err  string
+	}{
+		{args: nil, want: Transport{}},
+		{args: []string{"--stdio", "-v"}, want: Transport{}, rest: []string{"-v"}},
+		{args: []string{"--socket=5007"}, want: Transport{Kind: TransportSocket, Address: "127.0.0.1:5007"}},
+		{args: []string{"-port", "5007"}, want: Transport{Kind: TransportSocket, Address: "127.0.0.1:5007"}},
+		{args: []string{"--listen=:0"}, want: Transport{Kind: TransportListen, Address: ":0"}},
+		{args: []string{"--pipe=/tmp/s", "a"}, want: Transport{Kind: TransportPipe, Address: "/tmp/s"}, rest: []string{"a"}},
+		{args: []string{"--node-ipc"}, want: Transport{Kind: TransportNodeIPC}},
+		{args: []string{"--stdio", "--clientProcessId=12"}, want: Transport{ClientProcessID: 12}},
+		{args: []string{"--clientProcessId", "12", "--pipe", "/tmp/s"}, want: Transport{Kind: TransportPipe, Address: "/tmp/s", ClientProcessID: 12}},
+		{args: []string{"--", "--stdio"}, want: Transport{}, rest: []string{"--", "--stdio"}},
+
+		{args: []string{"--socket=x"}, err: "invalid port"},
+		{args: []string{"--socket"}, err: "missing value"},
+		{args: []string{"--clientProcessId=abc"}, err: "invalid process id"},
+		{args: []string{"--clientProcessId"}, err: "missing value"},
+		{args: []string{"--stdio", "--pipe=x"}, err: "conflicting transport flags"},
+	}
+	for _, tt := range tests {
+		got, rest, err := ParseTransport(tt.args)
+		if tt.err != "" {
+			if err == nil || !strings.Contains(err.Error(), tt.err) {
+				t.Errorf("%q: got error %v, want %q", tt.args, err, tt.err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%q: %v", tt.args, err)
+			continue
+		}
+		if got != tt.want || !reflect.DeepEqual(rest, tt.rest) {
+			t.Errorf("%q: got %+v, %q, want %+v, %q", tt.args, got, rest, tt.want, tt.rest)
+		}
+	}
+}
+
+func TestOpenPipe(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("Unix domain sockets")
+	}
+	path := filepath.Join(t.TempDir(), "s")
+	l, err := net.Listen("unix", path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	go func() {
+		c, err := l.Accept()
+		if err != nil {
+			return
+		}
+		s := NewHeaderStream(c)
+		if b, err := s.Read(); err == nil {
+			s.Write(b)
+		}
+	}()
+
+	s, err := Transport{Kind: TransportPipe, Address: path}.Open(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+	if err := s.Write([]byte("{}")); err != nil {
+		t.Fatal(err)
+	}
+	if b, err := s.Read(); err != nil || string(b) != "{}" {
+		t.Fatalf("got %q, %v", b, err)
+	}
+
+	for _, name := range []string{`\\.\pipe\lsp`, `//./pipe/lsp`} {
+		_, err := Transport{Kind: TransportPipe, Address: name}.Open(context.Background())
+		if err == nil || !strings.Contains(err.Error(), "not supported") {
+			t.Errorf("%s: got %v, want unsupported named pipe", name, err)
+		}
+	}
+}
+
+func TestLaunchHandler(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	_, port, _ := net.SplitHostPort(l.Addr().String())
+
+	errc := make(chan error, 1)
+	go func() {
+		errc <- LaunchHandler(context.Background(), []string{"--socket=" + port}, func(conn *Conn) Handler {
+			m := NewMux()
+			HandleRequest(m, InitializeRequest, func(ctx context.Context, params *InitializeParams) (*InitializeResult, error) {
+				return &InitializeResult{ServerInfo: &InitializeResultServerInfo{Name: "test"}}, nil
+			})
+			return m
+		})
+	}()
+
+	c, err := l.Accept()
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := NewConn(NewHeaderStream(c))
+	go client.Run(context.Background(), echo)
+
+	var res InitializeResult
+	if err := client.Call(context.Background(), MethodInitialize, &InitializeParams{}, &res); err != nil {
+		t.Fatal(err)
+	}
+	if res.ServerInfo == nil || res.ServerInfo.Name != "test" {
+		t.Errorf("got %+v", res)
+	}
+	client.Close()
+	if err := <-errc; err != nil {
+		t.Errorf("LaunchHandler: %v", err)
+	}
+}
@@ -0,0 +1,166 @@
+package lsp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+)
+
+// State is the lifecycle state of a server.
+type State int
+
+const (
+	// StateUninitialized is the state before the initialize request.
+	StateUninitialized State = iota
+
+	// StateInitializing is the state after the initialize request until
+	// the initialized notification.
+	StateInitializing
+
+	// StateInitialized is the state after the initialized notification.
+	StateInitialized
+
+	// StateShutdown is the state after the shutdown request.
+	StateShutdown
+
+	// StateExited is the state after the exit notification.
+	StateExited
+)
+
+func (s State) String() string {
+	switch s {
+	case StateUninitialized:
+		return "uninitialized"
+	case StateInitializing:
+		return "initializing"
+	case StateInitialized:
+		return "initialized"
+	case StateShutdown:
+		return "shutdown"
+	case StateExited:
+		return "exited"
+	}
+	return fmt.Sprintf("State(%d)", int(s))
+}
+
+// A Lifecycle is a Handler enforcing the lifecycle of a language server
+// before passing messages to the wrapped handler:
+//
+//   - Before initialize, requests fail with ErrorCodesServerNotInitialized
+//     and notifications other than exit are dropped.
+//   - A second initialize request fails with ErrorCodesInvalidRequest.
+//   - After shutdown, requests fail with ErrorCodesInvalidRequest and
+//     notifications other than exit are dropped.
+//   - On exit, the process exits with code 0 if a shutdown request was
+//     received before, and 1 otherwise.
+type Lifecycle struct {
+	handler Handler
+
+	// OnTransition, if not nil, is called after each state change.
+	OnTransition func(from, to State)
+
+	// Exit is called with the exit code when the exit notification is
+	// received. If nil, os.Exit is called.
+	Exit func(code int)
+
+	mu    sync.Mutex
+	state State
+}
+
+// NewLifecycle returns a Lifecycle passing messages to h.
+func NewLifecycle(h Handler) *Lifecycle {
+	return &Lifecycle{handler: h}
+}
+
+// State returns the current state.
+func (l *Lifecycle) State() State {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.state
+}
+
+// transition changes the state from one of the states in from to the state
+// to. It returns the previous state and whether the state was changed.
+func (l *Lifecycle) transition(to State, from ...State) (State, bool) {
+	l.mu.Lock()
+	prev := l.state
+	ok := false
+	for _, s := range from {
+		if s == prev {
+			ok = true
+			l.state = to
+			break
+		}
+	}
+	l.mu.Unlock()
+	if ok && l.OnTransition != nil {
+		l.OnTransition(prev, to)
+	}
+	return prev, ok
+}
+
+// Handle implements Handler.
+func (l *Lifecycle) Handle(ctx context.Context, method string, params json.RawMessage) (json.RawMessage, error) {
+	_, isRequest := RequestID(ctx)
+
+	switch method {
+	case MethodInitialize:
+		if prev, ok := l.transition(StateInitializing, StateUninitialized); !ok {
+			return nil, l.reject(prev, method)
+		}
+		result, err := l.handler.Handle(ctx, method, params)
+		if err != nil {
+			l.transition(StateUninitialized, StateInitializing)
+		}
+		return result, err
+
+	case MethodInitialized:
+		if _, ok := l.transition(StateInitialized, StateInitializing); !ok {
+			return nil, nil
+		}
+		return l.handler.Handle(ctx, method, params)
+
+	case MethodShutdown:
+		if prev, ok := l.transition(StateShutdown, StateInitializing, StateInitialized); !ok {
+			return nil, l.reject(prev, method)
+		}
+		return l.handler.Handle(ctx, method, params)
+
+	case MethodExit:
+		prev, ok := l.transition(StateExited, StateUninitialized, StateInitializing, StateInitialized, StateShutdown)
+		if !ok {
+			return nil, nil
+		}
+		code := 1
+		if prev == StateShutdown {
+			code = 0
+		}
+		if prev != StateUninitialized {
+			l.handler.Handle(ctx, method, params)
+		}
+		exit := l.Exit
+		if exit == nil {
+			exit = os.Exit
+		}
+		exit(code)
+		return nil, nil
+	}
+
+	if s := l.State(); s != StateInitializing && s != StateInitialized {
+		if !isRequest {
+			return nil, nil
+		}
+		return nil, l.reject(s, method)
+	}
+	return l.handler.Handle(ctx, method, params)
+}
+
+// reject returns the error for a request not allowed in state s.
+func (l *Lifecycle) reject(s State, method string) error {
+	if s == StateUninitialized {
+		return errorf(int32(ErrorCodesServerNotInitialized), "%s: server not initialized", method)
+	}
+	return errorf(int32(ErrorCodesInvalidRequest), "%s: server is %s", method, s)
+}
@@ -0,0 +1,137 @@
+package lsp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// recorder is a handler recording the methods it handles. It fails for
+// methods in fail.
+type recorder struct {
+	methods []string
+	fail    map[string]bool
+}
+
+func (r *recorder) Handle(ctx context.Context, method string, params json.RawMessage) (json.RawMessage, error) {
+	r.methods = append(r.methods, method)
+	if r.fail[method] {
+		return nil, errors.New("failed")
+	}
+	return nil, nil
+}
+
+// request returns a context for handling a request.
+func request() context.Context {
+	return context.WithValue(context.Background(), idKey{}, NumberID(1))
+}
+
+func TestLifecycle(t *testing.T) {
+	r := &recorder{fail: map[string]bool{}}
+	l := NewLifecycle(r)
+	var transitions []State
+	l.OnTransition = func(from, to State) { transitions = append(transitions, to) }
+	code := -1
+	l.Exit = func(c int) { code = c }
+
+	steps := []struct {
+		method  string
+		request bool
+		fail    bool
+		code    int32 // of the error
+		state   State
+	}{
+		{method: MethodTextDocumentHover, request: true, code: int32(ErrorCodesServerNotInitialized), state: StateUninitialized},
+		{method: MethodTextDocumentDidOpen, state: StateUninitialized},
+		{method: MethodShutdown, request: true, code: int32(ErrorCodesServerNotInitialized), state: StateUninitialized},
+		{method: MethodInitialize, request: true, fail: true, code: int32(LSPErrorCodesRequestFailed), state: StateUninitialized},
+		{method: MethodInitialize, request: true, state: StateInitializing},
+		{method: MethodInitialize, request: true, code: int32(ErrorCodesInvalidRequest), state: StateInitializing},
+		{method: MethodTextDocumentHover, request: true, state: StateInitializing},
+		{method: MethodInitialized, state: StateInitialized},
+		{method: MethodInitialized, state: StateInitialized},
+		{method: MethodTextDocumentDidOpen, state: StateInitialized},
+		{method: MethodShutdown, request: true, state: StateShutdown},
+		{method: MethodTextDocumentHover, request: true, code: int32(ErrorCodesInvalidRequest), state: StateShutdown},
+		{method: MethodTextDocumentDidClose, state: StateShutdown},
+		{method: MethodShutdown, request: true, code: int32(ErrorCodesInvalidRequest), state: StateShutdown},
+		{method: MethodExit, state: StateExited},
+	}
+	for i, s := range steps {
+		ctx := context.Background()
+		if s.request {
+			ctx = request()
+		}
+		r.fail[s.method] = s.fail
+		_, err := l.Handle(ctx, s.method, nil)
+		var e *Error
+		switch {
+		case s.code == 0 && err != nil:
+			t.Errorf("%d: %s: %v", i, s.method, err)
+		case s.code != 0 && !(errors.As(toError(err), &e) && e.Code == s.code):
+			t.Errorf("%d: %s: got error %v, want code %d", i, s.method, err, s.code)
+		}
+		if got := l.State(); got != s.state {
+			t.Errorf("%d: %s: got state %s, want %s", i, s.method, got, s.state)
+		}
+	}
+
+	wantMethods := []string{
+		MethodInitialize, MethodInitialize, MethodTextDocumentHover, MethodInitialized,
+		MethodTextDocumentDidOpen, MethodShutdown, MethodExit,
+	}
+	if !reflect.DeepEqual(r.methods, wantMethods) {
+		t.Errorf("handled %v, want %v", r.methods, wantMethods)
+	}
+	wantTransitions := []State{
+		StateInitializing, StateUninitialized, StateInitializing, StateInitialized, StateShutdown, StateExited,
+	}
+	if !reflect.DeepEqual(transitions, wantTransitions) {
+		t.Errorf("transitions %v, want %v", transitions, wantTransitions)
+	}
+	if code != 0 {
+		t.Errorf("exit code %d, want 0", code)
+	}
+}
+
+func TestLifecycleExit(t *testing.T) {
+	tests := []struct {
+		methods []string // before exit
+		code    int
+		handled bool // whether exit is passed to the handler
+	}{
+		{nil, 1, false},
+		{[]string{MethodInitialize}, 1, true},
+		{[]string{MethodInitialize, MethodInitialized}, 1, true},
+		{[]string{MethodInitialize, MethodInitialized, MethodShutdown}, 0, true},
+		{[]string{MethodInitialize, MethodShutdown}, 0, true},
+	}
+	for _, tt := range tests {
+		r := &recorder{}
+		l := NewLifecycle(r)
+		code := -1
+		l.Exit = func(c int) { code = c }
+		for _, m := range tt.methods {
+			if _, err := l.Handle(request(), m, nil); err != nil {
+				t.Fatalf("%v: %s: %v", tt.methods, m, err)
+			}
+		}
+		l.Handle(context.Background(), MethodExit, nil)
+		if code != tt.code {
+			t.Errorf("%v: exit code %d, want %d", tt.methods, code, tt.code)
+		}
+		handled := len(r.methods) > 0 && r.methods[len(r.methods)-1] == MethodExit
+		if handled != tt.handled {
+			t.Errorf("%v: exit handled %v, want %v", tt.methods, handled, tt.handled)
+		}
+
+		// A second exit is ignored.
+		code = -1
+		l.Handle(context.Background(), MethodExit, nil)
+		if code != -1 {
+			t.Errorf("%v: second exit called Exit(%d)", tt.methods, code)
+		}
+	}
+}
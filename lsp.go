@@ -1,3 +1,29 @@
 //go:generate go run ./internal/gen
 
 package lsp
+
+// ptr returns a pointer to a copy of v. Optional properties of the
+// generated types are pointers.
+func ptr[T any](v T) *T {
+	return &v
+}
+
+// optional returns a pointer to a copy of v, or nil if v is the zero value,
+// so it is omitted from the JSON encoding.
+func optional[T comparable](v T) *T {
+	var zero T
+	if v == zero {
+		return nil
+	}
+	return &v
+}
+
+// value returns the value of an optional property, or the zero value if it
+// is omitted.
+func value[T any](p *T) T {
+	if p == nil {
+		var zero T
+		return zero
+	}
+	return *p
+}
@@ -1,22 +1,67 @@
 package lsp
 
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// A URI is a uniform resource identifier as defined by RFC 3986.
+type URI string
+
+// A DocumentURI is the URI of a document. Clients and servers use it to
+// identify text documents and notebooks.
+type DocumentURI string
+
 // The definition of a symbol represented as one or many [locations](#Location).
 // For most programming languages there is only one location at which a symbol is
 // defined.
 //
 // Servers should prefer returning `DefinitionLink` over `Definition` if supported
 // by the client.
-type Definition int //string, []interface {}
+type Definition struct {
+	// Value is one of Location, []Location.
+	Value interface{}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t Definition) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.Value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. The type of the value is
+// determined by the shape of the JSON value.
+func (t *Definition) UnmarshalJSON(b []byte) error {
+	if isNull(b) {
+		t.Value = nil
+		return nil
+	}
+	if hasFields(b, "uri", "range") {
+		var v Location
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	if isArrayOf(b, func(b []byte) bool { return hasFields(b, "uri", "range") }) {
+		var v []Location
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot unmarshal %s into Definition", b)
+}
 
 // Information about where a symbol is defined.
 //
 // Provides additional metadata over normal [location](#Location) definitions, including the range of
 // the defining symbol
-type DefinitionLink int //string, string
+type DefinitionLink LocationLink
 
 // LSP arrays.
 // @since 3.17.0
-type LSPArray int //string, map[string]interface {}
+type LSPArray = []interface{}
 
 // The LSP any type.
 // Please note that strictly speaking a property with the value `undefined`
@@ -24,10 +69,42 @@ type LSPArray int //string, map[string]interface {}
 // convenience it is allowed and assumed that all these properties are
 // optional as well.
 // @since 3.17.0
-type LSPAny int //string, []interface {}
+type LSPAny = interface{}
 
 // The declaration of a symbol representation as one or many [locations](#Location).
-type Declaration int //string, []interface {}
+type Declaration struct {
+	// Value is one of Location, []Location.
+	Value interface{}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t Declaration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.Value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. The type of the value is
+// determined by the shape of the JSON value.
+func (t *Declaration) UnmarshalJSON(b []byte) error {
+	if isNull(b) {
+		t.Value = nil
+		return nil
+	}
+	if hasFields(b, "uri", "range") {
+		var v Location
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	if isArrayOf(b, func(b []byte) bool { return hasFields(b, "uri", "range") }) {
+		var v []Location
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot unmarshal %s into Declaration", b)
+}
 
 // Information about where a symbol is declared.
 //
@@ -36,7 +113,7 @@ type Declaration int //string, []interface {}
 //
 // Servers should prefer returning `DeclarationLink` over `Declaration` if supported
 // by the client.
-type DeclarationLink int //string, string
+type DeclarationLink LocationLink
 
 // Inline value information can be provided by different means:
 // - directly as a text value (class InlineValueText).
@@ -45,7 +122,46 @@ type DeclarationLink int //string, string
 // The InlineValue types combines all inline value types into one type.
 //
 // @since 3.17.0
-type InlineValue int //string, []interface {}
+type InlineValue struct {
+	// Value is one of InlineValueText, InlineValueVariableLookup, InlineValueEvaluatableExpression.
+	Value interface{}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t InlineValue) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.Value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. The type of the value is
+// determined by the shape of the JSON value.
+func (t *InlineValue) UnmarshalJSON(b []byte) error {
+	if isNull(b) {
+		t.Value = nil
+		return nil
+	}
+	if hasFields(b, "range", "text") {
+		var v InlineValueText
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	if hasFields(b, "range", "caseSensitiveLookup") {
+		var v InlineValueVariableLookup
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	if hasFields(b, "range") {
+		var v InlineValueEvaluatableExpression
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot unmarshal %s into InlineValue", b)
+}
 
 // The result of a document diagnostic pull request. A report can
 // either be a full report containing all diagnostics for the
@@ -54,30 +170,199 @@ type InlineValue int //string, []interface {}
 // pull request.
 //
 // @since 3.17.0
-type DocumentDiagnosticReport int //string, []interface {}
-
-type PrepareRenameResult int //string, []interface {}
-
-type ProgressToken int //string, []interface {}
+type DocumentDiagnosticReport struct {
+	// Value is one of RelatedFullDocumentDiagnosticReport, RelatedUnchangedDocumentDiagnosticReport.
+	Value interface{}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t DocumentDiagnosticReport) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.Value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. The type of the value is
+// determined by the shape of the JSON value.
+func (t *DocumentDiagnosticReport) UnmarshalJSON(b []byte) error {
+	if isNull(b) {
+		t.Value = nil
+		return nil
+	}
+	if hasFields(b, "kind", "items") && hasValue(b, "kind", "\"full\"") {
+		var v RelatedFullDocumentDiagnosticReport
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	if hasFields(b, "kind", "resultId") && hasValue(b, "kind", "\"unchanged\"") {
+		var v RelatedUnchangedDocumentDiagnosticReport
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot unmarshal %s into DocumentDiagnosticReport", b)
+}
+
+// PrepareRenameResult holds a value of one of several types.
+type PrepareRenameResult struct {
+	// Value is one of Range, PrepareRenameResultRange, PrepareRenameResultDefaultBehavior.
+	Value interface{}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t PrepareRenameResult) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.Value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. The type of the value is
+// determined by the shape of the JSON value.
+func (t *PrepareRenameResult) UnmarshalJSON(b []byte) error {
+	if isNull(b) {
+		t.Value = nil
+		return nil
+	}
+	if hasFields(b, "start", "end") {
+		var v Range
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	if hasFields(b, "range", "placeholder") {
+		var v PrepareRenameResultRange
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	if hasFields(b, "defaultBehavior") {
+		var v PrepareRenameResultDefaultBehavior
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot unmarshal %s into PrepareRenameResult", b)
+}
+
+// ProgressToken holds a value of one of several types.
+type ProgressToken struct {
+	// Value is one of int32, string.
+	Value interface{}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t ProgressToken) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.Value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. The type of the value is
+// determined by the shape of the JSON value.
+func (t *ProgressToken) UnmarshalJSON(b []byte) error {
+	if isNull(b) {
+		t.Value = nil
+		return nil
+	}
+	if isInteger(b) {
+		var v int32
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	if isString(b) {
+		var v string
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot unmarshal %s into ProgressToken", b)
+}
 
 // A document selector is the combination of one or many document filters.
 //
 // @sample `let sel:DocumentSelector = [{ language: 'typescript' }, { language: 'json', pattern: '**∕tsconfig.json' }]`;
 //
 // The use of a string as a document filter is deprecated @since 3.16.0.
-type DocumentSelector int //string, map[string]interface {}
+type DocumentSelector []DocumentFilter
 
 // An identifier to refer to a change annotation stored with a workspace edit.
-type ChangeAnnotationIdentifier int //string, string
+type ChangeAnnotationIdentifier string
 
 // A workspace diagnostic document report.
 //
 // @since 3.17.0
-type WorkspaceDocumentDiagnosticReport int //string, []interface {}
+type WorkspaceDocumentDiagnosticReport struct {
+	// Value is one of WorkspaceFullDocumentDiagnosticReport, WorkspaceUnchangedDocumentDiagnosticReport.
+	Value interface{}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t WorkspaceDocumentDiagnosticReport) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.Value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. The type of the value is
+// determined by the shape of the JSON value.
+func (t *WorkspaceDocumentDiagnosticReport) UnmarshalJSON(b []byte) error {
+	if isNull(b) {
+		t.Value = nil
+		return nil
+	}
+	if hasFields(b, "kind", "items", "uri", "version") && hasValue(b, "kind", "\"full\"") {
+		var v WorkspaceFullDocumentDiagnosticReport
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	if hasFields(b, "kind", "resultId", "uri", "version") && hasValue(b, "kind", "\"unchanged\"") {
+		var v WorkspaceUnchangedDocumentDiagnosticReport
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot unmarshal %s into WorkspaceDocumentDiagnosticReport", b)
+}
 
 // An event describing a change to a text document. If only a text is provided
 // it is considered to be the full content of the document.
-type TextDocumentContentChangeEvent int //string, []interface {}
+type TextDocumentContentChangeEvent struct {
+	// Value is one of TextDocumentContentChangeEventRange, TextDocumentContentChangeEventText.
+	Value interface{}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t TextDocumentContentChangeEvent) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.Value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. The type of the value is
+// determined by the shape of the JSON value.
+func (t *TextDocumentContentChangeEvent) UnmarshalJSON(b []byte) error {
+	if isNull(b) {
+		t.Value = nil
+		return nil
+	}
+	if hasFields(b, "range", "text") {
+		var v TextDocumentContentChangeEventRange
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	if hasFields(b, "text") {
+		var v TextDocumentContentChangeEventText
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot unmarshal %s into TextDocumentContentChangeEvent", b)
+}
 
 // MarkedString can be used to render human readable text. It is either a markdown string
 // or a code-block that provides a language and a code snippet. The language identifier
@@ -91,18 +376,114 @@ type TextDocumentContentChangeEvent int //string, []interface {}
 //
 // Note that markdown strings will be sanitized - that means html will be escaped.
 // @deprecated use MarkupContent instead.
-type MarkedString int //string, []interface {}
+type MarkedString struct {
+	// Value is one of MarkedStringLanguage, string.
+	Value interface{}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t MarkedString) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.Value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. The type of the value is
+// determined by the shape of the JSON value.
+func (t *MarkedString) UnmarshalJSON(b []byte) error {
+	if isNull(b) {
+		t.Value = nil
+		return nil
+	}
+	if hasFields(b, "language", "value") {
+		var v MarkedStringLanguage
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	if isString(b) {
+		var v string
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot unmarshal %s into MarkedString", b)
+}
 
 // A document filter describes a top level text document or
 // a notebook cell document.
 //
 // @since 3.17.0 - proposed support for NotebookCellTextDocumentFilter.
-type DocumentFilter int //string, []interface {}
+type DocumentFilter struct {
+	// Value is one of NotebookCellTextDocumentFilter, TextDocumentFilter.
+	Value interface{}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t DocumentFilter) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.Value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. The type of the value is
+// determined by the shape of the JSON value.
+func (t *DocumentFilter) UnmarshalJSON(b []byte) error {
+	if isNull(b) {
+		t.Value = nil
+		return nil
+	}
+	if hasFields(b, "notebook") {
+		var v NotebookCellTextDocumentFilter
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	{
+		var v TextDocumentFilter
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot unmarshal %s into DocumentFilter", b)
+}
 
 // The glob pattern. Either a string pattern or a relative pattern.
 //
 // @since 3.17.0
-type GlobPattern int //string, []interface {}
+type GlobPattern struct {
+	// Value is one of RelativePattern, Pattern.
+	Value interface{}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t GlobPattern) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.Value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. The type of the value is
+// determined by the shape of the JSON value.
+func (t *GlobPattern) UnmarshalJSON(b []byte) error {
+	if isNull(b) {
+		t.Value = nil
+		return nil
+	}
+	if hasFields(b, "baseUri", "pattern") {
+		var v RelativePattern
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	if isString(b) {
+		var v Pattern
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot unmarshal %s into GlobPattern", b)
+}
 
 // A document filter denotes a document by different properties like
 // the [language](#TextDocument.languageId), the [scheme](#Uri.scheme) of
@@ -120,14 +501,92 @@ type GlobPattern int //string, []interface {}
 // @sample A language filter that applies to all package.json paths: `{ language: 'json', pattern: '**package.json' }`
 //
 // @since 3.17.0
-type TextDocumentFilter int //string, []interface {}
+type TextDocumentFilter struct {
+	// Value is one of TextDocumentFilterLanguage, TextDocumentFilterScheme, TextDocumentFilterPattern.
+	Value interface{}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t TextDocumentFilter) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.Value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. The type of the value is
+// determined by the shape of the JSON value.
+func (t *TextDocumentFilter) UnmarshalJSON(b []byte) error {
+	if isNull(b) {
+		t.Value = nil
+		return nil
+	}
+	if hasFields(b, "language") {
+		var v TextDocumentFilterLanguage
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	if hasFields(b, "scheme") {
+		var v TextDocumentFilterScheme
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	if hasFields(b, "pattern") {
+		var v TextDocumentFilterPattern
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot unmarshal %s into TextDocumentFilter", b)
+}
 
 // A notebook document filter denotes a notebook document by
 // different properties. The properties will be match
 // against the notebook's URI (same as with documents)
 //
 // @since 3.17.0
-type NotebookDocumentFilter int //string, []interface {}
+type NotebookDocumentFilter struct {
+	// Value is one of NotebookDocumentFilterNotebookType, NotebookDocumentFilterScheme, NotebookDocumentFilterPattern.
+	Value interface{}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t NotebookDocumentFilter) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.Value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. The type of the value is
+// determined by the shape of the JSON value.
+func (t *NotebookDocumentFilter) UnmarshalJSON(b []byte) error {
+	if isNull(b) {
+		t.Value = nil
+		return nil
+	}
+	if hasFields(b, "notebookType") {
+		var v NotebookDocumentFilterNotebookType
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	if hasFields(b, "scheme") {
+		var v NotebookDocumentFilterScheme
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	if hasFields(b, "pattern") {
+		var v NotebookDocumentFilterPattern
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot unmarshal %s into NotebookDocumentFilter", b)
+}
 
 // The glob pattern to watch relative to the base path. Glob patterns can have the following syntax:
 // - `*` to match one or more characters in a path segment
@@ -138,7 +597,7 @@ type NotebookDocumentFilter int //string, []interface {}
 // - `[!...]` to negate a range of characters to match in a path segment (e.g., `example.[!0-9]` to match on `example.a`, `example.b`, but not `example.0`)
 //
 // @since 3.17.0
-type Pattern int //string, string
+type Pattern string
 
 // A set of predefined token types. This set is not fixed
 // an clients can specify additional token types via the
@@ -148,34 +607,34 @@ type Pattern int //string, string
 type SemanticTokenTypes string
 
 const (
-	Namespace SemanticTokenTypes = "namespace"
+	SemanticTokenTypesNamespace SemanticTokenTypes = "namespace"
 
 	// Represents a generic type. Acts as a fallback for types which can't be mapped to
 	// a specific type like class or enum.
-	Type          SemanticTokenTypes = "type"
-	Class         SemanticTokenTypes = "class"
-	Enum          SemanticTokenTypes = "enum"
-	Interface     SemanticTokenTypes = "interface"
-	Struct        SemanticTokenTypes = "struct"
-	TypeParameter SemanticTokenTypes = "typeParameter"
-	Parameter     SemanticTokenTypes = "parameter"
-	Variable      SemanticTokenTypes = "variable"
-	Property      SemanticTokenTypes = "property"
-	EnumMember    SemanticTokenTypes = "enumMember"
-	Event         SemanticTokenTypes = "event"
-	Function      SemanticTokenTypes = "function"
-	Method        SemanticTokenTypes = "method"
-	Macro         SemanticTokenTypes = "macro"
-	Keyword       SemanticTokenTypes = "keyword"
-	Modifier      SemanticTokenTypes = "modifier"
-	Comment       SemanticTokenTypes = "comment"
-	String        SemanticTokenTypes = "string"
-	Number        SemanticTokenTypes = "number"
-	Regexp        SemanticTokenTypes = "regexp"
-	Operator      SemanticTokenTypes = "operator"
-
-	// @since 3.17.0
-	Decorator SemanticTokenTypes = "decorator"
+	SemanticTokenTypesType          SemanticTokenTypes = "type"
+	SemanticTokenTypesClass         SemanticTokenTypes = "class"
+	SemanticTokenTypesEnum          SemanticTokenTypes = "enum"
+	SemanticTokenTypesInterface     SemanticTokenTypes = "interface"
+	SemanticTokenTypesStruct        SemanticTokenTypes = "struct"
+	SemanticTokenTypesTypeParameter SemanticTokenTypes = "typeParameter"
+	SemanticTokenTypesParameter     SemanticTokenTypes = "parameter"
+	SemanticTokenTypesVariable      SemanticTokenTypes = "variable"
+	SemanticTokenTypesProperty      SemanticTokenTypes = "property"
+	SemanticTokenTypesEnumMember    SemanticTokenTypes = "enumMember"
+	SemanticTokenTypesEvent         SemanticTokenTypes = "event"
+	SemanticTokenTypesFunction      SemanticTokenTypes = "function"
+	SemanticTokenTypesMethod        SemanticTokenTypes = "method"
+	SemanticTokenTypesMacro         SemanticTokenTypes = "macro"
+	SemanticTokenTypesKeyword       SemanticTokenTypes = "keyword"
+	SemanticTokenTypesModifier      SemanticTokenTypes = "modifier"
+	SemanticTokenTypesComment       SemanticTokenTypes = "comment"
+	SemanticTokenTypesString        SemanticTokenTypes = "string"
+	SemanticTokenTypesNumber        SemanticTokenTypes = "number"
+	SemanticTokenTypesRegexp        SemanticTokenTypes = "regexp"
+	SemanticTokenTypesOperator      SemanticTokenTypes = "operator"
+
+	// @since 3.17.0
+	SemanticTokenTypesDecorator SemanticTokenTypes = "decorator"
 )
 
 // A set of predefined token modifiers. This set is not fixed
@@ -186,16 +645,16 @@ const (
 type SemanticTokenModifiers string
 
 const (
-	Declaration    SemanticTokenModifiers = "declaration"
-	Definition     SemanticTokenModifiers = "definition"
-	Readonly       SemanticTokenModifiers = "readonly"
-	Static         SemanticTokenModifiers = "static"
-	Deprecated     SemanticTokenModifiers = "deprecated"
-	Abstract       SemanticTokenModifiers = "abstract"
-	Async          SemanticTokenModifiers = "async"
-	Modification   SemanticTokenModifiers = "modification"
-	Documentation  SemanticTokenModifiers = "documentation"
-	DefaultLibrary SemanticTokenModifiers = "defaultLibrary"
+	SemanticTokenModifiersDeclaration    SemanticTokenModifiers = "declaration"
+	SemanticTokenModifiersDefinition     SemanticTokenModifiers = "definition"
+	SemanticTokenModifiersReadonly       SemanticTokenModifiers = "readonly"
+	SemanticTokenModifiersStatic         SemanticTokenModifiers = "static"
+	SemanticTokenModifiersDeprecated     SemanticTokenModifiers = "deprecated"
+	SemanticTokenModifiersAbstract       SemanticTokenModifiers = "abstract"
+	SemanticTokenModifiersAsync          SemanticTokenModifiers = "async"
+	SemanticTokenModifiersModification   SemanticTokenModifiers = "modification"
+	SemanticTokenModifiersDocumentation  SemanticTokenModifiers = "documentation"
+	SemanticTokenModifiersDefaultLibrary SemanticTokenModifiers = "defaultLibrary"
 )
 
 // The document diagnostic report kinds.
@@ -207,30 +666,30 @@ const (
 
 	// A diagnostic report with a full
 	// set of problems.
-	Full DocumentDiagnosticReportKind = "full"
+	DocumentDiagnosticReportKindFull DocumentDiagnosticReportKind = "full"
 
 	// A report indicating that the last
 	// returned report is still accurate.
-	Unchanged DocumentDiagnosticReportKind = "unchanged"
+	DocumentDiagnosticReportKindUnchanged DocumentDiagnosticReportKind = "unchanged"
 )
 
 // Predefined error codes.
-type ErrorCodes integer
+type ErrorCodes int32
 
 const (
-	ParseError     ErrorCodes = -32700
-	InvalidRequest ErrorCodes = -32600
-	MethodNotFound ErrorCodes = -32601
-	InvalidParams  ErrorCodes = -32602
-	InternalError  ErrorCodes = -32603
+	ErrorCodesParseError     ErrorCodes = -32700
+	ErrorCodesInvalidRequest ErrorCodes = -32600
+	ErrorCodesMethodNotFound ErrorCodes = -32601
+	ErrorCodesInvalidParams  ErrorCodes = -32602
+	ErrorCodesInternalError  ErrorCodes = -32603
 
 	// Error code indicating that a server received a notification or
 	// request before the server has received the `initialize` request.
-	ServerNotInitialized ErrorCodes = -32002
-	UnknownErrorCode     ErrorCodes = -32001
+	ErrorCodesServerNotInitialized ErrorCodes = -32002
+	ErrorCodesUnknownErrorCode     ErrorCodes = -32001
 )
 
-type LSPErrorCodes integer
+type LSPErrorCodes int32
 
 const (
 
@@ -240,14 +699,14 @@ const (
 	// the request failed.
 	//
 	// @since 3.17.0
-	RequestFailed LSPErrorCodes = -32803
+	LSPErrorCodesRequestFailed LSPErrorCodes = -32803
 
 	// The server cancelled the request. This error code should
 	// only be used for requests that explicitly support being
 	// server cancellable.
 	//
 	// @since 3.17.0
-	ServerCancelled LSPErrorCodes = -32802
+	LSPErrorCodesServerCancelled LSPErrorCodes = -32802
 
 	// The server detected that the content of a document got
 	// modified outside normal conditions. A server should
@@ -257,11 +716,11 @@ const (
 	//
 	// If a client decides that a result is not of any use anymore
 	// the client should cancel the request.
-	ContentModified LSPErrorCodes = -32801
+	LSPErrorCodesContentModified LSPErrorCodes = -32801
 
 	// The client has canceled a request and a server as detected
 	// the cancel.
-	RequestCancelled LSPErrorCodes = -32800
+	LSPErrorCodesRequestCancelled LSPErrorCodes = -32800
 )
 
 // A set of predefined range kinds.
@@ -270,56 +729,56 @@ type FoldingRangeKind string
 const (
 
 	// Folding range for a comment
-	Comment FoldingRangeKind = "comment"
+	FoldingRangeKindComment FoldingRangeKind = "comment"
 
 	// Folding range for an import or include
-	Imports FoldingRangeKind = "imports"
+	FoldingRangeKindImports FoldingRangeKind = "imports"
 
 	// Folding range for a region (e.g. `#region`)
-	Region FoldingRangeKind = "region"
+	FoldingRangeKindRegion FoldingRangeKind = "region"
 )
 
 // A symbol kind.
-type SymbolKind uinteger
-
-const (
-	File          SymbolKind = 1
-	Module        SymbolKind = 2
-	Namespace     SymbolKind = 3
-	Package       SymbolKind = 4
-	Class         SymbolKind = 5
-	Method        SymbolKind = 6
-	Property      SymbolKind = 7
-	Field         SymbolKind = 8
-	Constructor   SymbolKind = 9
-	Enum          SymbolKind = 10
-	Interface     SymbolKind = 11
-	Function      SymbolKind = 12
-	Variable      SymbolKind = 13
-	Constant      SymbolKind = 14
-	String        SymbolKind = 15
-	Number        SymbolKind = 16
-	Boolean       SymbolKind = 17
-	Array         SymbolKind = 18
-	Object        SymbolKind = 19
-	Key           SymbolKind = 20
-	Null          SymbolKind = 21
-	EnumMember    SymbolKind = 22
-	Struct        SymbolKind = 23
-	Event         SymbolKind = 24
-	Operator      SymbolKind = 25
-	TypeParameter SymbolKind = 26
+type SymbolKind uint32
+
+const (
+	SymbolKindFile          SymbolKind = 1
+	SymbolKindModule        SymbolKind = 2
+	SymbolKindNamespace     SymbolKind = 3
+	SymbolKindPackage       SymbolKind = 4
+	SymbolKindClass         SymbolKind = 5
+	SymbolKindMethod        SymbolKind = 6
+	SymbolKindProperty      SymbolKind = 7
+	SymbolKindField         SymbolKind = 8
+	SymbolKindConstructor   SymbolKind = 9
+	SymbolKindEnum          SymbolKind = 10
+	SymbolKindInterface     SymbolKind = 11
+	SymbolKindFunction      SymbolKind = 12
+	SymbolKindVariable      SymbolKind = 13
+	SymbolKindConstant      SymbolKind = 14
+	SymbolKindString        SymbolKind = 15
+	SymbolKindNumber        SymbolKind = 16
+	SymbolKindBoolean       SymbolKind = 17
+	SymbolKindArray         SymbolKind = 18
+	SymbolKindObject        SymbolKind = 19
+	SymbolKindKey           SymbolKind = 20
+	SymbolKindNull          SymbolKind = 21
+	SymbolKindEnumMember    SymbolKind = 22
+	SymbolKindStruct        SymbolKind = 23
+	SymbolKindEvent         SymbolKind = 24
+	SymbolKindOperator      SymbolKind = 25
+	SymbolKindTypeParameter SymbolKind = 26
 )
 
 // Symbol tags are extra annotations that tweak the rendering of a symbol.
 //
 // @since 3.16
-type SymbolTag uinteger
+type SymbolTag uint32
 
 const (
 
 	// Render a symbol as obsolete, usually using a strike-out.
-	Deprecated SymbolTag = 1
+	SymbolTagDeprecated SymbolTag = 1
 )
 
 // Moniker uniqueness level to define scope of the moniker.
@@ -330,19 +789,19 @@ type UniquenessLevel string
 const (
 
 	// The moniker is only unique inside a document
-	Document UniquenessLevel = "document"
+	UniquenessLevelDocument UniquenessLevel = "document"
 
 	// The moniker is unique inside a project for which a dump got created
-	Project UniquenessLevel = "project"
+	UniquenessLevelProject UniquenessLevel = "project"
 
 	// The moniker is unique inside the group to which a project belongs
-	Group UniquenessLevel = "group"
+	UniquenessLevelGroup UniquenessLevel = "group"
 
 	// The moniker is unique inside the moniker scheme.
-	Scheme UniquenessLevel = "scheme"
+	UniquenessLevelScheme UniquenessLevel = "scheme"
 
 	// The moniker is globally unique
-	Global UniquenessLevel = "global"
+	UniquenessLevelGlobal UniquenessLevel = "global"
 )
 
 // The moniker kind.
@@ -353,134 +812,134 @@ type MonikerKind string
 const (
 
 	// The moniker represent a symbol that is imported into a project
-	Import MonikerKind = "import"
+	MonikerKindImport MonikerKind = "import"
 
 	// The moniker represents a symbol that is exported from a project
-	Export MonikerKind = "export"
+	MonikerKindExport MonikerKind = "export"
 
 	// The moniker represents a symbol that is local to a project (e.g. a local
 	// variable of a function, a class not visible outside the project, ...)
-	Local MonikerKind = "local"
+	MonikerKindLocal MonikerKind = "local"
 )
 
 // Inlay hint kinds.
 //
 // @since 3.17.0
-type InlayHintKind uinteger
+type InlayHintKind uint32
 
 const (
 
 	// An inlay hint that for a type annotation.
-	Type InlayHintKind = 1
+	InlayHintKindType InlayHintKind = 1
 
 	// An inlay hint that is for a parameter.
-	Parameter InlayHintKind = 2
+	InlayHintKindParameter InlayHintKind = 2
 )
 
 // The message type
-type MessageType uinteger
+type MessageType uint32
 
 const (
 
 	// An error message.
-	Error MessageType = 1
+	MessageTypeError MessageType = 1
 
 	// A warning message.
-	Warning MessageType = 2
+	MessageTypeWarning MessageType = 2
 
 	// An information message.
-	Info MessageType = 3
+	MessageTypeInfo MessageType = 3
 
 	// A log message.
-	Log MessageType = 4
+	MessageTypeLog MessageType = 4
 )
 
 // Defines how the host (editor) should sync
 // document changes to the language server.
-type TextDocumentSyncKind uinteger
+type TextDocumentSyncKind uint32
 
 const (
 
 	// Documents should not be synced at all.
-	None TextDocumentSyncKind = 0
+	TextDocumentSyncKindNone TextDocumentSyncKind = 0
 
 	// Documents are synced by always sending the full content
 	// of the document.
-	Full TextDocumentSyncKind = 1
+	TextDocumentSyncKindFull TextDocumentSyncKind = 1
 
 	// Documents are synced by sending the full content on open.
 	// After that only incremental updates to the document are
 	// send.
-	Incremental TextDocumentSyncKind = 2
+	TextDocumentSyncKindIncremental TextDocumentSyncKind = 2
 )
 
 // Represents reasons why a text document is saved.
-type TextDocumentSaveReason uinteger
+type TextDocumentSaveReason uint32
 
 const (
 
 	// Manually triggered, e.g. by the user pressing save, by starting debugging,
 	// or by an API call.
-	Manual TextDocumentSaveReason = 1
+	TextDocumentSaveReasonManual TextDocumentSaveReason = 1
 
 	// Automatic after a delay.
-	AfterDelay TextDocumentSaveReason = 2
+	TextDocumentSaveReasonAfterDelay TextDocumentSaveReason = 2
 
 	// When the editor lost focus.
-	FocusOut TextDocumentSaveReason = 3
+	TextDocumentSaveReasonFocusOut TextDocumentSaveReason = 3
 )
 
 // The kind of a completion entry.
-type CompletionItemKind uinteger
-
-const (
-	Text          CompletionItemKind = 1
-	Method        CompletionItemKind = 2
-	Function      CompletionItemKind = 3
-	Constructor   CompletionItemKind = 4
-	Field         CompletionItemKind = 5
-	Variable      CompletionItemKind = 6
-	Class         CompletionItemKind = 7
-	Interface     CompletionItemKind = 8
-	Module        CompletionItemKind = 9
-	Property      CompletionItemKind = 10
-	Unit          CompletionItemKind = 11
-	Value         CompletionItemKind = 12
-	Enum          CompletionItemKind = 13
-	Keyword       CompletionItemKind = 14
-	Snippet       CompletionItemKind = 15
-	Color         CompletionItemKind = 16
-	File          CompletionItemKind = 17
-	Reference     CompletionItemKind = 18
-	Folder        CompletionItemKind = 19
-	EnumMember    CompletionItemKind = 20
-	Constant      CompletionItemKind = 21
-	Struct        CompletionItemKind = 22
-	Event         CompletionItemKind = 23
-	Operator      CompletionItemKind = 24
-	TypeParameter CompletionItemKind = 25
+type CompletionItemKind uint32
+
+const (
+	CompletionItemKindText          CompletionItemKind = 1
+	CompletionItemKindMethod        CompletionItemKind = 2
+	CompletionItemKindFunction      CompletionItemKind = 3
+	CompletionItemKindConstructor   CompletionItemKind = 4
+	CompletionItemKindField         CompletionItemKind = 5
+	CompletionItemKindVariable      CompletionItemKind = 6
+	CompletionItemKindClass         CompletionItemKind = 7
+	CompletionItemKindInterface     CompletionItemKind = 8
+	CompletionItemKindModule        CompletionItemKind = 9
+	CompletionItemKindProperty      CompletionItemKind = 10
+	CompletionItemKindUnit          CompletionItemKind = 11
+	CompletionItemKindValue         CompletionItemKind = 12
+	CompletionItemKindEnum          CompletionItemKind = 13
+	CompletionItemKindKeyword       CompletionItemKind = 14
+	CompletionItemKindSnippet       CompletionItemKind = 15
+	CompletionItemKindColor         CompletionItemKind = 16
+	CompletionItemKindFile          CompletionItemKind = 17
+	CompletionItemKindReference     CompletionItemKind = 18
+	CompletionItemKindFolder        CompletionItemKind = 19
+	CompletionItemKindEnumMember    CompletionItemKind = 20
+	CompletionItemKindConstant      CompletionItemKind = 21
+	CompletionItemKindStruct        CompletionItemKind = 22
+	CompletionItemKindEvent         CompletionItemKind = 23
+	CompletionItemKindOperator      CompletionItemKind = 24
+	CompletionItemKindTypeParameter CompletionItemKind = 25
 )
 
 // Completion item tags are extra annotations that tweak the rendering of a completion
 // item.
 //
 // @since 3.15.0
-type CompletionItemTag uinteger
+type CompletionItemTag uint32
 
 const (
 
 	// Render a completion as obsolete, usually using a strike-out.
-	Deprecated CompletionItemTag = 1
+	CompletionItemTagDeprecated CompletionItemTag = 1
 )
 
 // Defines whether the insert text in a completion item should be interpreted as
 // plain text or a snippet.
-type InsertTextFormat uinteger
+type InsertTextFormat uint32
 
 const (
 
 	// The primary text to be inserted is treated as a plain string.
-	PlainText InsertTextFormat = 1
+	InsertTextFormatPlainText InsertTextFormat = 1
 
 	// The primary text to be inserted is treated as a snippet.
 	//
@@ -490,14 +949,14 @@ const (
 	// that is typing in one will update others too.
 	//
 	// See also: https://microsoft.github.io/language-server-protocol/specifications/specification-current/#snippet_syntax
-	Snippet InsertTextFormat = 2
+	InsertTextFormatSnippet InsertTextFormat = 2
 )
 
 // How whitespace and indentation is handled during completion
 // item insertion.
 //
 // @since 3.16.0
-type InsertTextMode uinteger
+type InsertTextMode uint32
 
 const (
 
@@ -506,7 +965,7 @@ const (
 	// inserted using the indentation defined in the string value.
 	// The client will not apply any kind of adjustments to the
 	// string.
-	AsIs InsertTextMode = 1
+	InsertTextModeAsIs InsertTextMode = 1
 
 	// The editor adjusts leading whitespace of new lines so that
 	// they match the indentation up to the cursor of the line for
@@ -515,22 +974,22 @@ const (
 	// Consider a line like this: <2tabs><cursor><3tabs>foo. Accepting a
 	// multi line completion item is indented using 2 tabs and all
 	// following lines inserted will be indented using 2 tabs as well.
-	AdjustIndentation InsertTextMode = 2
+	InsertTextModeAdjustIndentation InsertTextMode = 2
 )
 
 // A document highlight kind.
-type DocumentHighlightKind uinteger
+type DocumentHighlightKind uint32
 
 const (
 
 	// A textual occurrence.
-	Text DocumentHighlightKind = 1
+	DocumentHighlightKindText DocumentHighlightKind = 1
 
 	// Read-access of a symbol, like reading a variable.
-	Read DocumentHighlightKind = 2
+	DocumentHighlightKindRead DocumentHighlightKind = 2
 
 	// Write-access of a symbol, like writing to a variable.
-	Write DocumentHighlightKind = 3
+	DocumentHighlightKindWrite DocumentHighlightKind = 3
 )
 
 // A set of predefined code action kinds
@@ -539,13 +998,13 @@ type CodeActionKind string
 const (
 
 	// Empty kind.
-	Empty CodeActionKind = ""
+	CodeActionKindEmpty CodeActionKind = ""
 
 	// Base kind for quickfix actions: 'quickfix'
-	QuickFix CodeActionKind = "quickfix"
+	CodeActionKindQuickFix CodeActionKind = "quickfix"
 
 	// Base kind for refactoring actions: 'refactor'
-	Refactor CodeActionKind = "refactor"
+	CodeActionKindRefactor CodeActionKind = "refactor"
 
 	// Base kind for refactoring extraction actions: 'refactor.extract'
 	//
@@ -556,7 +1015,7 @@ const (
 	// - Extract variable
 	// - Extract interface from class
 	// - ...
-	RefactorExtract CodeActionKind = "refactor.extract"
+	CodeActionKindRefactorExtract CodeActionKind = "refactor.extract"
 
 	// Base kind for refactoring inline actions: 'refactor.inline'
 	//
@@ -566,7 +1025,7 @@ const (
 	// - Inline variable
 	// - Inline constant
 	// - ...
-	RefactorInline CodeActionKind = "refactor.inline"
+	CodeActionKindRefactorInline CodeActionKind = "refactor.inline"
 
 	// Base kind for refactoring rewrite actions: 'refactor.rewrite'
 	//
@@ -578,15 +1037,15 @@ const (
 	// - Make method static
 	// - Move method to base class
 	// - ...
-	RefactorRewrite CodeActionKind = "refactor.rewrite"
+	CodeActionKindRefactorRewrite CodeActionKind = "refactor.rewrite"
 
 	// Base kind for source actions: `source`
 	//
 	// Source code actions apply to the entire file.
-	Source CodeActionKind = "source"
+	CodeActionKindSource CodeActionKind = "source"
 
 	// Base kind for an organize imports source action: `source.organizeImports`
-	SourceOrganizeImports CodeActionKind = "source.organizeImports"
+	CodeActionKindSourceOrganizeImports CodeActionKind = "source.organizeImports"
 
 	// Base kind for auto-fix source actions: `source.fixAll`.
 	//
@@ -594,7 +1053,7 @@ const (
 	// They should not suppress errors or perform unsafe fixes such as generating new types or classes.
 	//
 	// @since 3.15.0
-	SourceFixAll CodeActionKind = "source.fixAll"
+	CodeActionKindSourceFixAll CodeActionKind = "source.fixAll"
 )
 
 type TraceValues string
@@ -602,13 +1061,13 @@ type TraceValues string
 const (
 
 	// Turn tracing off.
-	Off TraceValues = "off"
+	TraceValuesOff TraceValues = "off"
 
 	// Trace messages only.
-	Messages TraceValues = "messages"
+	TraceValuesMessages TraceValues = "messages"
 
 	// Verbose message tracing.
-	Verbose TraceValues = "verbose"
+	TraceValuesVerbose TraceValues = "verbose"
 )
 
 // Describes the content type that a client supports in various
@@ -621,10 +1080,10 @@ type MarkupKind string
 const (
 
 	// Plain text is supported as a content format
-	PlainText MarkupKind = "plaintext"
+	MarkupKindPlainText MarkupKind = "plaintext"
 
 	// Markdown is supported as a content format
-	Markdown MarkupKind = "markdown"
+	MarkupKindMarkdown MarkupKind = "markdown"
 )
 
 // A set of predefined position encoding kinds.
@@ -635,73 +1094,73 @@ type PositionEncodingKind string
 const (
 
 	// Character offsets count UTF-8 code units.
-	UTF8 PositionEncodingKind = "utf-8"
+	PositionEncodingKindUTF8 PositionEncodingKind = "utf-8"
 
 	// Character offsets count UTF-16 code units.
 	//
 	// This is the default and must always be supported
 	// by servers
-	UTF16 PositionEncodingKind = "utf-16"
+	PositionEncodingKindUTF16 PositionEncodingKind = "utf-16"
 
 	// Character offsets count UTF-32 code units.
 	//
 	// Implementation note: these are the same as Unicode code points,
 	// so this `PositionEncodingKind` may also be used for an
 	// encoding-agnostic representation of character offsets.
-	UTF32 PositionEncodingKind = "utf-32"
+	PositionEncodingKindUTF32 PositionEncodingKind = "utf-32"
 )
 
 // The file event type
-type FileChangeType uinteger
+type FileChangeType uint32
 
 const (
 
 	// The file got created.
-	Created FileChangeType = 1
+	FileChangeTypeCreated FileChangeType = 1
 
 	// The file got changed.
-	Changed FileChangeType = 2
+	FileChangeTypeChanged FileChangeType = 2
 
 	// The file got deleted.
-	Deleted FileChangeType = 3
+	FileChangeTypeDeleted FileChangeType = 3
 )
 
-type WatchKind uinteger
+type WatchKind uint32
 
 const (
 
 	// Interested in create events.
-	Create WatchKind = 1
+	WatchKindCreate WatchKind = 1
 
 	// Interested in change events
-	Change WatchKind = 2
+	WatchKindChange WatchKind = 2
 
 	// Interested in delete events
-	Delete WatchKind = 4
+	WatchKindDelete WatchKind = 4
 )
 
 // The diagnostic's severity.
-type DiagnosticSeverity uinteger
+type DiagnosticSeverity uint32
 
 const (
 
 	// Reports an error.
-	Error DiagnosticSeverity = 1
+	DiagnosticSeverityError DiagnosticSeverity = 1
 
 	// Reports a warning.
-	Warning DiagnosticSeverity = 2
+	DiagnosticSeverityWarning DiagnosticSeverity = 2
 
 	// Reports an information.
-	Information DiagnosticSeverity = 3
+	DiagnosticSeverityInformation DiagnosticSeverity = 3
 
 	// Reports a hint.
-	Hint DiagnosticSeverity = 4
+	DiagnosticSeverityHint DiagnosticSeverity = 4
 )
 
 // The diagnostic tags.
 //
 // @since 3.15.0
-type DiagnosticTag uinteger
+type DiagnosticTag uint32
 
 const (
 
@@ -709,63 +1168,63 @@ const (
 	//
 	// Clients are allowed to render diagnostics with this tag faded out instead of having
 	// an error squiggle.
-	Unnecessary DiagnosticTag = 1
+	DiagnosticTagUnnecessary DiagnosticTag = 1
 
 	// Deprecated or obsolete code.
 	//
 	// Clients are allowed to rendered diagnostics with this tag strike through.
-	Deprecated DiagnosticTag = 2
+	DiagnosticTagDeprecated DiagnosticTag = 2
 )
 
 // How a completion was triggered
-type CompletionTriggerKind uinteger
+type CompletionTriggerKind uint32
 
 const (
 
 	// Completion was triggered by typing an identifier (24x7 code
 	// complete), manual invocation (e.g Ctrl+Space) or via API.
-	Invoked CompletionTriggerKind = 1
+	CompletionTriggerKindInvoked CompletionTriggerKind = 1
 
 	// Completion was triggered by a trigger character specified by
 	// the `triggerCharacters` properties of the `CompletionRegistrationOptions`.
-	TriggerCharacter CompletionTriggerKind = 2
+	CompletionTriggerKindTriggerCharacter CompletionTriggerKind = 2
 
 	// Completion was re-triggered as current completion list is incomplete
-	TriggerForIncompleteCompletions CompletionTriggerKind = 3
+	CompletionTriggerKindTriggerForIncompleteCompletions CompletionTriggerKind = 3
 )
 
 // How a signature help was triggered.
 //
 // @since 3.15.0
-type SignatureHelpTriggerKind uinteger
+type SignatureHelpTriggerKind uint32
 
 const (
 
 	// Signature help was invoked manually by the user or by a command.
-	Invoked SignatureHelpTriggerKind = 1
+	SignatureHelpTriggerKindInvoked SignatureHelpTriggerKind = 1
 
 	// Signature help was triggered by a trigger character.
-	TriggerCharacter SignatureHelpTriggerKind = 2
+	SignatureHelpTriggerKindTriggerCharacter SignatureHelpTriggerKind = 2
 
 	// Signature help was triggered by the cursor moving or by the document content changing.
-	ContentChange SignatureHelpTriggerKind = 3
+	SignatureHelpTriggerKindContentChange SignatureHelpTriggerKind = 3
 )
 
 // The reason why code actions were requested.
 //
 // @since 3.17.0
-type CodeActionTriggerKind uinteger
+type CodeActionTriggerKind uint32
 
 const (
 
 	// Code actions were explicitly requested by the user or by an extension.
-	Invoked CodeActionTriggerKind = 1
+	CodeActionTriggerKindInvoked CodeActionTriggerKind = 1
 
 	// Code actions were requested automatically.
 	//
 	// This typically happens when current selection in a file changes, but can
 	// also be triggered when file content changes.
-	Automatic CodeActionTriggerKind = 2
+	CodeActionTriggerKindAutomatic CodeActionTriggerKind = 2
 )
 
 // A pattern kind describing if a glob pattern matches a file a folder or
@@ -777,24 +1236,24 @@ type FileOperationPatternKind string
 const (
 
 	// The pattern matches a file only.
-	File FileOperationPatternKind = "file"
+	FileOperationPatternKindFile FileOperationPatternKind = "file"
 
 	// The pattern matches a folder only.
-	Folder FileOperationPatternKind = "folder"
+	FileOperationPatternKindFolder FileOperationPatternKind = "folder"
 )
 
 // A notebook cell kind.
 //
 // @since 3.17.0
-type NotebookCellKind uinteger
+type NotebookCellKind uint32
 
 const (
 
 	// A markup-cell is formatted source that is used for display.
-	Markup NotebookCellKind = 1
+	NotebookCellKindMarkup NotebookCellKind = 1
 
 	// A code-cell is source code.
-	Code NotebookCellKind = 2
+	NotebookCellKindCode NotebookCellKind = 2
 )
 
 type ResourceOperationKind string
@@ -802,13 +1261,13 @@ type ResourceOperationKind string
 const (
 
 	// Supports creating new files and folders.
-	Create ResourceOperationKind = "create"
+	ResourceOperationKindCreate ResourceOperationKind = "create"
 
 	// Supports renaming existing files and folders.
-	Rename ResourceOperationKind = "rename"
+	ResourceOperationKindRename ResourceOperationKind = "rename"
 
 	// Supports deleting existing files and folders.
-	Delete ResourceOperationKind = "delete"
+	ResourceOperationKindDelete ResourceOperationKind = "delete"
 )
 
 type FailureHandlingKind string
@@ -817,33 +1276,6670 @@ const (
 
 	// Applying the workspace change is simply aborted if one of the changes provided
 	// fails. All operations executed before the failing operation stay executed.
-	Abort FailureHandlingKind = "abort"
+	FailureHandlingKindAbort FailureHandlingKind = "abort"
 
 	// All operations are executed transactional. That means they either all
 	// succeed or no changes at all are applied to the workspace.
-	Transactional FailureHandlingKind = "transactional"
+	FailureHandlingKindTransactional FailureHandlingKind = "transactional"
 
 	// If the workspace edit contains only textual file changes they are executed transactional.
 	// If resource changes (create, rename or delete file) are part of the change the failure
 	// handling strategy is abort.
-	TextOnlyTransactional FailureHandlingKind = "textOnlyTransactional"
+	FailureHandlingKindTextOnlyTransactional FailureHandlingKind = "textOnlyTransactional"
 
 	// The client tries to undo the operations already executed. But there is no
 	// guarantee that this is succeeding.
-	Undo FailureHandlingKind = "undo"
+	FailureHandlingKindUndo FailureHandlingKind = "undo"
 )
 
-type PrepareSupportDefaultBehavior uinteger
+type PrepareSupportDefaultBehavior uint32
 
 const (
 
 	// The client's default behavior is to select the identifier
 	// according the to language's syntax rule.
-	Identifier PrepareSupportDefaultBehavior = 1
+	PrepareSupportDefaultBehaviorIdentifier PrepareSupportDefaultBehavior = 1
 )
 
 type TokenFormat string
 
 const (
-	Relative TokenFormat = "relative"
+	TokenFormatRelative TokenFormat = "relative"
 )
+
+// Position in a text document expressed as zero-based line and character
+// offset.
+type Position struct {
+	// Line position in a document (zero-based).
+	Line uint32 `json:"line"`
+
+	// Character offset on a line in a document (zero-based).
+	//
+	// The meaning of this offset is determined by the negotiated
+	// `PositionEncodingKind`.
+	Character uint32 `json:"character"`
+}
+
+// A range in a text document expressed as (zero-based) start and end positions.
+type Range struct {
+	// The range's start position.
+	Start Position `json:"start"`
+
+	// The range's end position.
+	End Position `json:"end"`
+}
+
+// Represents a location inside a resource, such as a line
+// inside a text file.
+type Location struct {
+	Uri   DocumentURI `json:"uri"`
+	Range Range       `json:"range"`
+}
+
+// Represents the connection of two locations. Provides additional metadata over normal [locations](#Location),
+// including an origin range.
+type LocationLink struct {
+	// Span of the origin of this link.
+	OriginSelectionRange *Range `json:"originSelectionRange,omitempty"`
+
+	// The target resource identifier of this link.
+	TargetUri DocumentURI `json:"targetUri"`
+
+	// The full target range of this link.
+	TargetRange Range `json:"targetRange"`
+
+	// The range that should be selected and revealed when this link is being followed.
+	TargetSelectionRange Range `json:"targetSelectionRange"`
+}
+
+// A literal to identify a text document in the client.
+type TextDocumentIdentifier struct {
+	// The text document's uri.
+	Uri DocumentURI `json:"uri"`
+}
+
+// A text document identifier to denote a specific version of a text document.
+type VersionedTextDocumentIdentifier struct {
+	TextDocumentIdentifier
+	// The version number of this document.
+	Version int32 `json:"version"`
+}
+
+// A text document identifier to optionally denote a specific version of a text document.
+type OptionalVersionedTextDocumentIdentifier struct {
+	TextDocumentIdentifier
+	// The version number of this document. If a versioned text document identifier
+	// is sent from the server to the client and the file is not open in the editor
+	// (the server has not received an open notification before) the server can send
+	// `null` to indicate that the version is unknown and the content on disk is the
+	// truth (as specified with document content ownership).
+	Version *int32 `json:"version"`
+}
+
+// An item to transfer a text document from the client to the
+// server.
+type TextDocumentItem struct {
+	// The text document's uri.
+	Uri DocumentURI `json:"uri"`
+
+	// The text document's language identifier.
+	LanguageId string `json:"languageId"`
+
+	// The version number of this document (it will increase after each
+	// change, including undo/redo).
+	Version int32 `json:"version"`
+
+	// The content of the opened text document.
+	Text string `json:"text"`
+}
+
+// A parameter literal used in requests to pass a text document and a position inside that
+// document.
+type TextDocumentPositionParams struct {
+	// The text document.
+	TextDocument TextDocumentIdentifier `json:"textDocument"`
+
+	// The position inside the text document.
+	Position Position `json:"position"`
+}
+
+type WorkDoneProgressParams struct {
+	// An optional token that a server can use to report work done progress.
+	WorkDoneToken *ProgressToken `json:"workDoneToken,omitempty"`
+}
+
+type PartialResultParams struct {
+	// An optional token that a server can use to report partial results (e.g. streaming) to
+	// the client.
+	PartialResultToken *ProgressToken `json:"partialResultToken,omitempty"`
+}
+
+type WorkDoneProgressOptions struct {
+	WorkDoneProgress *bool `json:"workDoneProgress,omitempty"`
+}
+
+// General text document registration options.
+type TextDocumentRegistrationOptions struct {
+	// A document selector to identify the scope of the registration. If set to null
+	// the document selector provided on the client side will be used.
+	DocumentSelector *DocumentSelector `json:"documentSelector"`
+}
+
+// Static registration options to be returned in the initialize
+// request.
+type StaticRegistrationOptions struct {
+	// The id used to register the request. The id can be used to deregister
+	// the request again. See also Registration#id.
+	Id *string `json:"id,omitempty"`
+}
+
+// A text edit applicable to a text document.
+type TextEdit struct {
+	// The range of the text document to be manipulated. To insert
+	// text into a document create a range where start === end.
+	Range Range `json:"range"`
+
+	// The string to be inserted. For delete operations use an
+	// empty string.
+	NewText string `json:"newText"`
+}
+
+// A special text edit with an additional change annotation.
+//
+// @since 3.16.0.
+type AnnotatedTextEdit struct {
+	TextEdit
+	// The actual identifier of the change annotation
+	AnnotationId ChangeAnnotationIdentifier `json:"annotationId"`
+}
+
+// Additional information that describes document changes.
+//
+// @since 3.16.0
+type ChangeAnnotation struct {
+	// A human-readable string describing the actual change. The string
+	// is rendered prominent in the user interface.
+	Label string `json:"label"`
+
+	// A flag which indicates that user confirmation is needed
+	// before applying the change.
+	NeedsConfirmation *bool `json:"needsConfirmation,omitempty"`
+
+	// A human-readable string which is rendered less prominent in
+	// the user interface.
+	Description *string `json:"description,omitempty"`
+}
+
+// Describes textual changes on a text document. A TextDocumentEdit describes all changes
+// on a document version Si and after they are applied move the document to version Si+1.
+// So the creator of a TextDocumentEdit doesn't need to sort the array of edits or do any
+// kind of ordering. However the edits must be non overlapping.
+type TextDocumentEdit struct {
+	// The text document to change.
+	TextDocument OptionalVersionedTextDocumentIdentifier `json:"textDocument"`
+
+	// The edits to be applied.
+	//
+	// @since 3.16.0 - support for AnnotatedTextEdit. This is guarded using a
+	// client capability.
+	Edits []Or_TextEdit_AnnotatedTextEdit `json:"edits"`
+}
+
+// A generic resource operation.
+type ResourceOperation struct {
+	// The resource operation kind.
+	Kind string `json:"kind"`
+
+	// An optional annotation identifier describing the operation.
+	//
+	// @since 3.16.0
+	AnnotationId *ChangeAnnotationIdentifier `json:"annotationId,omitempty"`
+}
+
+// Options to create a file.
+type CreateFileOptions struct {
+	// Overwrite existing file. Overwrite wins over `ignoreIfExists`
+	Overwrite *bool `json:"overwrite,omitempty"`
+
+	// Ignore if exists.
+	IgnoreIfExists *bool `json:"ignoreIfExists,omitempty"`
+}
+
+// Create file operation.
+type CreateFile struct {
+	ResourceOperation
+	// A create
+	Kind string `json:"kind"`
+
+	// The resource to create.
+	Uri DocumentURI `json:"uri"`
+
+	// Additional options
+	Options *CreateFileOptions `json:"options,omitempty"`
+}
+
+// Rename file options
+type RenameFileOptions struct {
+	// Overwrite target if existing. Overwrite wins over `ignoreIfExists`
+	Overwrite *bool `json:"overwrite,omitempty"`
+
+	// Ignores if target exists.
+	IgnoreIfExists *bool `json:"ignoreIfExists,omitempty"`
+}
+
+// Rename file operation
+type RenameFile struct {
+	ResourceOperation
+	// A rename
+	Kind string `json:"kind"`
+
+	// The old (existing) location.
+	OldUri DocumentURI `json:"oldUri"`
+
+	// The new location.
+	NewUri DocumentURI `json:"newUri"`
+
+	// Rename options.
+	Options *RenameFileOptions `json:"options,omitempty"`
+}
+
+// Delete file options
+type DeleteFileOptions struct {
+	// Delete the content recursively if a folder is denoted.
+	Recursive *bool `json:"recursive,omitempty"`
+
+	// Ignore the operation if the file doesn't exist.
+	IgnoreIfNotExists *bool `json:"ignoreIfNotExists,omitempty"`
+}
+
+// Delete file operation
+type DeleteFile struct {
+	ResourceOperation
+	// A delete
+	Kind string `json:"kind"`
+
+	// The file to delete.
+	Uri DocumentURI `json:"uri"`
+
+	// Delete options.
+	Options *DeleteFileOptions `json:"options,omitempty"`
+}
+
+// A workspace edit represents changes to many resources managed in the workspace. The edit
+// should either provide `changes` or `documentChanges`. If documentChanges are present
+// they are preferred over `changes` if the client can handle versioned document edits.
+type WorkspaceEdit struct {
+	// Holds changes to existing resources.
+	Changes map[DocumentURI][]TextEdit `json:"changes,omitempty"`
+
+	// Depending on the client capability `workspace.workspaceEdit.resourceOperations` document changes
+	// are either an array of `TextDocumentEdit`s to express changes to n different text documents
+	// where each text document edit addresses a specific version of a text document. Or it can contain
+	// above `TextDocumentEdit`s mixed with create, rename and delete file / folder operations.
+	DocumentChanges []Or_TextDocumentEdit_CreateFile_RenameFile_DeleteFile `json:"documentChanges,omitempty"`
+
+	// A map of change annotations that can be referenced in `AnnotatedTextEdit`s or create, rename and
+	// delete file / folder operations.
+	//
+	// @since 3.16.0
+	ChangeAnnotations map[ChangeAnnotationIdentifier]ChangeAnnotation `json:"changeAnnotations,omitempty"`
+}
+
+// Represents a reference to a command. Provides a title which
+// will be used to represent a command in the UI and, optionally,
+// an array of arguments which will be passed to the command handler
+// function when invoked.
+type Command struct {
+	// Title of the command, like `save`.
+	Title string `json:"title"`
+
+	// The identifier of the actual command handler.
+	Command string `json:"command"`
+
+	// Arguments that the command handler should be
+	// invoked with.
+	Arguments []interface{} `json:"arguments,omitempty"`
+}
+
+// A `MarkupContent` literal represents a string value which content is interpreted base on its
+// kind flag. Currently the protocol supports `plaintext` and `markdown` as markup kinds.
+type MarkupContent struct {
+	// The type of the Markup
+	Kind MarkupKind `json:"kind"`
+
+	// The content itself
+	Value string `json:"value"`
+}
+
+// Structure to capture a description for an error code.
+//
+// @since 3.16.0
+type CodeDescription struct {
+	// An URI to open with more information about the diagnostic error.
+	Href URI `json:"href"`
+}
+
+// Represents a related message and source code location for a diagnostic. This should be
+// used to point to code locations that cause or related to a diagnostics, e.g when duplicating
+// a symbol in a scope.
+type DiagnosticRelatedInformation struct {
+	// The location of this related diagnostic information.
+	Location Location `json:"location"`
+
+	// The message of this related diagnostic information.
+	Message string `json:"message"`
+}
+
+// Represents a diagnostic, such as a compiler error or warning. Diagnostic objects
+// are only valid in the scope of a resource.
+type Diagnostic struct {
+	// The range at which the message applies
+	Range Range `json:"range"`
+
+	// The diagnostic's severity. Can be omitted. If omitted it is up to the
+	// client to interpret diagnostics as error, warning, info or hint.
+	Severity *DiagnosticSeverity `json:"severity,omitempty"`
+
+	// The diagnostic's code, which usually appear in the user interface.
+	Code *Or_Int32_String `json:"code,omitempty"`
+
+	// An optional property to describe the error code.
+	// Requires the code field (above) to be present/not null.
+	//
+	// @since 3.16.0
+	CodeDescription *CodeDescription `json:"codeDescription,omitempty"`
+
+	// A human-readable string describing the source of this
+	// diagnostic, e.g. 'typescript' or 'super lint'. It usually
+	// appears in the user interface.
+	Source *string `json:"source,omitempty"`
+
+	// The diagnostic's message. It usually appears in the user interface
+	Message string `json:"message"`
+
+	// Additional metadata about the diagnostic.
+	//
+	// @since 3.15.0
+	Tags []DiagnosticTag `json:"tags,omitempty"`
+
+	// An array of related diagnostic information, e.g. when symbol-names within
+	// a scope collide all definitions can be marked via this property.
+	RelatedInformation []DiagnosticRelatedInformation `json:"relatedInformation,omitempty"`
+
+	// A data entry field that is preserved between a `textDocument/publishDiagnostics`
+	// notification and `textDocument/codeAction` request.
+	//
+	// @since 3.16.0
+	Data interface{} `json:"data,omitempty"`
+}
+
+// LSP object definition.
+// @since 3.17.0
+type LSPObject = map[string]interface{}
+
+type CancelParams struct {
+	// The request id to cancel.
+	Id Or_Int32_String `json:"id"`
+}
+
+type ProgressParams struct {
+	// The progress token provided by the client or server.
+	Token ProgressToken `json:"token"`
+
+	// The progress data.
+	Value interface{} `json:"value"`
+}
+
+type SetTraceParams struct {
+	Value TraceValues `json:"value"`
+}
+
+type LogTraceParams struct {
+	Message string  `json:"message"`
+	Verbose *string `json:"verbose,omitempty"`
+}
+
+type WorkDoneProgressBegin struct {
+	Kind string `json:"kind"`
+
+	// Mandatory title of the progress operation. Used to briefly inform about
+	// the kind of operation being performed.
+	Title string `json:"title"`
+
+	// Controls if a cancel button should show to allow the user to cancel the
+	// long running operation. Clients that don't support cancellation are allowed
+	// to ignore the setting.
+	Cancellable *bool `json:"cancellable,omitempty"`
+
+	// Optional, more detailed associated progress message. Contains
+	// complementary information to the `title`.
+	Message *string `json:"message,omitempty"`
+
+	// Optional progress percentage to display (value 100 is considered 100%).
+	// If not provided infinite progress is assumed and clients are allowed
+	// to ignore the `percentage` value in subsequent in report notifications.
+	Percentage *uint32 `json:"percentage,omitempty"`
+}
+
+type WorkDoneProgressReport struct {
+	Kind string `json:"kind"`
+
+	// Controls enablement state of a cancel button.
+	Cancellable *bool `json:"cancellable,omitempty"`
+
+	// Optional, more detailed associated progress message.
+	Message *string `json:"message,omitempty"`
+
+	// Optional progress percentage to display (value 100 is considered 100%).
+	Percentage *uint32 `json:"percentage,omitempty"`
+}
+
+type WorkDoneProgressEnd struct {
+	Kind string `json:"kind"`
+
+	// Optional, a final message indicating to for example indicate the outcome
+	// of the operation.
+	Message *string `json:"message,omitempty"`
+}
+
+type WorkDoneProgressCreateParams struct {
+	// The token to be used to report progress.
+	Token ProgressToken `json:"token"`
+}
+
+type WorkDoneProgressCancelParams struct {
+	// The token to be used to report progress.
+	Token ProgressToken `json:"token"`
+}
+
+// The initialize parameters
+type XInitializeParams struct {
+	WorkDoneProgressParams
+	// The process Id of the parent process that started
+	// the server.
+	//
+	// Is `null` if the process has not been started by another process.
+	// If the parent process is not alive then the server should exit.
+	ProcessId *int32 `json:"processId"`
+
+	// Information about the client
+	//
+	// @since 3.15.0
+	ClientInfo *XInitializeParamsClientInfo `json:"clientInfo,omitempty"`
+
+	// The locale the client is currently showing the user interface
+	// in. This must not necessarily be the locale of the operating
+	// system.
+	//
+	// @since 3.16.0
+	Locale *string `json:"locale,omitempty"`
+
+	// The rootPath of the workspace. Is null
+	// if no folder is open.
+	//
+	// @deprecated in favour of rootUri.
+	RootPath *string `json:"rootPath,omitempty"`
+
+	// The rootUri of the workspace. Is null if no
+	// folder is open. If both `rootPath` and `rootUri` are set
+	// `rootUri` wins.
+	//
+	// @deprecated in favour of workspaceFolders.
+	RootUri *DocumentURI `json:"rootUri"`
+
+	// The capabilities provided by the client (editor or tool)
+	Capabilities ClientCapabilities `json:"capabilities"`
+
+	// User provided initialization options.
+	InitializationOptions interface{} `json:"initializationOptions,omitempty"`
+
+	// The initial trace setting. If omitted trace is disabled ('off').
+	Trace *string `json:"trace,omitempty"`
+}
+
+type WorkspaceFoldersInitializeParams struct {
+	// The workspace folders configured in the client when the server starts.
+	//
+	// This property is only available if the client supports workspace folders.
+	// It can be `null` if the client supports workspace folders but none are
+	// configured.
+	//
+	// @since 3.6.0
+	WorkspaceFolders *[]WorkspaceFolder `json:"workspaceFolders,omitempty"`
+}
+
+type InitializeParams struct {
+	XInitializeParams
+	WorkspaceFoldersInitializeParams
+}
+
+// The result returned from an initialize request.
+type InitializeResult struct {
+	// The capabilities the language server provides.
+	Capabilities ServerCapabilities `json:"capabilities"`
+
+	// Information about the server.
+	//
+	// @since 3.15.0
+	ServerInfo *InitializeResultServerInfo `json:"serverInfo,omitempty"`
+}
+
+// The data type of the ResponseError if the
+// initialize request fails.
+type InitializeError struct {
+	// Indicates whether the client execute the following retry logic:
+	// (1) show the message provided by the ResponseError to the user
+	// (2) user selects retry or cancel
+	// (3) if user selected retry the initialize method is sent again.
+	Retry bool `json:"retry"`
+}
+
+type InitializedParams struct {
+}
+
+// Defines the capabilities provided by the client.
+type ClientCapabilities struct {
+	// Workspace specific client capabilities.
+	Workspace *WorkspaceClientCapabilities `json:"workspace,omitempty"`
+
+	// Text document specific client capabilities.
+	TextDocument *TextDocumentClientCapabilities `json:"textDocument,omitempty"`
+
+	// Capabilities specific to the notebook document support.
+	//
+	// @since 3.17.0
+	NotebookDocument *NotebookDocumentClientCapabilities `json:"notebookDocument,omitempty"`
+
+	// Window specific client capabilities.
+	Window *WindowClientCapabilities `json:"window,omitempty"`
+
+	// General client capabilities.
+	//
+	// @since 3.16.0
+	General *GeneralClientCapabilities `json:"general,omitempty"`
+
+	// Experimental client capabilities.
+	Experimental interface{} `json:"experimental,omitempty"`
+}
+
+// Workspace specific client capabilities.
+type WorkspaceClientCapabilities struct {
+	// The client supports applying batch edits
+	// to the workspace by supporting the request
+	// 'workspace/applyEdit'
+	ApplyEdit *bool `json:"applyEdit,omitempty"`
+
+	// Capabilities specific to `WorkspaceEdit`s.
+	WorkspaceEdit *WorkspaceEditClientCapabilities `json:"workspaceEdit,omitempty"`
+
+	// Capabilities specific to the `workspace/didChangeConfiguration` notification.
+	DidChangeConfiguration *DidChangeConfigurationClientCapabilities `json:"didChangeConfiguration,omitempty"`
+
+	// Capabilities specific to the `workspace/didChangeWatchedFiles` notification.
+	DidChangeWatchedFiles *DidChangeWatchedFilesClientCapabilities `json:"didChangeWatchedFiles,omitempty"`
+
+	// Capabilities specific to the `workspace/symbol` request.
+	Symbol *WorkspaceSymbolClientCapabilities `json:"symbol,omitempty"`
+
+	// Capabilities specific to the `workspace/executeCommand` request.
+	ExecuteCommand *ExecuteCommandClientCapabilities `json:"executeCommand,omitempty"`
+
+	// The client has support for workspace folders.
+	//
+	// @since 3.6.0
+	WorkspaceFolders *bool `json:"workspaceFolders,omitempty"`
+
+	// The client supports `workspace/configuration` requests.
+	//
+	// @since 3.6.0
+	Configuration *bool `json:"configuration,omitempty"`
+
+	// Capabilities specific to the semantic token requests scoped to the
+	// workspace.
+	//
+	// @since 3.16.0.
+	SemanticTokens *SemanticTokensWorkspaceClientCapabilities `json:"semanticTokens,omitempty"`
+
+	// Capabilities specific to the code lens requests scoped to the
+	// workspace.
+	//
+	// @since 3.16.0.
+	CodeLens *CodeLensWorkspaceClientCapabilities `json:"codeLens,omitempty"`
+
+	// The client has support for file notifications/requests for user operations on files.
+	//
+	// Since 3.16.0
+	FileOperations *FileOperationClientCapabilities `json:"fileOperations,omitempty"`
+
+	// Capabilities specific to the inline values requests scoped to the
+	// workspace.
+	//
+	// @since 3.17.0.
+	InlineValue *InlineValueWorkspaceClientCapabilities `json:"inlineValue,omitempty"`
+
+	// Capabilities specific to the inlay hint requests scoped to the
+	// workspace.
+	//
+	// @since 3.17.0.
+	InlayHint *InlayHintWorkspaceClientCapabilities `json:"inlayHint,omitempty"`
+
+	// Capabilities specific to the diagnostic requests scoped to the
+	// workspace.
+	//
+	// @since 3.17.0.
+	Diagnostics *DiagnosticWorkspaceClientCapabilities `json:"diagnostics,omitempty"`
+}
+
+type WorkspaceEditClientCapabilities struct {
+	// The client supports versioned document changes in `WorkspaceEdit`s
+	DocumentChanges *bool `json:"documentChanges,omitempty"`
+
+	// The resource operations the client supports. Clients should at least
+	// support 'create', 'rename' and 'delete' files and folders.
+	//
+	// @since 3.13.0
+	ResourceOperations []ResourceOperationKind `json:"resourceOperations,omitempty"`
+
+	// The failure handling strategy of a client if applying the workspace edit
+	// fails.
+	//
+	// @since 3.13.0
+	FailureHandling *FailureHandlingKind `json:"failureHandling,omitempty"`
+
+	// Whether the client normalizes line endings to the client specific
+	// setting.
+	//
+	// @since 3.16.0
+	NormalizesLineEndings *bool `json:"normalizesLineEndings,omitempty"`
+
+	// Whether the client in general supports change annotations on text edits,
+	// create file, rename file and delete file changes.
+	//
+	// @since 3.16.0
+	ChangeAnnotationSupport *WorkspaceEditClientCapabilitiesChangeAnnotationSupport `json:"changeAnnotationSupport,omitempty"`
+}
+
+type DidChangeConfigurationClientCapabilities struct {
+	// Whether the feature supports dynamic registration.
+	DynamicRegistration *bool `json:"dynamicRegistration,omitempty"`
+}
+
+type DidChangeWatchedFilesClientCapabilities struct {
+	// Whether the feature supports dynamic registration.
+	DynamicRegistration *bool `json:"dynamicRegistration,omitempty"`
+
+	// Whether the client has support for {@link  RelativePattern relative pattern}
+	// or not.
+	//
+	// @since 3.17.0
+	RelativePatternSupport *bool `json:"relativePatternSupport,omitempty"`
+}
+
+// Client capabilities for a {@link WorkspaceSymbolRequest}.
+type WorkspaceSymbolClientCapabilities struct {
+	// Whether the feature supports dynamic registration.
+	DynamicRegistration *bool `json:"dynamicRegistration,omitempty"`
+
+	// Specific capabilities for the `SymbolKind` in the `workspace/symbol` request.
+	SymbolKind *WorkspaceSymbolClientCapabilitiesSymbolKind `json:"symbolKind,omitempty"`
+
+	// The client supports tags on `SymbolInformation`.
+	// Clients supporting tags have to handle unknown tags gracefully.
+	//
+	// @since 3.16.0
+	TagSupport *WorkspaceSymbolClientCapabilitiesTagSupport `json:"tagSupport,omitempty"`
+
+	// The client support partial workspace symbols. The client will send the
+	// request `workspaceSymbol/resolve` to the server to resolve additional
+	// properties.
+	//
+	// @since 3.17.0
+	ResolveSupport *WorkspaceSymbolClientCapabilitiesResolveSupport `json:"resolveSupport,omitempty"`
+}
+
+// The client capabilities of a {@link ExecuteCommandRequest}.
+type ExecuteCommandClientCapabilities struct {
+	// Whether the feature supports dynamic registration.
+	DynamicRegistration *bool `json:"dynamicRegistration,omitempty"`
+}
+
+// @since 3.16.0
+type SemanticTokensWorkspaceClientCapabilities struct {
+	// Whether the client implementation supports a refresh request sent from
+	// the server to the client.
+	RefreshSupport *bool `json:"refreshSupport,omitempty"`
+}
+
+// @since 3.16.0
+type CodeLensWorkspaceClientCapabilities struct {
+	// Whether the client implementation supports a refresh request sent from
+	// the server to the client.
+	RefreshSupport *bool `json:"refreshSupport,omitempty"`
+}
+
+// Capabilities relating to events from file operations by the user in the client.
+//
+// These events do not come from the file system, they come from user operations
+// like renaming a file in the UI.
+//
+// @since 3.16.0
+type FileOperationClientCapabilities struct {
+	// Whether the feature supports dynamic registration.
+	DynamicRegistration *bool `json:"dynamicRegistration,omitempty"`
+
+	// The client has support for sending didCreateFiles notifications.
+	DidCreate *bool `json:"didCreate,omitempty"`
+
+	// The client has support for sending willCreateFiles requests.
+	WillCreate *bool `json:"willCreate,omitempty"`
+
+	// The client has support for sending didRenameFiles notifications.
+	DidRename *bool `json:"didRename,omitempty"`
+
+	// The client has support for sending willRenameFiles requests.
+	WillRename *bool `json:"willRename,omitempty"`
+
+	// The client has support for sending didDeleteFiles notifications.
+	DidDelete *bool `json:"didDelete,omitempty"`
+
+	// The client has support for sending willDeleteFiles requests.
+	WillDelete *bool `json:"willDelete,omitempty"`
+}
+
+// Client workspace capabilities specific to inline values.
+//
+// @since 3.17.0
+type InlineValueWorkspaceClientCapabilities struct {
+	// Whether the client implementation supports a refresh request sent from
+	// the server to the client.
+	RefreshSupport *bool `json:"refreshSupport,omitempty"`
+}
+
+// Client workspace capabilities specific to inlay hints.
+//
+// @since 3.17.0
+type InlayHintWorkspaceClientCapabilities struct {
+	// Whether the client implementation supports a refresh request sent from
+	// the server to the client.
+	RefreshSupport *bool `json:"refreshSupport,omitempty"`
+}
+
+// Workspace client capabilities specific to diagnostic pull requests.
+//
+// @since 3.17.0
+type DiagnosticWorkspaceClientCapabilities struct {
+	// Whether the client implementation supports a refresh request sent from
+	// the server to the client.
+	RefreshSupport *bool `json:"refreshSupport,omitempty"`
+}
+
+// Text document specific client capabilities.
+type TextDocumentClientCapabilities struct {
+	// Defines which synchronization capabilities the client supports.
+	Synchronization *TextDocumentSyncClientCapabilities `json:"synchronization,omitempty"`
+
+	// Capabilities specific to the `textDocument/completion` request.
+	Completion *CompletionClientCapabilities `json:"completion,omitempty"`
+
+	// Capabilities specific to the `textDocument/hover` request.
+	Hover *HoverClientCapabilities `json:"hover,omitempty"`
+
+	// Capabilities specific to the `textDocument/signatureHelp` request.
+	SignatureHelp *SignatureHelpClientCapabilities `json:"signatureHelp,omitempty"`
+
+	// Capabilities specific to the `textDocument/declaration` request.
+	//
+	// @since 3.14.0
+	Declaration *DeclarationClientCapabilities `json:"declaration,omitempty"`
+
+	// Capabilities specific to the `textDocument/definition` request.
+	Definition *DefinitionClientCapabilities `json:"definition,omitempty"`
+
+	// Capabilities specific to the `textDocument/typeDefinition` request.
+	//
+	// @since 3.6.0
+	TypeDefinition *TypeDefinitionClientCapabilities `json:"typeDefinition,omitempty"`
+
+	// Capabilities specific to the `textDocument/implementation` request.
+	//
+	// @since 3.6.0
+	Implementation *ImplementationClientCapabilities `json:"implementation,omitempty"`
+
+	// Capabilities specific to the `textDocument/references` request.
+	References *ReferenceClientCapabilities `json:"references,omitempty"`
+
+	// Capabilities specific to the `textDocument/documentHighlight` request.
+	DocumentHighlight *DocumentHighlightClientCapabilities `json:"documentHighlight,omitempty"`
+
+	// Capabilities specific to the `textDocument/documentSymbol` request.
+	DocumentSymbol *DocumentSymbolClientCapabilities `json:"documentSymbol,omitempty"`
+
+	// Capabilities specific to the `textDocument/codeAction` request.
+	CodeAction *CodeActionClientCapabilities `json:"codeAction,omitempty"`
+
+	// Capabilities specific to the `textDocument/codeLens` request.
+	CodeLens *CodeLensClientCapabilities `json:"codeLens,omitempty"`
+
+	// Capabilities specific to the `textDocument/documentLink` request.
+	DocumentLink *DocumentLinkClientCapabilities `json:"documentLink,omitempty"`
+
+	// Capabilities specific to the `textDocument/documentColor` and the
+	// `textDocument/colorPresentation` request.
+	//
+	// @since 3.6.0
+	ColorProvider *DocumentColorClientCapabilities `json:"colorProvider,omitempty"`
+
+	// Capabilities specific to the `textDocument/formatting` request.
+	Formatting *DocumentFormattingClientCapabilities `json:"formatting,omitempty"`
+
+	// Capabilities specific to the `textDocument/rangeFormatting` request.
+	RangeFormatting *DocumentRangeFormattingClientCapabilities `json:"rangeFormatting,omitempty"`
+
+	// Capabilities specific to the `textDocument/onTypeFormatting` request.
+	OnTypeFormatting *DocumentOnTypeFormattingClientCapabilities `json:"onTypeFormatting,omitempty"`
+
+	// Capabilities specific to the `textDocument/rename` request.
+	Rename *RenameClientCapabilities `json:"rename,omitempty"`
+
+	// Capabilities specific to the `textDocument/foldingRange` request.
+	//
+	// @since 3.10.0
+	FoldingRange *FoldingRangeClientCapabilities `json:"foldingRange,omitempty"`
+
+	// Capabilities specific to the `textDocument/selectionRange` request.
+	//
+	// @since 3.15.0
+	SelectionRange *SelectionRangeClientCapabilities `json:"selectionRange,omitempty"`
+
+	// Capabilities specific to the `textDocument/publishDiagnostics` notification.
+	PublishDiagnostics *PublishDiagnosticsClientCapabilities `json:"publishDiagnostics,omitempty"`
+
+	// Capabilities specific to the various call hierarchy requests.
+	//
+	// @since 3.16.0
+	CallHierarchy *CallHierarchyClientCapabilities `json:"callHierarchy,omitempty"`
+
+	// Capabilities specific to the various semantic token request.
+	//
+	// @since 3.16.0
+	SemanticTokens *SemanticTokensClientCapabilities `json:"semanticTokens,omitempty"`
+
+	// Capabilities specific to the `textDocument/linkedEditingRange` request.
+	//
+	// @since 3.16.0
+	LinkedEditingRange *LinkedEditingRangeClientCapabilities `json:"linkedEditingRange,omitempty"`
+
+	// Client capabilities specific to the `textDocument/moniker` request.
+	//
+	// @since 3.16.0
+	Moniker *MonikerClientCapabilities `json:"moniker,omitempty"`
+
+	// Capabilities specific to the various type hierarchy requests.
+	//
+	// @since 3.17.0
+	TypeHierarchy *TypeHierarchyClientCapabilities `json:"typeHierarchy,omitempty"`
+
+	// Capabilities specific to the `textDocument/inlineValue` request.
+	//
+	// @since 3.17.0
+	InlineValue *InlineValueClientCapabilities `json:"inlineValue,omitempty"`
+
+	// Capabilities specific to the `textDocument/inlayHint` request.
+	//
+	// @since 3.17.0
+	InlayHint *InlayHintClientCapabilities `json:"inlayHint,omitempty"`
+
+	// Capabilities specific to the diagnostic pull model.
+	//
+	// @since 3.17.0
+	Diagnostic *DiagnosticClientCapabilities `json:"diagnostic,omitempty"`
+}
+
+type TextDocumentSyncClientCapabilities struct {
+	// Whether the feature supports dynamic registration.
+	DynamicRegistration *bool `json:"dynamicRegistration,omitempty"`
+
+	// The client supports sending will save notifications.
+	WillSave *bool `json:"willSave,omitempty"`
+
+	// The client supports sending a will save request and
+	// waits for a response providing text edits which will
+	// be applied to the document before it is saved.
+	WillSaveWaitUntil *bool `json:"willSaveWaitUntil,omitempty"`
+
+	// The client supports did save notifications.
+	DidSave *bool `json:"didSave,omitempty"`
+}
+
+// Completion client capabilities
+type CompletionClientCapabilities struct {
+	// Whether the feature supports dynamic registration.
+	DynamicRegistration *bool `json:"dynamicRegistration,omitempty"`
+
+	// The client supports the following `CompletionItem` specific
+	// capabilities.
+	CompletionItem     *CompletionClientCapabilitiesCompletionItem     `json:"completionItem,omitempty"`
+	CompletionItemKind *CompletionClientCapabilitiesCompletionItemKind `json:"completionItemKind,omitempty"`
+
+	// Defines how the client handles whitespace and indentation
+	// when accepting a completion item that uses multi line
+	// text in either `insertText` or `textEdit`.
+	//
+	// @since 3.17.0
+	InsertTextMode *InsertTextMode `json:"insertTextMode,omitempty"`
+
+	// The client supports to send additional context information for a
+	// `textDocument/completion` request.
+	ContextSupport *bool `json:"contextSupport,omitempty"`
+
+	// The client supports the following `CompletionList` specific
+	// capabilities.
+	//
+	// @since 3.17.0
+	CompletionList *CompletionClientCapabilitiesCompletionList `json:"completionList,omitempty"`
+}
+
+type HoverClientCapabilities struct {
+	// Whether the feature supports dynamic registration.
+	DynamicRegistration *bool `json:"dynamicRegistration,omitempty"`
+
+	// Client supports the following content formats for the content
+	// property. The order describes the preferred format of the client.
+	ContentFormat []MarkupKind `json:"contentFormat,omitempty"`
+}
+
+// Client Capabilities for a {@link SignatureHelpRequest}.
+type SignatureHelpClientCapabilities struct {
+	// Whether the feature supports dynamic registration.
+	DynamicRegistration *bool `json:"dynamicRegistration,omitempty"`
+
+	// The client supports the following `SignatureInformation`
+	// specific properties.
+	SignatureInformation *SignatureHelpClientCapabilitiesSignatureInformation `json:"signatureInformation,omitempty"`
+
+	// The client supports to send additional context information for a
+	// `textDocument/signatureHelp` request.
+	//
+	// @since 3.15.0
+	ContextSupport *bool `json:"contextSupport,omitempty"`
+}
+
+// @since 3.14.0
+type DeclarationClientCapabilities struct {
+	// Whether the feature supports dynamic registration.
+	DynamicRegistration *bool `json:"dynamicRegistration,omitempty"`
+
+	// The client supports additional metadata in the form of links.
+	LinkSupport *bool `json:"linkSupport,omitempty"`
+}
+
+// Client Capabilities for a {@link DefinitionRequest}.
+type DefinitionClientCapabilities struct {
+	// Whether the feature supports dynamic registration.
+	DynamicRegistration *bool `json:"dynamicRegistration,omitempty"`
+
+	// The client supports additional metadata in the form of links.
+	LinkSupport *bool `json:"linkSupport,omitempty"`
+}
+
+// Since 3.6.0
+type TypeDefinitionClientCapabilities struct {
+	// Whether the feature supports dynamic registration.
+	DynamicRegistration *bool `json:"dynamicRegistration,omitempty"`
+
+	// The client supports additional metadata in the form of links.
+	LinkSupport *bool `json:"linkSupport,omitempty"`
+}
+
+// @since 3.6.0
+type ImplementationClientCapabilities struct {
+	// Whether the feature supports dynamic registration.
+	DynamicRegistration *bool `json:"dynamicRegistration,omitempty"`
+
+	// The client supports additional metadata in the form of links.
+	LinkSupport *bool `json:"linkSupport,omitempty"`
+}
+
+// Client Capabilities for a {@link ReferencesRequest}.
+type ReferenceClientCapabilities struct {
+	// Whether the feature supports dynamic registration.
+	DynamicRegistration *bool `json:"dynamicRegistration,omitempty"`
+}
+
+// Client Capabilities for a {@link DocumentHighlightRequest}.
+type DocumentHighlightClientCapabilities struct {
+	// Whether the feature supports dynamic registration.
+	DynamicRegistration *bool `json:"dynamicRegistration,omitempty"`
+}
+
+// Client Capabilities for a {@link DocumentSymbolRequest}.
+type DocumentSymbolClientCapabilities struct {
+	// Whether the feature supports dynamic registration.
+	DynamicRegistration *bool `json:"dynamicRegistration,omitempty"`
+
+	// Specific capabilities for the `SymbolKind` in the
+	// `textDocument/documentSymbol` request.
+	SymbolKind *DocumentSymbolClientCapabilitiesSymbolKind `json:"symbolKind,omitempty"`
+
+	// The client supports hierarchical document symbols.
+	HierarchicalDocumentSymbolSupport *bool `json:"hierarchicalDocumentSymbolSupport,omitempty"`
+
+	// The client supports tags on `SymbolInformation`. Tags are supported on
+	// `DocumentSymbol` if `hierarchicalDocumentSymbolSupport` is set to true.
+	//
+	// @since 3.16.0
+	TagSupport *DocumentSymbolClientCapabilitiesTagSupport `json:"tagSupport,omitempty"`
+
+	// The client supports an additional label presented in the UI when
+	// registering a document symbol provider.
+	//
+	// @since 3.16.0
+	LabelSupport *bool `json:"labelSupport,omitempty"`
+}
+
+// The Client Capabilities of a {@link CodeActionRequest}.
+type CodeActionClientCapabilities struct {
+	// Whether the feature supports dynamic registration.
+	DynamicRegistration *bool `json:"dynamicRegistration,omitempty"`
+
+	// The client support code action literals of type `CodeAction` as a valid
+	// response of the `textDocument/codeAction` request. If the property is not
+	// set the request can only return `Command` literals.
+	//
+	// @since 3.8.0
+	CodeActionLiteralSupport *CodeActionClientCapabilitiesCodeActionLiteralSupport `json:"codeActionLiteralSupport,omitempty"`
+
+	// Whether code action supports the `isPreferred` property.
+	//
+	// @since 3.15.0
+	IsPreferredSupport *bool `json:"isPreferredSupport,omitempty"`
+
+	// Whether code action supports the `disabled` property.
+	//
+	// @since 3.16.0
+	DisabledSupport *bool `json:"disabledSupport,omitempty"`
+
+	// Whether code action supports the `data` property which is
+	// preserved between a `textDocument/codeAction` and a
+	// `codeAction/resolve` request.
+	//
+	// @since 3.16.0
+	DataSupport *bool `json:"dataSupport,omitempty"`
+
+	// Whether the client supports resolving additional code action
+	// properties via a separate `codeAction/resolve` request.
+	//
+	// @since 3.16.0
+	ResolveSupport *CodeActionClientCapabilitiesResolveSupport `json:"resolveSupport,omitempty"`
+
+	// Whether the client honors the change annotations in
+	// text edits and resource operations returned via the
+	// `CodeAction#edit` property by for example presenting
+	// the workspace edit in the user interface and asking
+	// for confirmation.
+	//
+	// @since 3.16.0
+	HonorsChangeAnnotations *bool `json:"honorsChangeAnnotations,omitempty"`
+}
+
+// The client capabilities  of a {@link CodeLensRequest}.
+type CodeLensClientCapabilities struct {
+	// Whether the feature supports dynamic registration.
+	DynamicRegistration *bool `json:"dynamicRegistration,omitempty"`
+}
+
+// The client capabilities of a {@link DocumentLinkRequest}.
+type DocumentLinkClientCapabilities struct {
+	// Whether the feature supports dynamic registration.
+	DynamicRegistration *bool `json:"dynamicRegistration,omitempty"`
+
+	// Whether the client supports the `tooltip` property on `DocumentLink`.
+	//
+	// @since 3.15.0
+	TooltipSupport *bool `json:"tooltipSupport,omitempty"`
+}
+
+type DocumentColorClientCapabilities struct {
+	// Whether the feature supports dynamic registration.
+	DynamicRegistration *bool `json:"dynamicRegistration,omitempty"`
+}
+
+// Client capabilities of a {@link DocumentFormattingRequest}.
+type DocumentFormattingClientCapabilities struct {
+	// Whether the feature supports dynamic registration.
+	DynamicRegistration *bool `json:"dynamicRegistration,omitempty"`
+}
+
+// Client capabilities of a {@link DocumentRangeFormattingRequest}.
+type DocumentRangeFormattingClientCapabilities struct {
+	// Whether the feature supports dynamic registration.
+	DynamicRegistration *bool `json:"dynamicRegistration,omitempty"`
+}
+
+// Client capabilities of a {@link DocumentOnTypeFormattingRequest}.
+type DocumentOnTypeFormattingClientCapabilities struct {
+	// Whether the feature supports dynamic registration.
+	DynamicRegistration *bool `json:"dynamicRegistration,omitempty"`
+}
+
+type RenameClientCapabilities struct {
+	// Whether the feature supports dynamic registration.
+	DynamicRegistration *bool `json:"dynamicRegistration,omitempty"`
+
+	// Client supports testing for validity of rename operations
+	// before execution.
+	//
+	// @since 3.12.0
+	PrepareSupport *bool `json:"prepareSupport,omitempty"`
+
+	// Client supports the default behavior result.
+	//
+	// The value indicates the default behavior used by the
+	// client.
+	//
+	// @since 3.16.0
+	PrepareSupportDefaultBehavior *PrepareSupportDefaultBehavior `json:"prepareSupportDefaultBehavior,omitempty"`
+
+	// Whether the client honors the change annotations in
+	// text edits and resource operations returned via the
+	// rename request's workspace edit by for example presenting
+	// the workspace edit in the user interface and asking
+	// for confirmation.
+	//
+	// @since 3.16.0
+	HonorsChangeAnnotations *bool `json:"honorsChangeAnnotations,omitempty"`
+}
+
+type FoldingRangeClientCapabilities struct {
+	// Whether the feature supports dynamic registration.
+	DynamicRegistration *bool `json:"dynamicRegistration,omitempty"`
+
+	// The maximum number of folding ranges that the client prefers to receive
+	// per document. The value serves as a hint, servers are free to follow the
+	// limit.
+	RangeLimit *uint32 `json:"rangeLimit,omitempty"`
+
+	// If set, the client signals that it only supports folding complete lines.
+	// If set, client will ignore specified `startCharacter` and `endCharacter`
+	// properties in a FoldingRange.
+	LineFoldingOnly *bool `json:"lineFoldingOnly,omitempty"`
+
+	// Specific options for the folding range kind.
+	//
+	// @since 3.17.0
+	FoldingRangeKind *FoldingRangeClientCapabilitiesFoldingRangeKind `json:"foldingRangeKind,omitempty"`
+
+	// Specific options for the folding range.
+	//
+	// @since 3.17.0
+	FoldingRange *FoldingRangeClientCapabilitiesFoldingRange `json:"foldingRange,omitempty"`
+}
+
+type SelectionRangeClientCapabilities struct {
+	// Whether the feature supports dynamic registration.
+	DynamicRegistration *bool `json:"dynamicRegistration,omitempty"`
+}
+
+// The publish diagnostic client capabilities.
+type PublishDiagnosticsClientCapabilities struct {
+	// Whether the clients accepts diagnostics with related information.
+	RelatedInformation *bool `json:"relatedInformation,omitempty"`
+
+	// Client supports the tag property to provide meta data about a diagnostic.
+	// Clients supporting tags have to handle unknown tags gracefully.
+	//
+	// @since 3.15.0
+	TagSupport *PublishDiagnosticsClientCapabilitiesTagSupport `json:"tagSupport,omitempty"`
+
+	// Whether the client interprets the version property of the
+	// `textDocument/publishDiagnostics` notification's parameter.
+	//
+	// @since 3.15.0
+	VersionSupport *bool `json:"versionSupport,omitempty"`
+
+	// Client supports a codeDescription property
+	//
+	// @since 3.16.0
+	CodeDescriptionSupport *bool `json:"codeDescriptionSupport,omitempty"`
+
+	// Whether code action supports the `data` property which is
+	// preserved between a `textDocument/publishDiagnostics` and
+	// `textDocument/codeAction` request.
+	//
+	// @since 3.16.0
+	DataSupport *bool `json:"dataSupport,omitempty"`
+}
+
+// @since 3.16.0
+type CallHierarchyClientCapabilities struct {
+	// Whether the feature supports dynamic registration.
+	DynamicRegistration *bool `json:"dynamicRegistration,omitempty"`
+}
+
+// @since 3.16.0
+type SemanticTokensClientCapabilities struct {
+	// Whether the feature supports dynamic registration.
+	DynamicRegistration *bool `json:"dynamicRegistration,omitempty"`
+
+	// Which requests the client supports and might send to the server
+	// depending on the server's capability.
+	Requests SemanticTokensClientCapabilitiesRequests `json:"requests"`
+
+	// The token types that the client supports.
+	TokenTypes []string `json:"tokenTypes"`
+
+	// The token modifiers that the client supports.
+	TokenModifiers []string `json:"tokenModifiers"`
+
+	// The token formats the clients supports.
+	Formats []TokenFormat `json:"formats"`
+
+	// Whether the client supports tokens that can overlap each other.
+	OverlappingTokenSupport *bool `json:"overlappingTokenSupport,omitempty"`
+
+	// Whether the client supports tokens that can span multiple lines.
+	MultilineTokenSupport *bool `json:"multilineTokenSupport,omitempty"`
+
+	// Whether the client allows the server to actively cancel a
+	// semantic token request, e.g. supports returning
+	// LSPErrorCodes.ServerCancelled.
+	//
+	// @since 3.17.0
+	ServerCancelSupport *bool `json:"serverCancelSupport,omitempty"`
+
+	// Whether the client uses semantic tokens to augment existing
+	// syntax tokens.
+	//
+	// @since 3.17.0
+	AugmentsSyntaxTokens *bool `json:"augmentsSyntaxTokens,omitempty"`
+}
+
+// Client capabilities for the linked editing range request.
+//
+// @since 3.16.0
+type LinkedEditingRangeClientCapabilities struct {
+	// Whether the feature supports dynamic registration.
+	DynamicRegistration *bool `json:"dynamicRegistration,omitempty"`
+}
+
+// Client capabilities specific to the moniker request.
+//
+// @since 3.16.0
+type MonikerClientCapabilities struct {
+	// Whether the feature supports dynamic registration.
+	DynamicRegistration *bool `json:"dynamicRegistration,omitempty"`
+}
+
+// @since 3.17.0
+type TypeHierarchyClientCapabilities struct {
+	// Whether the feature supports dynamic registration.
+	DynamicRegistration *bool `json:"dynamicRegistration,omitempty"`
+}
+
+// Client capabilities specific to inline values.
+//
+// @since 3.17.0
+type InlineValueClientCapabilities struct {
+	// Whether the feature supports dynamic registration.
+	DynamicRegistration *bool `json:"dynamicRegistration,omitempty"`
+}
+
+// Inlay hint client capabilities.
+//
+// @since 3.17.0
+type InlayHintClientCapabilities struct {
+	// Whether the feature supports dynamic registration.
+	DynamicRegistration *bool `json:"dynamicRegistration,omitempty"`
+
+	// Indicates which properties a client can resolve lazily on an inlay
+	// hint.
+	ResolveSupport *InlayHintClientCapabilitiesResolveSupport `json:"resolveSupport,omitempty"`
+}
+
+// Client capabilities specific to diagnostic pull requests.
+//
+// @since 3.17.0
+type DiagnosticClientCapabilities struct {
+	// Whether the feature supports dynamic registration.
+	DynamicRegistration *bool `json:"dynamicRegistration,omitempty"`
+
+	// Whether the clients supports related documents for document diagnostic pulls.
+	RelatedDocumentSupport *bool `json:"relatedDocumentSupport,omitempty"`
+}
+
+// Capabilities specific to the notebook document support.
+//
+// @since 3.17.0
+type NotebookDocumentClientCapabilities struct {
+	// Capabilities specific to notebook document synchronization
+	//
+	// @since 3.17.0
+	Synchronization NotebookDocumentSyncClientCapabilities `json:"synchronization"`
+}
+
+// Notebook specific client capabilities.
+//
+// @since 3.17.0
+type NotebookDocumentSyncClientCapabilities struct {
+	// Whether the feature supports dynamic registration.
+	DynamicRegistration *bool `json:"dynamicRegistration,omitempty"`
+
+	// The client supports sending execution summary data per cell.
+	ExecutionSummarySupport *bool `json:"executionSummarySupport,omitempty"`
+}
+
+type WindowClientCapabilities struct {
+	// It indicates whether the client supports server initiated
+	// progress using the `window/workDoneProgress/create` request.
+	//
+	// @since 3.15.0
+	WorkDoneProgress *bool `json:"workDoneProgress,omitempty"`
+
+	// Capabilities specific to the showMessage request.
+	//
+	// @since 3.16.0
+	ShowMessage *ShowMessageRequestClientCapabilities `json:"showMessage,omitempty"`
+
+	// Capabilities specific to the showDocument request.
+	//
+	// @since 3.16.0
+	ShowDocument *ShowDocumentClientCapabilities `json:"showDocument,omitempty"`
+}
+
+// Show message request client capabilities
+type ShowMessageRequestClientCapabilities struct {
+	// Capabilities specific to the `MessageActionItem` type.
+	MessageActionItem *ShowMessageRequestClientCapabilitiesMessageActionItem `json:"messageActionItem,omitempty"`
+}
+
+// Client capabilities for the showDocument request.
+//
+// @since 3.16.0
+type ShowDocumentClientCapabilities struct {
+	// The client has support for the showDocument
+	// request.
+	Support bool `json:"support"`
+}
+
+// General client capabilities.
+//
+// @since 3.16.0
+type GeneralClientCapabilities struct {
+	// Client capability that signals how the client
+	// handles stale requests (e.g. a request
+	// for which the client will not process the response
+	// anymore since the information is outdated).
+	//
+	// @since 3.17.0
+	StaleRequestSupport *GeneralClientCapabilitiesStaleRequestSupport `json:"staleRequestSupport,omitempty"`
+
+	// Client capabilities specific to regular expressions.
+	//
+	// @since 3.16.0
+	RegularExpressions *RegularExpressionsClientCapabilities `json:"regularExpressions,omitempty"`
+
+	// Client capabilities specific to the client's markdown parser.
+	//
+	// @since 3.16.0
+	Markdown *MarkdownClientCapabilities `json:"markdown,omitempty"`
+
+	// The position encodings supported by the client. Client and server
+	// have to agree on the same position encoding to ensure that offsets
+	// (e.g. character position in a line) are interpreted the same on both
+	// sides.
+	//
+	// To keep the protocol backwards compatible the following applies: if
+	// the value 'utf-16' is missing from the array of position encodings
+	// servers can assume that the client supports UTF-16. UTF-16 is
+	// therefore a mandatory encoding.
+	//
+	// If omitted it defaults to ['utf-16'].
+	//
+	// @since 3.17.0
+	PositionEncodings []PositionEncodingKind `json:"positionEncodings,omitempty"`
+}
+
+// Client capabilities specific to regular expressions.
+//
+// @since 3.16.0
+type RegularExpressionsClientCapabilities struct {
+	// The engine's name.
+	Engine string `json:"engine"`
+
+	// The engine's version.
+	Version *string `json:"version,omitempty"`
+}
+
+// Client capabilities specific to the used markdown parser.
+//
+// @since 3.16.0
+type MarkdownClientCapabilities struct {
+	// The name of the parser.
+	Parser string `json:"parser"`
+
+	// The version of the parser.
+	Version *string `json:"version,omitempty"`
+
+	// A list of HTML tags that the client allows / supports in
+	// Markdown.
+	//
+	// @since 3.17.0
+	AllowedTags []string `json:"allowedTags,omitempty"`
+}
+
+// Defines the capabilities provided by a language
+// server.
+type ServerCapabilities struct {
+	// The position encoding the server picked from the encodings offered
+	// by the client via the client capability `general.positionEncodings`.
+	//
+	// If the client didn't provide any position encodings the only valid
+	// value that a server can return is 'utf-16'.
+	//
+	// If omitted it defaults to 'utf-16'.
+	//
+	// @since 3.17.0
+	PositionEncoding *PositionEncodingKind `json:"positionEncoding,omitempty"`
+
+	// Defines how text documents are synced. Is either a detailed structure
+	// defining each notification or for backwards compatibility the
+	// TextDocumentSyncKind number.
+	TextDocumentSync *Or_TextDocumentSyncOptions_TextDocumentSyncKind `json:"textDocumentSync,omitempty"`
+
+	// Defines how notebook documents are synced.
+	//
+	// @since 3.17.0
+	NotebookDocumentSync *Or_NotebookDocumentSyncOptions_NotebookDocumentSyncRegistrationOptions `json:"notebookDocumentSync,omitempty"`
+
+	// The server provides completion support.
+	CompletionProvider *CompletionOptions `json:"completionProvider,omitempty"`
+
+	// The server provides hover support.
+	HoverProvider *Or_Bool_HoverOptions `json:"hoverProvider,omitempty"`
+
+	// The server provides signature help support.
+	SignatureHelpProvider *SignatureHelpOptions `json:"signatureHelpProvider,omitempty"`
+
+	// The server provides Goto Declaration support.
+	DeclarationProvider *Or_Bool_DeclarationOptions_DeclarationRegistrationOptions `json:"declarationProvider,omitempty"`
+
+	// The server provides goto definition support.
+	DefinitionProvider *Or_Bool_DefinitionOptions `json:"definitionProvider,omitempty"`
+
+	// The server provides Goto Type Definition support.
+	TypeDefinitionProvider *Or_Bool_TypeDefinitionOptions_TypeDefinitionRegistrationOptions `json:"typeDefinitionProvider,omitempty"`
+
+	// The server provides Goto Implementation support.
+	ImplementationProvider *Or_Bool_ImplementationOptions_ImplementationRegistrationOptions `json:"implementationProvider,omitempty"`
+
+	// The server provides find references support.
+	ReferencesProvider *Or_Bool_ReferenceOptions `json:"referencesProvider,omitempty"`
+
+	// The server provides document highlight support.
+	DocumentHighlightProvider *Or_Bool_DocumentHighlightOptions `json:"documentHighlightProvider,omitempty"`
+
+	// The server provides document symbol support.
+	DocumentSymbolProvider *Or_Bool_DocumentSymbolOptions `json:"documentSymbolProvider,omitempty"`
+
+	// The server provides code actions. CodeActionOptions may only be
+	// specified if the client states that it supports
+	// `codeActionLiteralSupport` in its initial `initialize` request.
+	CodeActionProvider *Or_Bool_CodeActionOptions `json:"codeActionProvider,omitempty"`
+
+	// The server provides code lens.
+	CodeLensProvider *CodeLensOptions `json:"codeLensProvider,omitempty"`
+
+	// The server provides document link support.
+	DocumentLinkProvider *DocumentLinkOptions `json:"documentLinkProvider,omitempty"`
+
+	// The server provides color provider support.
+	ColorProvider *Or_Bool_DocumentColorOptions_DocumentColorRegistrationOptions `json:"colorProvider,omitempty"`
+
+	// The server provides workspace symbol support.
+	WorkspaceSymbolProvider *Or_Bool_WorkspaceSymbolOptions `json:"workspaceSymbolProvider,omitempty"`
+
+	// The server provides document formatting.
+	DocumentFormattingProvider *Or_Bool_DocumentFormattingOptions `json:"documentFormattingProvider,omitempty"`
+
+	// The server provides document range formatting.
+	DocumentRangeFormattingProvider *Or_Bool_DocumentRangeFormattingOptions `json:"documentRangeFormattingProvider,omitempty"`
+
+	// The server provides document formatting on typing.
+	DocumentOnTypeFormattingProvider *DocumentOnTypeFormattingOptions `json:"documentOnTypeFormattingProvider,omitempty"`
+
+	// The server provides rename support. RenameOptions may only be
+	// specified if the client states that it supports
+	// `prepareSupport` in its initial `initialize` request.
+	RenameProvider *Or_Bool_RenameOptions `json:"renameProvider,omitempty"`
+
+	// The server provides folding provider support.
+	FoldingRangeProvider *Or_Bool_FoldingRangeOptions_FoldingRangeRegistrationOptions `json:"foldingRangeProvider,omitempty"`
+
+	// The server provides selection range support.
+	SelectionRangeProvider *Or_Bool_SelectionRangeOptions_SelectionRangeRegistrationOptions `json:"selectionRangeProvider,omitempty"`
+
+	// The server provides execute command support.
+	ExecuteCommandProvider *ExecuteCommandOptions `json:"executeCommandProvider,omitempty"`
+
+	// The server provides call hierarchy support.
+	//
+	// @since 3.16.0
+	CallHierarchyProvider *Or_Bool_CallHierarchyOptions_CallHierarchyRegistrationOptions `json:"callHierarchyProvider,omitempty"`
+
+	// The server provides linked editing range support.
+	//
+	// @since 3.16.0
+	LinkedEditingRangeProvider *Or_Bool_LinkedEditingRangeOptions_LinkedEditingRangeRegistrationOptions `json:"linkedEditingRangeProvider,omitempty"`
+
+	// The server provides semantic tokens support.
+	//
+	// @since 3.16.0
+	SemanticTokensProvider *Or_SemanticTokensOptions_SemanticTokensRegistrationOptions `json:"semanticTokensProvider,omitempty"`
+
+	// The server provides moniker support.
+	//
+	// @since 3.16.0
+	MonikerProvider *Or_Bool_MonikerOptions_MonikerRegistrationOptions `json:"monikerProvider,omitempty"`
+
+	// The server provides type hierarchy support.
+	//
+	// @since 3.17.0
+	TypeHierarchyProvider *Or_Bool_TypeHierarchyOptions_TypeHierarchyRegistrationOptions `json:"typeHierarchyProvider,omitempty"`
+
+	// The server provides inline values.
+	//
+	// @since 3.17.0
+	InlineValueProvider *Or_Bool_InlineValueOptions_InlineValueRegistrationOptions `json:"inlineValueProvider,omitempty"`
+
+	// The server provides inlay hints.
+	//
+	// @since 3.17.0
+	InlayHintProvider *Or_Bool_InlayHintOptions_InlayHintRegistrationOptions `json:"inlayHintProvider,omitempty"`
+
+	// The server has support for pull model diagnostics.
+	//
+	// @since 3.17.0
+	DiagnosticProvider *Or_DiagnosticOptions_DiagnosticRegistrationOptions `json:"diagnosticProvider,omitempty"`
+
+	// Workspace specific server capabilities.
+	Workspace *ServerCapabilitiesWorkspace `json:"workspace,omitempty"`
+
+	// Experimental server capabilities.
+	Experimental interface{} `json:"experimental,omitempty"`
+}
+
+type WorkspaceFoldersServerCapabilities struct {
+	// The server has support for workspace folders
+	Supported *bool `json:"supported,omitempty"`
+
+	// Whether the server wants to receive workspace folder
+	// change notifications.
+	//
+	// If a string is provided the string is treated as an ID
+	// under which the notification is registered on the client
+	// side. The ID can be used to unregister for these events
+	// using the `client/unregisterCapability` request.
+	ChangeNotifications *Or_String_Bool `json:"changeNotifications,omitempty"`
+}
+
+// Options for notifications/requests for user operations on files.
+//
+// @since 3.16.0
+type FileOperationOptions struct {
+	// The server is interested in receiving didCreateFiles notifications.
+	DidCreate *FileOperationRegistrationOptions `json:"didCreate,omitempty"`
+
+	// The server is interested in receiving willCreateFiles requests.
+	WillCreate *FileOperationRegistrationOptions `json:"willCreate,omitempty"`
+
+	// The server is interested in receiving didRenameFiles notifications.
+	DidRename *FileOperationRegistrationOptions `json:"didRename,omitempty"`
+
+	// The server is interested in receiving willRenameFiles requests.
+	WillRename *FileOperationRegistrationOptions `json:"willRename,omitempty"`
+
+	// The server is interested in receiving didDeleteFiles file notifications.
+	DidDelete *FileOperationRegistrationOptions `json:"didDelete,omitempty"`
+
+	// The server is interested in receiving willDeleteFiles file requests.
+	WillDelete *FileOperationRegistrationOptions `json:"willDelete,omitempty"`
+}
+
+// The options to register for file operations.
+//
+// @since 3.16.0
+type FileOperationRegistrationOptions struct {
+	// The actual filters.
+	Filters []FileOperationFilter `json:"filters"`
+}
+
+// A filter to describe in which file operation requests or notifications
+// the server is interested in receiving.
+//
+// @since 3.16.0
+type FileOperationFilter struct {
+	// A Uri scheme like `file` or `untitled`.
+	Scheme *string `json:"scheme,omitempty"`
+
+	// The actual file operation pattern.
+	Pattern FileOperationPattern `json:"pattern"`
+}
+
+// A pattern to describe in which file operation requests or notifications
+// the server is interested in receiving.
+//
+// @since 3.16.0
+type FileOperationPattern struct {
+	// The glob pattern to match. Glob patterns can have the following syntax:
+	// - `*` to match one or more characters in a path segment
+	// - `?` to match on one character in a path segment
+	// - `**` to match any number of path segments, including none
+	// - `{}` to group sub patterns into an OR expression. (e.g. `**​/*.{ts,js}` matches all TypeScript and JavaScript files)
+	// - `[]` to declare a range of characters to match in a path segment (e.g., `example.[0-9]` to match on `example.0`, `example.1`, …)
+	// - `[!...]` to negate a range of characters to match in a path segment (e.g., `example.[!0-9]` to match on `example.a`, `example.b`, but not `example.0`)
+	Glob string `json:"glob"`
+
+	// Whether to match files or folders with this pattern.
+	//
+	// Matches both if undefined.
+	Matches *FileOperationPatternKind `json:"matches,omitempty"`
+
+	// Additional options used during matching.
+	Options *FileOperationPatternOptions `json:"options,omitempty"`
+}
+
+// Matching options for the file operation pattern.
+//
+// @since 3.16.0
+type FileOperationPatternOptions struct {
+	// The pattern should be matched ignoring casing.
+	IgnoreCase *bool `json:"ignoreCase,omitempty"`
+}
+
+// A workspace folder inside a client.
+type WorkspaceFolder struct {
+	// The associated URI for this workspace folder.
+	Uri URI `json:"uri"`
+
+	// The name of the workspace folder. Used to refer to this
+	// workspace folder in the user interface.
+	Name string `json:"name"`
+}
+
+// The workspace folder change event.
+type WorkspaceFoldersChangeEvent struct {
+	// The array of added workspace folders
+	Added []WorkspaceFolder `json:"added"`
+
+	// The array of the removed workspace folders
+	Removed []WorkspaceFolder `json:"removed"`
+}
+
+// The parameters of a `workspace/didChangeWorkspaceFolders` notification.
+type DidChangeWorkspaceFoldersParams struct {
+	// The actual workspace folder change event.
+	Event WorkspaceFoldersChangeEvent `json:"event"`
+}
+
+// The parameters of a change configuration notification.
+type DidChangeConfigurationParams struct {
+	// The actual changed settings
+	Settings interface{} `json:"settings"`
+}
+
+type DidChangeConfigurationRegistrationOptions struct {
+	Section *Or_String_StringSlice `json:"section,omitempty"`
+}
+
+type ConfigurationItem struct {
+	// The scope to get the configuration section for.
+	ScopeUri *string `json:"scopeUri,omitempty"`
+
+	// The configuration section asked for.
+	Section *string `json:"section,omitempty"`
+}
+
+// The parameters of a configuration request.
+type ConfigurationParams struct {
+	Items []ConfigurationItem `json:"items"`
+}
+
+// The watched files change notification's parameters.
+type DidChangeWatchedFilesParams struct {
+	// The actual file events.
+	Changes []FileEvent `json:"changes"`
+}
+
+// An event describing a file change.
+type FileEvent struct {
+	// The file's uri.
+	Uri DocumentURI `json:"uri"`
+
+	// The change type.
+	Type FileChangeType `json:"type"`
+}
+
+// Describe options to be used when registered for text document change events.
+type DidChangeWatchedFilesRegistrationOptions struct {
+	// The watchers to register.
+	Watchers []FileSystemWatcher `json:"watchers"`
+}
+
+type FileSystemWatcher struct {
+	// The glob pattern to watch. See {@link GlobPattern glob pattern} for more detail.
+	//
+	// @since 3.17.0 support for relative patterns.
+	GlobPattern GlobPattern `json:"globPattern"`
+
+	// The kind of events of interest. If omitted it defaults
+	// to WatchKind.Create | WatchKind.Change | WatchKind.Delete
+	// which is 7.
+	Kind *WatchKind `json:"kind,omitempty"`
+}
+
+// A relative pattern is a helper to construct glob patterns that are matched
+// relatively to a base URI. The common value for a `baseUri` is a workspace
+// folder root, but it can be another absolute URI as well.
+//
+// @since 3.17.0
+type RelativePattern struct {
+	// A workspace folder or a base URI to which this pattern will be matched
+	// against relatively.
+	BaseUri Or_WorkspaceFolder_URI `json:"baseUri"`
+
+	// The actual glob pattern;
+	Pattern Pattern `json:"pattern"`
+}
+
+// A base for all symbol information.
+type BaseSymbolInformation struct {
+	// The name of this symbol.
+	Name string `json:"name"`
+
+	// The kind of this symbol.
+	Kind SymbolKind `json:"kind"`
+
+	// Tags for this symbol.
+	//
+	// @since 3.16.0
+	Tags []SymbolTag `json:"tags,omitempty"`
+
+	// The name of the symbol containing this symbol. This information is for
+	// user interface purposes (e.g. to render a qualifier in the user interface
+	// if necessary). It can't be used to re-infer a hierarchy for the document
+	// symbols.
+	ContainerName *string `json:"containerName,omitempty"`
+}
+
+// Represents information about programming constructs like variables, classes,
+// interfaces etc.
+type SymbolInformation struct {
+	BaseSymbolInformation
+	// Indicates if this symbol is deprecated.
+	//
+	// @deprecated Use tags instead
+	Deprecated *bool `json:"deprecated,omitempty"`
+
+	// The location of this symbol.
+	Location Location `json:"location"`
+}
+
+// A special workspace symbol that supports locations without a range.
+//
+// See also SymbolInformation.
+//
+// @since 3.17.0
+type WorkspaceSymbol struct {
+	BaseSymbolInformation
+	// The location of the symbol. Whether a server is allowed to
+	// return a location without a range depends on the client
+	// capability `workspace.symbol.resolveSupport`.
+	//
+	// See SymbolInformation#location for more details.
+	Location WorkspaceSymbolLocation `json:"location"`
+
+	// A data entry field that is preserved on a workspace symbol between a
+	// workspace symbol request and a workspace symbol resolve request.
+	Data interface{} `json:"data,omitempty"`
+}
+
+// The parameters of a {@link WorkspaceSymbolRequest}.
+type WorkspaceSymbolParams struct {
+	WorkDoneProgressParams
+	PartialResultParams
+	// A query string to filter symbols by. Clients may send an empty
+	// string here to request all symbols.
+	Query string `json:"query"`
+}
+
+// Server capabilities for a {@link WorkspaceSymbolRequest}.
+type WorkspaceSymbolOptions struct {
+	WorkDoneProgressOptions
+	// The server provides support to resolve additional
+	// information for a workspace symbol.
+	//
+	// @since 3.17.0
+	ResolveProvider *bool `json:"resolveProvider,omitempty"`
+}
+
+// Registration options for a {@link WorkspaceSymbolRequest}.
+type WorkspaceSymbolRegistrationOptions struct {
+	WorkspaceSymbolOptions
+}
+
+// The parameters of a {@link ExecuteCommandRequest}.
+type ExecuteCommandParams struct {
+	WorkDoneProgressParams
+	// The identifier of the actual command handler.
+	Command string `json:"command"`
+
+	// Arguments that the command should be invoked with.
+	Arguments []interface{} `json:"arguments,omitempty"`
+}
+
+// The server capabilities of a {@link ExecuteCommandRequest}.
+type ExecuteCommandOptions struct {
+	WorkDoneProgressOptions
+	// The commands to be executed on the server
+	Commands []string `json:"commands"`
+}
+
+// Registration options for a {@link ExecuteCommandRequest}.
+type ExecuteCommandRegistrationOptions struct {
+	ExecuteCommandOptions
+}
+
+// The parameters passed via a apply workspace edit request.
+type ApplyWorkspaceEditParams struct {
+	// An optional label of the workspace edit. This label is
+	// presented in the user interface for example on an undo
+	// stack to undo the workspace edit.
+	Label *string `json:"label,omitempty"`
+
+	// The edits to apply.
+	Edit WorkspaceEdit `json:"edit"`
+}
+
+// The result returned from the apply workspace edit request.
+//
+// @since 3.17 renamed from ApplyWorkspaceEditResponse
+type ApplyWorkspaceEditResult struct {
+	// Indicates whether the edit was applied or not.
+	Applied bool `json:"applied"`
+
+	// An optional textual description for why the edit was not applied.
+	// This may be used by the server for diagnostic logging or to provide
+	// a suitable error for a request that triggered the edit.
+	FailureReason *string `json:"failureReason,omitempty"`
+
+	// Depending on the client's failure handling strategy `failedChange` might
+	// contain the index of the change that failed. This property is only available
+	// if the client signals a `failureHandlingStrategy` in its client capabilities.
+	FailedChange *uint32 `json:"failedChange,omitempty"`
+}
+
+// Represents information on a file/folder create.
+//
+// @since 3.16.0
+type FileCreate struct {
+	// A file:// URI for the location of the file/folder being created.
+	Uri string `json:"uri"`
+}
+
+// The parameters sent in notifications/requests for user-initiated creation of
+// files.
+//
+// @since 3.16.0
+type CreateFilesParams struct {
+	// An array of all files/folders created in this operation.
+	Files []FileCreate `json:"files"`
+}
+
+// Represents information on a file/folder rename.
+//
+// @since 3.16.0
+type FileRename struct {
+	// A file:// URI for the original location of the file/folder being renamed.
+	OldUri string `json:"oldUri"`
+
+	// A file:// URI for the new location of the file/folder being renamed.
+	NewUri string `json:"newUri"`
+}
+
+// The parameters sent in notifications/requests for user-initiated renames of
+// files.
+//
+// @since 3.16.0
+type RenameFilesParams struct {
+	// An array of all files/folders renamed in this operation. When a folder is renamed, only
+	// the folder will be included, and not its children.
+	Files []FileRename `json:"files"`
+}
+
+// Represents information on a file/folder delete.
+//
+// @since 3.16.0
+type FileDelete struct {
+	// A file:// URI for the location of the file/folder being deleted.
+	Uri string `json:"uri"`
+}
+
+// The parameters sent in notifications/requests for user-initiated deletes of
+// files.
+//
+// @since 3.16.0
+type DeleteFilesParams struct {
+	// An array of all files/folders deleted in this operation.
+	Files []FileDelete `json:"files"`
+}
+
+// The parameters of a notification message.
+type ShowMessageParams struct {
+	// The message type. See {@link MessageType}
+	Type MessageType `json:"type"`
+
+	// The actual message.
+	Message string `json:"message"`
+}
+
+type MessageActionItem struct {
+	// A short title like 'Retry', 'Open Log' etc.
+	Title string `json:"title"`
+}
+
+type ShowMessageRequestParams struct {
+	// The message type. See {@link MessageType}
+	Type MessageType `json:"type"`
+
+	// The actual message.
+	Message string `json:"message"`
+
+	// The message action items to present.
+	Actions []MessageActionItem `json:"actions,omitempty"`
+}
+
+// The log message parameters.
+type LogMessageParams struct {
+	// The message type. See {@link MessageType}
+	Type MessageType `json:"type"`
+
+	// The actual message.
+	Message string `json:"message"`
+}
+
+// Params to show a resource in the UI.
+//
+// @since 3.16.0
+type ShowDocumentParams struct {
+	// The uri to show.
+	Uri URI `json:"uri"`
+
+	// Indicates to show the resource in an external program.
+	// To show, for example, `https://code.visualstudio.com/`
+	// in the default WEB browser set `external` to `true`.
+	External *bool `json:"external,omitempty"`
+
+	// An optional property to indicate whether the editor
+	// showing the document should take focus or not.
+	// Clients might ignore this property if an external
+	// program is started.
+	TakeFocus *bool `json:"takeFocus,omitempty"`
+
+	// An optional selection range if the document is a text
+	// document. Clients might ignore the property if an
+	// external program is started or the file is not a text
+	// file.
+	Selection *Range `json:"selection,omitempty"`
+}
+
+// The result of a showDocument request.
+//
+// @since 3.16.0
+type ShowDocumentResult struct {
+	// A boolean indicating if the show was successful.
+	Success bool `json:"success"`
+}
+
+// General parameters to register for a notification or to register a provider.
+type Registration struct {
+	// The id used to register the request. The id can be used to deregister
+	// the request again.
+	Id string `json:"id"`
+
+	// The method / capability to register for.
+	Method string `json:"method"`
+
+	// Options necessary for the registration.
+	RegisterOptions interface{} `json:"registerOptions,omitempty"`
+}
+
+type RegistrationParams struct {
+	Registrations []Registration `json:"registrations"`
+}
+
+// General parameters to unregister a request or notification.
+type Unregistration struct {
+	// The id used to unregister the request or notification. Usually an id
+	// provided during the register request.
+	Id string `json:"id"`
+
+	// The method to unregister for.
+	Method string `json:"method"`
+}
+
+type UnregistrationParams struct {
+	Unregisterations []Unregistration `json:"unregisterations"`
+}
+
+type TextDocumentSyncOptions struct {
+	// Open and close notifications are sent to the server. If omitted open close notification should not
+	// be sent.
+	OpenClose *bool `json:"openClose,omitempty"`
+
+	// Change notifications are sent to the server. See TextDocumentSyncKind.None, TextDocumentSyncKind.Full
+	// and TextDocumentSyncKind.Incremental. If omitted it defaults to TextDocumentSyncKind.None.
+	Change *TextDocumentSyncKind `json:"change,omitempty"`
+
+	// If present will save notifications are sent to the server. If omitted the notification should not be
+	// sent.
+	WillSave *bool `json:"willSave,omitempty"`
+
+	// If present will save wait until requests are sent to the server. If omitted the request should not be
+	// sent.
+	WillSaveWaitUntil *bool `json:"willSaveWaitUntil,omitempty"`
+
+	// If present save notifications are sent to the server. If omitted the notification should not be
+	// sent.
+	Save *Or_Bool_SaveOptions `json:"save,omitempty"`
+}
+
+// Save options.
+type SaveOptions struct {
+	// The client is supposed to include the content on save.
+	IncludeText *bool `json:"includeText,omitempty"`
+}
+
+// Save registration options.
+type TextDocumentSaveRegistrationOptions struct {
+	TextDocumentRegistrationOptions
+	SaveOptions
+}
+
+// Describe options to be used when registered for text document change events.
+type TextDocumentChangeRegistrationOptions struct {
+	TextDocumentRegistrationOptions
+	// How documents are synced to the server.
+	SyncKind TextDocumentSyncKind `json:"syncKind"`
+}
+
+// The parameters sent in an open text document notification
+type DidOpenTextDocumentParams struct {
+	// The document that was opened.
+	TextDocument TextDocumentItem `json:"textDocument"`
+}
+
+// The change text document notification's parameters.
+type DidChangeTextDocumentParams struct {
+	// The document that did change. The version number points
+	// to the version after all provided content changes have
+	// been applied.
+	TextDocument VersionedTextDocumentIdentifier `json:"textDocument"`
+
+	// The actual content changes. The content changes describe single state changes
+	// to the document. So if there are two content changes c1 (at array index 0) and
+	// c2 (at array index 1) for a document in state S then c1 moves the document from
+	// S to S' and c2 from S' to S''. So c1 is computed on the state S and c2 is computed
+	// on the state S'.
+	ContentChanges []TextDocumentContentChangeEvent `json:"contentChanges"`
+}
+
+// The parameters sent in a save text document notification
+type DidSaveTextDocumentParams struct {
+	// The document that was saved.
+	TextDocument TextDocumentIdentifier `json:"textDocument"`
+
+	// Optional the content when saved. Depends on the includeText value
+	// when the save notification was requested.
+	Text *string `json:"text,omitempty"`
+}
+
+// The parameters sent in a will save text document notification.
+type WillSaveTextDocumentParams struct {
+	// The document that will be saved.
+	TextDocument TextDocumentIdentifier `json:"textDocument"`
+
+	// The 'TextDocumentSaveReason'.
+	Reason TextDocumentSaveReason `json:"reason"`
+}
+
+// The parameters sent in a close text document notification
+type DidCloseTextDocumentParams struct {
+	// The document that was closed.
+	TextDocument TextDocumentIdentifier `json:"textDocument"`
+}
+
+// A notebook document.
+//
+// @since 3.17.0
+type NotebookDocument struct {
+	// The notebook document's uri.
+	Uri URI `json:"uri"`
+
+	// The type of the notebook.
+	NotebookType string `json:"notebookType"`
+
+	// The version number of this document (it will increase after each
+	// change, including undo/redo).
+	Version int32 `json:"version"`
+
+	// Additional metadata stored with the notebook
+	// document.
+	//
+	// Note: should always be an object literal (e.g. LSPObject)
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+
+	// The cells of a notebook.
+	Cells []NotebookCell `json:"cells"`
+}
+
+// A notebook cell.
+//
+// A cell's document URI must be unique across ALL notebook
+// cells and can therefore be used to uniquely identify a
+// notebook cell or the cell's text document.
+//
+// @since 3.17.0
+type NotebookCell struct {
+	// The cell's kind
+	Kind NotebookCellKind `json:"kind"`
+
+	// The URI of the cell's text document
+	// content.
+	Document DocumentURI `json:"document"`
+
+	// Additional metadata stored with the cell.
+	//
+	// Note: should always be an object literal (e.g. LSPObject)
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+
+	// Additional execution summary information
+	// if supported by the client.
+	ExecutionSummary *ExecutionSummary `json:"executionSummary,omitempty"`
+}
+
+type ExecutionSummary struct {
+	// A strict monotonically increasing value
+	// indicating the execution order of a cell
+	// inside a notebook.
+	ExecutionOrder uint32 `json:"executionOrder"`
+
+	// Whether the execution was successful or
+	// not if known by the client.
+	Success *bool `json:"success,omitempty"`
+}
+
+// A literal to identify a notebook document in the client.
+//
+// @since 3.17.0
+type NotebookDocumentIdentifier struct {
+	// The notebook document's uri.
+	Uri URI `json:"uri"`
+}
+
+// A versioned notebook document identifier.
+//
+// @since 3.17.0
+type VersionedNotebookDocumentIdentifier struct {
+	// The version number of this notebook document.
+	Version int32 `json:"version"`
+
+	// The notebook document's uri.
+	Uri URI `json:"uri"`
+}
+
+// The params sent in an open notebook document notification.
+//
+// @since 3.17.0
+type DidOpenNotebookDocumentParams struct {
+	// The notebook document that got opened.
+	NotebookDocument NotebookDocument `json:"notebookDocument"`
+
+	// The text documents that represent the content
+	// of a notebook cell.
+	CellTextDocuments []TextDocumentItem `json:"cellTextDocuments"`
+}
+
+// The params sent in a change notebook document notification.
+//
+// @since 3.17.0
+type DidChangeNotebookDocumentParams struct {
+	// The notebook document that did change. The version number points
+	// to the version after all provided changes have been applied. If
+	// only the text document content of a cell changes the notebook version
+	// doesn't necessarily have to change.
+	NotebookDocument VersionedNotebookDocumentIdentifier `json:"notebookDocument"`
+
+	// The actual changes to the notebook document.
+	Change NotebookDocumentChangeEvent `json:"change"`
+}
+
+// A change event for a notebook document.
+//
+// @since 3.17.0
+type NotebookDocumentChangeEvent struct {
+	// The changed meta data if any.
+	//
+	// Note: should always be an object literal (e.g. LSPObject)
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+
+	// Changes to cells
+	Cells *NotebookDocumentChangeEventCells `json:"cells,omitempty"`
+}
+
+// A change describing how to move a `NotebookCell`
+// array from state S to S'.
+//
+// @since 3.17.0
+type NotebookCellArrayChange struct {
+	// The start oftest of the cell that changed.
+	Start uint32 `json:"start"`
+
+	// The deleted cells
+	DeleteCount uint32 `json:"deleteCount"`
+
+	// The new cells, if any
+	Cells []NotebookCell `json:"cells,omitempty"`
+}
+
+// The params sent in a save notebook document notification.
+//
+// @since 3.17.0
+type DidSaveNotebookDocumentParams struct {
+	// The notebook document that got saved.
+	NotebookDocument NotebookDocumentIdentifier `json:"notebookDocument"`
+}
+
+// The params sent in a close notebook document notification.
+//
+// @since 3.17.0
+type DidCloseNotebookDocumentParams struct {
+	// The notebook document that got closed.
+	NotebookDocument NotebookDocumentIdentifier `json:"notebookDocument"`
+
+	// The text documents that represent the content
+	// of a notebook cell that got closed.
+	CellTextDocuments []TextDocumentIdentifier `json:"cellTextDocuments"`
+}
+
+// Options specific to a notebook plus its cells
+// to be synced to the server.
+//
+// If a selector provides a notebook document
+// filter but no cell selector all cells of a
+// matching notebook document will be synced.
+//
+// If a selector provides no notebook document
+// filter but only a cell selector all notebook
+// document that contain at least one matching
+// cell will be synced.
+//
+// @since 3.17.0
+type NotebookDocumentSyncOptions struct {
+	// The notebooks to be synced
+	NotebookSelector []NotebookDocumentSyncOptionsNotebookSelector `json:"notebookSelector"`
+
+	// Whether save notification should be forwarded to
+	// the server. Will only be honored if mode === `notebook`.
+	Save *bool `json:"save,omitempty"`
+}
+
+// Registration options specific to a notebook.
+//
+// @since 3.17.0
+type NotebookDocumentSyncRegistrationOptions struct {
+	NotebookDocumentSyncOptions
+	StaticRegistrationOptions
+}
+
+// A notebook cell text document filter denotes a cell text
+// document by different properties.
+//
+// @since 3.17.0
+type NotebookCellTextDocumentFilter struct {
+	// A filter that matches against the notebook
+	// containing the notebook cell. If a string
+	// value is provided it matches against the
+	// notebook type. '*' matches every notebook.
+	Notebook Or_String_NotebookDocumentFilter `json:"notebook"`
+
+	// A language id like `python`.
+	//
+	// Will be matched against the language id of the
+	// notebook cell document. '*' matches every language.
+	Language *string `json:"language,omitempty"`
+}
+
+type DeclarationParams struct {
+	TextDocumentPositionParams
+	WorkDoneProgressParams
+	PartialResultParams
+}
+
+type DeclarationOptions struct {
+	WorkDoneProgressOptions
+}
+
+type DeclarationRegistrationOptions struct {
+	DeclarationOptions
+	TextDocumentRegistrationOptions
+	StaticRegistrationOptions
+}
+
+// Parameters for a {@link DefinitionRequest}.
+type DefinitionParams struct {
+	TextDocumentPositionParams
+	WorkDoneProgressParams
+	PartialResultParams
+}
+
+// Server Capabilities for a {@link DefinitionRequest}.
+type DefinitionOptions struct {
+	WorkDoneProgressOptions
+}
+
+// Registration options for a {@link DefinitionRequest}.
+type DefinitionRegistrationOptions struct {
+	TextDocumentRegistrationOptions
+	DefinitionOptions
+}
+
+type TypeDefinitionParams struct {
+	TextDocumentPositionParams
+	WorkDoneProgressParams
+	PartialResultParams
+}
+
+type TypeDefinitionOptions struct {
+	WorkDoneProgressOptions
+}
+
+type TypeDefinitionRegistrationOptions struct {
+	TextDocumentRegistrationOptions
+	TypeDefinitionOptions
+	StaticRegistrationOptions
+}
+
+type ImplementationParams struct {
+	TextDocumentPositionParams
+	WorkDoneProgressParams
+	PartialResultParams
+}
+
+type ImplementationOptions struct {
+	WorkDoneProgressOptions
+}
+
+type ImplementationRegistrationOptions struct {
+	TextDocumentRegistrationOptions
+	ImplementationOptions
+	StaticRegistrationOptions
+}
+
+// Value-object that contains additional information when
+// requesting references.
+type ReferenceContext struct {
+	// Include the declaration of the current symbol.
+	IncludeDeclaration bool `json:"includeDeclaration"`
+}
+
+// Parameters for a {@link ReferencesRequest}.
+type ReferenceParams struct {
+	TextDocumentPositionParams
+	WorkDoneProgressParams
+	PartialResultParams
+	Context ReferenceContext `json:"context"`
+}
+
+// Reference options.
+type ReferenceOptions struct {
+	WorkDoneProgressOptions
+}
+
+// Registration options for a {@link ReferencesRequest}.
+type ReferenceRegistrationOptions struct {
+	TextDocumentRegistrationOptions
+	ReferenceOptions
+}
+
+// The parameter of a `textDocument/prepareCallHierarchy` request.
+//
+// @since 3.16.0
+type CallHierarchyPrepareParams struct {
+	TextDocumentPositionParams
+	WorkDoneProgressParams
+}
+
+// Represents programming constructs like functions or constructors in the context
+// of call hierarchy.
+//
+// @since 3.16.0
+type CallHierarchyItem struct {
+	// The name of this item.
+	Name string `json:"name"`
+
+	// The kind of this item.
+	Kind SymbolKind `json:"kind"`
+
+	// Tags for this item.
+	Tags []SymbolTag `json:"tags,omitempty"`
+
+	// More detail for this item, e.g. the signature of a function.
+	Detail *string `json:"detail,omitempty"`
+
+	// The resource identifier of this item.
+	Uri DocumentURI `json:"uri"`
+
+	// The range enclosing this symbol not including leading/trailing whitespace but everything else, e.g. comments and code.
+	Range Range `json:"range"`
+
+	// The range that should be selected and revealed when this symbol is being picked, e.g. the name of a function.
+	// Must be contained by the {@link CallHierarchyItem.range `range`}.
+	SelectionRange Range `json:"selectionRange"`
+
+	// A data entry field that is preserved between a call hierarchy prepare and
+	// incoming calls or outgoing calls requests.
+	Data interface{} `json:"data,omitempty"`
+}
+
+// Call hierarchy options used during static registration.
+//
+// @since 3.16.0
+type CallHierarchyOptions struct {
+	WorkDoneProgressOptions
+}
+
+// Call hierarchy options used during static or dynamic registration.
+//
+// @since 3.16.0
+type CallHierarchyRegistrationOptions struct {
+	TextDocumentRegistrationOptions
+	CallHierarchyOptions
+	StaticRegistrationOptions
+}
+
+// The parameter of a `callHierarchy/incomingCalls` request.
+//
+// @since 3.16.0
+type CallHierarchyIncomingCallsParams struct {
+	WorkDoneProgressParams
+	PartialResultParams
+	Item CallHierarchyItem `json:"item"`
+}
+
+// Represents an incoming call, e.g. a caller of a method or constructor.
+//
+// @since 3.16.0
+type CallHierarchyIncomingCall struct {
+	// The item that makes the call.
+	From CallHierarchyItem `json:"from"`
+
+	// The ranges at which the calls appear. This is relative to the caller
+	// denoted by {@link CallHierarchyIncomingCall.from `this.from`}.
+	FromRanges []Range `json:"fromRanges"`
+}
+
+// The parameter of a `callHierarchy/outgoingCalls` request.
+//
+// @since 3.16.0
+type CallHierarchyOutgoingCallsParams struct {
+	WorkDoneProgressParams
+	PartialResultParams
+	Item CallHierarchyItem `json:"item"`
+}
+
+// Represents an outgoing call, e.g. calling a getter from a method or a method from a constructor etc.
+//
+// @since 3.16.0
+type CallHierarchyOutgoingCall struct {
+	// The item that is called.
+	To CallHierarchyItem `json:"to"`
+
+	// The range at which this item is called. This is the range relative to the caller, e.g the item
+	// passed to {@link CallHierarchyItemProvider.provideCallHierarchyOutgoingCalls `provideCallHierarchyOutgoingCalls`}
+	// and not {@link CallHierarchyOutgoingCall.to `this.to`}.
+	FromRanges []Range `json:"fromRanges"`
+}
+
+// The parameter of a `textDocument/prepareTypeHierarchy` request.
+//
+// @since 3.17.0
+type TypeHierarchyPrepareParams struct {
+	TextDocumentPositionParams
+	WorkDoneProgressParams
+}
+
+// @since 3.17.0
+type TypeHierarchyItem struct {
+	// The name of this item.
+	Name string `json:"name"`
+
+	// The kind of this item.
+	Kind SymbolKind `json:"kind"`
+
+	// Tags for this item.
+	Tags []SymbolTag `json:"tags,omitempty"`
+
+	// More detail for this item, e.g. the signature of a function.
+	Detail *string `json:"detail,omitempty"`
+
+	// The resource identifier of this item.
+	Uri DocumentURI `json:"uri"`
+
+	// The range enclosing this symbol not including leading/trailing whitespace
+	// but everything else, e.g. comments and code.
+	Range Range `json:"range"`
+
+	// The range that should be selected and revealed when this symbol is being
+	// picked, e.g. the name of a function. Must be contained by the
+	// {@link TypeHierarchyItem.range `range`}.
+	SelectionRange Range `json:"selectionRange"`
+
+	// A data entry field that is preserved between a type hierarchy prepare and
+	// supertypes or subtypes requests. It could also be used to identify the
+	// type hierarchy in the server, helping improve the performance on
+	// resolving supertypes and subtypes.
+	Data interface{} `json:"data,omitempty"`
+}
+
+// Type hierarchy options used during static registration.
+//
+// @since 3.17.0
+type TypeHierarchyOptions struct {
+	WorkDoneProgressOptions
+}
+
+// Type hierarchy options used during static or dynamic registration.
+//
+// @since 3.17.0
+type TypeHierarchyRegistrationOptions struct {
+	TextDocumentRegistrationOptions
+	TypeHierarchyOptions
+	StaticRegistrationOptions
+}
+
+// The parameter of a `typeHierarchy/supertypes` request.
+//
+// @since 3.17.0
+type TypeHierarchySupertypesParams struct {
+	WorkDoneProgressParams
+	PartialResultParams
+	Item TypeHierarchyItem `json:"item"`
+}
+
+// The parameter of a `typeHierarchy/subtypes` request.
+//
+// @since 3.17.0
+type TypeHierarchySubtypesParams struct {
+	WorkDoneProgressParams
+	PartialResultParams
+	Item TypeHierarchyItem `json:"item"`
+}
+
+// Parameters for a {@link DocumentHighlightRequest}.
+type DocumentHighlightParams struct {
+	TextDocumentPositionParams
+	WorkDoneProgressParams
+	PartialResultParams
+}
+
+// A document highlight is a range inside a text document which deserves
+// special attention. Usually a document highlight is visualized by changing
+// the background color of its range.
+type DocumentHighlight struct {
+	// The range this highlight applies to.
+	Range Range `json:"range"`
+
+	// The highlight kind, default is {@link DocumentHighlightKind.Text text}.
+	Kind *DocumentHighlightKind `json:"kind,omitempty"`
+}
+
+// Provider options for a {@link DocumentHighlightRequest}.
+type DocumentHighlightOptions struct {
+	WorkDoneProgressOptions
+}
+
+// Registration options for a {@link DocumentHighlightRequest}.
+type DocumentHighlightRegistrationOptions struct {
+	TextDocumentRegistrationOptions
+	DocumentHighlightOptions
+}
+
+// The parameters of a {@link DocumentLinkRequest}.
+type DocumentLinkParams struct {
+	WorkDoneProgressParams
+	PartialResultParams
+	// The document to provide document links for.
+	TextDocument TextDocumentIdentifier `json:"textDocument"`
+}
+
+// A document link is a range in a text document that links to an internal or external resource, like another
+// text document or a web site.
+type DocumentLink struct {
+	// The range this link applies to.
+	Range Range `json:"range"`
+
+	// The uri this link points to. If missing a resolve request is sent later.
+	Target *URI `json:"target,omitempty"`
+
+	// The tooltip text when you hover over this link.
+	//
+	// If a tooltip is provided, is will be displayed in a string that includes instructions on how to
+	// trigger the link, such as `{0} (ctrl + click)`. The specific instructions vary depending on OS,
+	// user settings, and localization.
+	//
+	// @since 3.15.0
+	Tooltip *string `json:"tooltip,omitempty"`
+
+	// A data entry field that is preserved on a document link between a
+	// DocumentLinkRequest and a DocumentLinkResolveRequest.
+	Data interface{} `json:"data,omitempty"`
+}
+
+// Provider options for a {@link DocumentLinkRequest}.
+type DocumentLinkOptions struct {
+	WorkDoneProgressOptions
+	// Document links have a resolve provider as well.
+	ResolveProvider *bool `json:"resolveProvider,omitempty"`
+}
+
+// Registration options for a {@link DocumentLinkRequest}.
+type DocumentLinkRegistrationOptions struct {
+	TextDocumentRegistrationOptions
+	DocumentLinkOptions
+}
+
+// Parameters for a {@link HoverRequest}.
+type HoverParams struct {
+	TextDocumentPositionParams
+	WorkDoneProgressParams
+}
+
+// The result of a hover request.
+type Hover struct {
+	// The hover's content
+	Contents Or_MarkupContent_MarkedString_MarkedStringSlice `json:"contents"`
+
+	// An optional range inside the text document that is used to
+	// visualize the hover, e.g. by changing the background color.
+	Range *Range `json:"range,omitempty"`
+}
+
+// Hover options.
+type HoverOptions struct {
+	WorkDoneProgressOptions
+}
+
+// Registration options for a {@link HoverRequest}.
+type HoverRegistrationOptions struct {
+	TextDocumentRegistrationOptions
+	HoverOptions
+}
+
+// The parameters of a {@link CodeLensRequest}.
+type CodeLensParams struct {
+	WorkDoneProgressParams
+	PartialResultParams
+	// The document to request code lens for.
+	TextDocument TextDocumentIdentifier `json:"textDocument"`
+}
+
+// A code lens represents a {@link Command command} that should be shown along with
+// source text, like the number of references, a way to run tests, etc.
+//
+// A code lens is _unresolved_ when no command is associated to it. For performance
+// reasons the creation of a code lens and resolving should be done in two stages.
+type CodeLens struct {
+	// The range in which this code lens is valid. Should only span a single line.
+	Range Range `json:"range"`
+
+	// The command this code lens represents.
+	Command *Command `json:"command,omitempty"`
+
+	// A data entry field that is preserved on a code lens item between
+	// a {@link CodeLensRequest} and a [CodeLensResolveRequest]
+	// (#CodeLensResolveRequest)
+	Data interface{} `json:"data,omitempty"`
+}
+
+// Code Lens provider options of a {@link CodeLensRequest}.
+type CodeLensOptions struct {
+	WorkDoneProgressOptions
+	// Code lens has a resolve provider as well.
+	ResolveProvider *bool `json:"resolveProvider,omitempty"`
+}
+
+// Registration options for a {@link CodeLensRequest}.
+type CodeLensRegistrationOptions struct {
+	TextDocumentRegistrationOptions
+	CodeLensOptions
+}
+
+// Parameters for a {@link FoldingRangeRequest}.
+type FoldingRangeParams struct {
+	WorkDoneProgressParams
+	PartialResultParams
+	// The text document.
+	TextDocument TextDocumentIdentifier `json:"textDocument"`
+}
+
+// Represents a folding range. To be valid, start and end line must be bigger than zero and smaller
+// than the number of lines in the document. Clients are free to ignore invalid ranges.
+type FoldingRange struct {
+	// The start line number of the folded range.
+	StartLine uint32 `json:"startLine"`
+
+	// The UTF-16 based character offset from where the folded range starts. If not defined, defaults to the length of the start line.
+	StartCharacter *uint32 `json:"startCharacter,omitempty"`
+
+	// The end line number of the folded range.
+	EndLine uint32 `json:"endLine"`
+
+	// The UTF-16 based character offset before the folded range ends. If not defined, defaults to the length of the end line.
+	EndCharacter *uint32 `json:"endCharacter,omitempty"`
+
+	// Describes the kind of the folding range such as `comment' or 'region'. The kind
+	// is used to categorize folding ranges and used by commands like 'Fold all comments'.
+	Kind *FoldingRangeKind `json:"kind,omitempty"`
+
+	// The text that the client should show when the specified range is
+	// collapsed. If not defined or not supported by the client, a default
+	// will be chosen by the client.
+	//
+	// @since 3.17.0
+	CollapsedText *string `json:"collapsedText,omitempty"`
+}
+
+type FoldingRangeOptions struct {
+	WorkDoneProgressOptions
+}
+
+type FoldingRangeRegistrationOptions struct {
+	TextDocumentRegistrationOptions
+	FoldingRangeOptions
+	StaticRegistrationOptions
+}
+
+// A parameter literal used in selection range requests.
+type SelectionRangeParams struct {
+	WorkDoneProgressParams
+	PartialResultParams
+	// The text document.
+	TextDocument TextDocumentIdentifier `json:"textDocument"`
+
+	// The positions inside the text document.
+	Positions []Position `json:"positions"`
+}
+
+// A selection range represents a part of a selection hierarchy. A selection range
+// may have a parent selection range that contains it.
+type SelectionRange struct {
+	// The {@link Range range} of this selection range.
+	Range Range `json:"range"`
+
+	// The parent selection range containing this range. Therefore `parent.range` must contain `this.range`.
+	Parent *SelectionRange `json:"parent,omitempty"`
+}
+
+type SelectionRangeOptions struct {
+	WorkDoneProgressOptions
+}
+
+type SelectionRangeRegistrationOptions struct {
+	SelectionRangeOptions
+	TextDocumentRegistrationOptions
+	StaticRegistrationOptions
+}
+
+// Parameters for a {@link DocumentSymbolRequest}.
+type DocumentSymbolParams struct {
+	WorkDoneProgressParams
+	PartialResultParams
+	// The text document.
+	TextDocument TextDocumentIdentifier `json:"textDocument"`
+}
+
+// Represents programming constructs like variables, classes, interfaces etc.
+// that appear in a document. Document symbols can be hierarchical and they
+// have two ranges: one that encloses its definition and one that points to
+// its most interesting range, e.g. the range of an identifier.
+type DocumentSymbol struct {
+	// The name of this symbol. Will be displayed in the user interface and therefore must not be
+	// an empty string or a string only consisting of white spaces.
+	Name string `json:"name"`
+
+	// More detail for this symbol, e.g the signature of a function.
+	Detail *string `json:"detail,omitempty"`
+
+	// The kind of this symbol.
+	Kind SymbolKind `json:"kind"`
+
+	// Tags for this document symbol.
+	//
+	// @since 3.16.0
+	Tags []SymbolTag `json:"tags,omitempty"`
+
+	// Indicates if this symbol is deprecated.
+	//
+	// @deprecated Use tags instead
+	Deprecated *bool `json:"deprecated,omitempty"`
+
+	// The range enclosing this symbol not including leading/trailing whitespace but everything else
+	// like comments. This information is typically used to determine if the clients cursor is
+	// inside the symbol to reveal in the symbol in the UI.
+	Range Range `json:"range"`
+
+	// The range that should be selected and revealed when this symbol is being picked, e.g the name of a function.
+	// Must be contained by the `range`.
+	SelectionRange Range `json:"selectionRange"`
+
+	// Children of this symbol, e.g. properties of a class.
+	Children []DocumentSymbol `json:"children,omitempty"`
+}
+
+// Provider options for a {@link DocumentSymbolRequest}.
+type DocumentSymbolOptions struct {
+	WorkDoneProgressOptions
+	// A human-readable string that is shown when multiple outlines trees
+	// are shown for the same document.
+	//
+	// @since 3.16.0
+	Label *string `json:"label,omitempty"`
+}
+
+// Registration options for a {@link DocumentSymbolRequest}.
+type DocumentSymbolRegistrationOptions struct {
+	TextDocumentRegistrationOptions
+	DocumentSymbolOptions
+}
+
+// @since 3.16.0
+type SemanticTokensParams struct {
+	WorkDoneProgressParams
+	PartialResultParams
+	// The text document.
+	TextDocument TextDocumentIdentifier `json:"textDocument"`
+}
+
+// @since 3.16.0
+type SemanticTokens struct {
+	// An optional result id. If provided and clients support delta updating
+	// the client will include the result id in the next semantic token request.
+	// A server can then instead of computing all semantic tokens again simply
+	// send a delta.
+	ResultId *string `json:"resultId,omitempty"`
+
+	// The actual tokens.
+	Data []uint32 `json:"data"`
+}
+
+// @since 3.16.0
+type SemanticTokensPartialResult struct {
+	Data []uint32 `json:"data"`
+}
+
+// @since 3.16.0
+type SemanticTokensLegend struct {
+	// The token types a server uses.
+	TokenTypes []string `json:"tokenTypes"`
+
+	// The token modifiers a server uses.
+	TokenModifiers []string `json:"tokenModifiers"`
+}
+
+// @since 3.16.0
+type SemanticTokensOptions struct {
+	WorkDoneProgressOptions
+	// The legend used by the server
+	Legend SemanticTokensLegend `json:"legend"`
+
+	// Server supports providing semantic tokens for a specific range
+	// of a document.
+	Range *SemanticTokensOptionsRange `json:"range,omitempty"`
+
+	// Server supports providing semantic tokens for a full document.
+	Full *SemanticTokensOptionsFull `json:"full,omitempty"`
+}
+
+// @since 3.16.0
+type SemanticTokensRegistrationOptions struct {
+	TextDocumentRegistrationOptions
+	SemanticTokensOptions
+	StaticRegistrationOptions
+}
+
+// @since 3.16.0
+type SemanticTokensDeltaParams struct {
+	WorkDoneProgressParams
+	PartialResultParams
+	// The text document.
+	TextDocument TextDocumentIdentifier `json:"textDocument"`
+
+	// The result id of a previous response. The result Id can either point to a full response
+	// or a delta response depending on what was received last.
+	PreviousResultId string `json:"previousResultId"`
+}
+
+// @since 3.16.0
+type SemanticTokensEdit struct {
+	// The start offset of the edit.
+	Start uint32 `json:"start"`
+
+	// The count of elements to remove.
+	DeleteCount uint32 `json:"deleteCount"`
+
+	// The elements to insert.
+	Data []uint32 `json:"data,omitempty"`
+}
+
+// @since 3.16.0
+type SemanticTokensDelta struct {
+	ResultId *string `json:"resultId,omitempty"`
+
+	// The semantic token edits to transform a previous result into a new result.
+	Edits []SemanticTokensEdit `json:"edits"`
+}
+
+// @since 3.16.0
+type SemanticTokensDeltaPartialResult struct {
+	Edits []SemanticTokensEdit `json:"edits"`
+}
+
+// @since 3.16.0
+type SemanticTokensRangeParams struct {
+	WorkDoneProgressParams
+	PartialResultParams
+	// The text document.
+	TextDocument TextDocumentIdentifier `json:"textDocument"`
+
+	// The range the semantic tokens are requested for.
+	Range Range `json:"range"`
+}
+
+// A parameter literal used in inlay hint requests.
+//
+// @since 3.17.0
+type InlayHintParams struct {
+	WorkDoneProgressParams
+	// The text document.
+	TextDocument TextDocumentIdentifier `json:"textDocument"`
+
+	// The document range for which inlay hints should be computed.
+	Range Range `json:"range"`
+}
+
+// An inlay hint label part allows for interactive and composite labels
+// of inlay hints.
+//
+// @since 3.17.0
+type InlayHintLabelPart struct {
+	// The value of this label part.
+	Value string `json:"value"`
+
+	// The tooltip text when you hover over this label part. Depending on
+	// the client capability `inlayHint.resolveSupport` clients might resolve
+	// this property late using the resolve request.
+	Tooltip *Or_String_MarkupContent `json:"tooltip,omitempty"`
+
+	// An optional source code location that represents this
+	// label part.
+	Location *Location `json:"location,omitempty"`
+
+	// An optional command for this label part.
+	Command *Command `json:"command,omitempty"`
+}
+
+// Inlay hint information.
+//
+// @since 3.17.0
+type InlayHint struct {
+	// The position of this hint.
+	Position Position `json:"position"`
+
+	// The label of this hint. A human readable string or an array of
+	// InlayHintLabelPart label parts.
+	//
+	// *Note* that neither the string nor the label part can be empty.
+	Label Or_String_InlayHintLabelPartSlice `json:"label"`
+
+	// The kind of this hint. Can be omitted in which case the client
+	// should fall back to a reasonable default.
+	Kind *InlayHintKind `json:"kind,omitempty"`
+
+	// Optional text edits that are performed when accepting this inlay hint.
+	TextEdits []TextEdit `json:"textEdits,omitempty"`
+
+	// The tooltip text when you hover over this item.
+	Tooltip *Or_String_MarkupContent `json:"tooltip,omitempty"`
+
+	// Render padding before the hint.
+	PaddingLeft *bool `json:"paddingLeft,omitempty"`
+
+	// Render padding after the hint.
+	PaddingRight *bool `json:"paddingRight,omitempty"`
+
+	// A data entry field that is preserved on an inlay hint between
+	// a `textDocument/inlayHint` and a `inlayHint/resolve` request.
+	Data interface{} `json:"data,omitempty"`
+}
+
+// Inlay hint options used during static registration.
+//
+// @since 3.17.0
+type InlayHintOptions struct {
+	WorkDoneProgressOptions
+	// The server provides support to resolve additional
+	// information for an inlay hint item.
+	ResolveProvider *bool `json:"resolveProvider,omitempty"`
+}
+
+// Inlay hint options used during static or dynamic registration.
+//
+// @since 3.17.0
+type InlayHintRegistrationOptions struct {
+	InlayHintOptions
+	TextDocumentRegistrationOptions
+	StaticRegistrationOptions
+}
+
+// A parameter literal used in inline value requests.
+//
+// @since 3.17.0
+type InlineValueParams struct {
+	WorkDoneProgressParams
+	// The text document.
+	TextDocument TextDocumentIdentifier `json:"textDocument"`
+
+	// The document range for which inline values should be computed.
+	Range Range `json:"range"`
+
+	// Additional information about the context in which inline values were
+	// requested.
+	Context InlineValueContext `json:"context"`
+}
+
+// @since 3.17.0
+type InlineValueContext struct {
+	// The stack frame (as a DAP Id) where the execution has stopped.
+	FrameId int32 `json:"frameId"`
+
+	// The document range where execution has stopped.
+	// Typically the end position of the range denotes the line where the inline values are shown.
+	StoppedLocation Range `json:"stoppedLocation"`
+}
+
+// Provide inline value as text.
+//
+// @since 3.17.0
+type InlineValueText struct {
+	// The document range for which the inline value applies.
+	Range Range `json:"range"`
+
+	// The text of the inline value.
+	Text string `json:"text"`
+}
+
+// Provide inline value through a variable lookup.
+// If only a range is specified, the variable name will be extracted from the underlying document.
+// An optional variable name can be used to override the extracted name.
+//
+// @since 3.17.0
+type InlineValueVariableLookup struct {
+	// The document range for which the inline value applies.
+	// The range is used to extract the variable name from the underlying document.
+	Range Range `json:"range"`
+
+	// If specified the name of the variable to look up.
+	VariableName *string `json:"variableName,omitempty"`
+
+	// How to perform the lookup.
+	CaseSensitiveLookup bool `json:"caseSensitiveLookup"`
+}
+
+// Provide an inline value through an expression evaluation.
+// If only a range is specified, the expression will be extracted from the underlying document.
+// An optional expression can be used to override the extracted expression.
+//
+// @since 3.17.0
+type InlineValueEvaluatableExpression struct {
+	// The document range for which the inline value applies.
+	// The range is used to extract the evaluatable expression from the underlying document.
+	Range Range `json:"range"`
+
+	// If specified the expression overrides the extracted expression.
+	Expression *string `json:"expression,omitempty"`
+}
+
+// Inline value options used during static registration.
+//
+// @since 3.17.0
+type InlineValueOptions struct {
+	WorkDoneProgressOptions
+}
+
+// Inline value options used during static or dynamic registration.
+//
+// @since 3.17.0
+type InlineValueRegistrationOptions struct {
+	InlineValueOptions
+	TextDocumentRegistrationOptions
+	StaticRegistrationOptions
+}
+
+type MonikerParams struct {
+	TextDocumentPositionParams
+	WorkDoneProgressParams
+	PartialResultParams
+}
+
+// Moniker definition to match LSIF 0.5 moniker definition.
+//
+// @since 3.16.0
+type Moniker struct {
+	// The scheme of the moniker. For example tsc or .Net
+	Scheme string `json:"scheme"`
+
+	// The identifier of the moniker. The value is opaque in LSIF however
+	// schema owners are allowed to define the structure if they want.
+	Identifier string `json:"identifier"`
+
+	// The scope in which the moniker is unique
+	Unique UniquenessLevel `json:"unique"`
+
+	// The moniker kind if known.
+	Kind *MonikerKind `json:"kind,omitempty"`
+}
+
+type MonikerOptions struct {
+	WorkDoneProgressOptions
+}
+
+type MonikerRegistrationOptions struct {
+	TextDocumentRegistrationOptions
+	MonikerOptions
+}
+
+// Parameters for a {@link DocumentColorRequest}.
+type DocumentColorParams struct {
+	WorkDoneProgressParams
+	PartialResultParams
+	// The text document.
+	TextDocument TextDocumentIdentifier `json:"textDocument"`
+}
+
+// Represents a color in RGBA space.
+type Color struct {
+	// The red component of this color in the range [0-1].
+	Red float64 `json:"red"`
+
+	// The green component of this color in the range [0-1].
+	Green float64 `json:"green"`
+
+	// The blue component of this color in the range [0-1].
+	Blue float64 `json:"blue"`
+
+	// The alpha component of this color in the range [0-1].
+	Alpha float64 `json:"alpha"`
+}
+
+// Represents a color range from a document.
+type ColorInformation struct {
+	// The range in the document where this color appears.
+	Range Range `json:"range"`
+
+	// The actual color value for this color range.
+	Color Color `json:"color"`
+}
+
+type DocumentColorOptions struct {
+	WorkDoneProgressOptions
+}
+
+type DocumentColorRegistrationOptions struct {
+	TextDocumentRegistrationOptions
+	DocumentColorOptions
+	StaticRegistrationOptions
+}
+
+// Parameters for a {@link ColorPresentationRequest}.
+type ColorPresentationParams struct {
+	WorkDoneProgressParams
+	PartialResultParams
+	// The text document.
+	TextDocument TextDocumentIdentifier `json:"textDocument"`
+
+	// The color to request presentations for.
+	Color Color `json:"color"`
+
+	// The range where the color would be inserted. Serves as a context.
+	Range Range `json:"range"`
+}
+
+type ColorPresentation struct {
+	// The label of this color presentation. It will be shown on the color
+	// picker header. By default this is also the text that is inserted when selecting
+	// this color presentation.
+	Label string `json:"label"`
+
+	// An {@link TextEdit edit} which is applied to a document when selecting
+	// this presentation for the color.  When `falsy` the {@link ColorPresentation.label label}
+	// is used.
+	TextEdit *TextEdit `json:"textEdit,omitempty"`
+
+	// An optional array of additional {@link TextEdit text edits} that are applied when
+	// selecting this color presentation. Edits must not overlap with the main {@link ColorPresentation.textEdit edit} nor with themselves.
+	AdditionalTextEdits []TextEdit `json:"additionalTextEdits,omitempty"`
+}
+
+type LinkedEditingRangeParams struct {
+	TextDocumentPositionParams
+	WorkDoneProgressParams
+}
+
+// The result of a linked editing range request.
+//
+// @since 3.16.0
+type LinkedEditingRanges struct {
+	// A list of ranges that can be edited together. The ranges must have
+	// identical length and contain identical text content. The ranges cannot overlap.
+	Ranges []Range `json:"ranges"`
+
+	// An optional word pattern (regular expression) that describes valid contents for
+	// the given ranges. If no pattern is provided, the client configuration's word
+	// pattern will be used.
+	WordPattern *string `json:"wordPattern,omitempty"`
+}
+
+type LinkedEditingRangeOptions struct {
+	WorkDoneProgressOptions
+}
+
+type LinkedEditingRangeRegistrationOptions struct {
+	TextDocumentRegistrationOptions
+	LinkedEditingRangeOptions
+	StaticRegistrationOptions
+}
+
+// Completion parameters
+type CompletionParams struct {
+	TextDocumentPositionParams
+	WorkDoneProgressParams
+	PartialResultParams
+	// The completion context. This is only available it the client specifies
+	// to send this using the client capability `textDocument.completion.contextSupport === true`
+	Context *CompletionContext `json:"context,omitempty"`
+}
+
+// Contains additional information about the context in which a completion request is triggered.
+type CompletionContext struct {
+	// How the completion was triggered.
+	TriggerKind CompletionTriggerKind `json:"triggerKind"`
+
+	// The trigger character (a single character) that has trigger code complete.
+	// Is undefined if `triggerKind !== CompletionTriggerKind.TriggerCharacter`
+	TriggerCharacter *string `json:"triggerCharacter,omitempty"`
+}
+
+// Additional details for a completion item label.
+//
+// @since 3.17.0
+type CompletionItemLabelDetails struct {
+	// An optional string which is rendered less prominently directly after {@link CompletionItem.label label},
+	// without any spacing. Should be used for function signatures and type annotations.
+	Detail *string `json:"detail,omitempty"`
+
+	// An optional string which is rendered less prominently after {@link CompletionItem.detail}. Should be used
+	// for fully qualified names and file paths.
+	Description *string `json:"description,omitempty"`
+}
+
+// A special text edit to provide an insert and a replace operation.
+//
+// @since 3.16.0
+type InsertReplaceEdit struct {
+	// The string to be inserted.
+	NewText string `json:"newText"`
+
+	// The range if the insert is requested
+	Insert Range `json:"insert"`
+
+	// The range if the replace is requested.
+	Replace Range `json:"replace"`
+}
+
+// A completion item represents a text snippet that is
+// proposed to complete text that is being typed.
+type CompletionItem struct {
+	// The label of this completion item.
+	//
+	// The label property is also by default the text that
+	// is inserted when selecting this completion.
+	Label string `json:"label"`
+
+	// Additional details for the label
+	//
+	// @since 3.17.0
+	LabelDetails *CompletionItemLabelDetails `json:"labelDetails,omitempty"`
+
+	// The kind of this completion item. Based of the kind
+	// an icon is chosen by the editor.
+	Kind *CompletionItemKind `json:"kind,omitempty"`
+
+	// Tags for this completion item.
+	//
+	// @since 3.15.0
+	Tags []CompletionItemTag `json:"tags,omitempty"`
+
+	// A human-readable string with additional information
+	// about this item, like type or symbol information.
+	Detail *string `json:"detail,omitempty"`
+
+	// A human-readable string that represents a doc-comment.
+	Documentation *Or_String_MarkupContent `json:"documentation,omitempty"`
+
+	// Indicates if this item is deprecated.
+	// @deprecated Use `tags` instead.
+	Deprecated *bool `json:"deprecated,omitempty"`
+
+	// Select this item when showing.
+	//
+	// *Note* that only one completion item can be selected and that the
+	// tool / client decides which item that is. The rule is that the *first*
+	// item of those that match best is selected.
+	Preselect *bool `json:"preselect,omitempty"`
+
+	// A string that should be used when comparing this item
+	// with other items. When `falsy` the {@link CompletionItem.label label}
+	// is used.
+	SortText *string `json:"sortText,omitempty"`
+
+	// A string that should be used when filtering a set of
+	// completion items. When `falsy` the {@link CompletionItem.label label}
+	// is used.
+	FilterText *string `json:"filterText,omitempty"`
+
+	// A string that should be inserted into a document when selecting
+	// this completion. When `falsy` the {@link CompletionItem.label label}
+	// is used.
+	InsertText *string `json:"insertText,omitempty"`
+
+	// The format of the insert text. The format applies to both the
+	// `insertText` property and the `newText` property of a provided
+	// `textEdit`. If omitted defaults to `InsertTextFormat.PlainText`.
+	InsertTextFormat *InsertTextFormat `json:"insertTextFormat,omitempty"`
+
+	// How whitespace and indentation is handled during completion
+	// item insertion. If not provided the clients default value depends on
+	// the `textDocument.completion.insertTextMode` client capability.
+	//
+	// @since 3.16.0
+	InsertTextMode *InsertTextMode `json:"insertTextMode,omitempty"`
+
+	// An {@link TextEdit edit} which is applied to a document when selecting
+	// this completion. When an edit is provided the value of
+	// {@link CompletionItem.insertText insertText} is ignored.
+	//
+	// @since 3.16.0 additional type `InsertReplaceEdit`
+	TextEdit *Or_TextEdit_InsertReplaceEdit `json:"textEdit,omitempty"`
+
+	// The edit text used if the completion item is part of a CompletionList and
+	// CompletionList defines an item default for the text edit range.
+	//
+	// @since 3.17.0
+	TextEditText *string `json:"textEditText,omitempty"`
+
+	// An optional array of additional {@link TextEdit text edits} that are applied when
+	// selecting this completion. Edits must not overlap (including the same insert position)
+	// with the main {@link CompletionItem.textEdit edit} nor with themselves.
+	AdditionalTextEdits []TextEdit `json:"additionalTextEdits,omitempty"`
+
+	// An optional set of characters that when pressed while this completion is active will accept it first and
+	// then type that character. *Note* that all commit characters should have `length=1` and that superfluous
+	// characters will be ignored.
+	CommitCharacters []string `json:"commitCharacters,omitempty"`
+
+	// An optional {@link Command command} that is executed *after* inserting this completion. *Note* that
+	// additional modifications to the current document should be described with the
+	// {@link CompletionItem.additionalTextEdits additionalTextEdits}-property.
+	Command *Command `json:"command,omitempty"`
+
+	// A data entry field that is preserved on a completion item between a
+	// {@link CompletionRequest} and a {@link CompletionResolveRequest}.
+	Data interface{} `json:"data,omitempty"`
+}
+
+// Represents a collection of {@link CompletionItem completion items} to be presented
+// in the editor.
+type CompletionList struct {
+	// This list it not complete. Further typing results in recomputing this list.
+	//
+	// Recomputed lists have all their items replaced (not appended) in the
+	// incomplete completion sessions.
+	IsIncomplete bool `json:"isIncomplete"`
+
+	// In many cases the items of an actual completion result share the same
+	// value for properties like `commitCharacters` or the range of a text
+	// edit. A completion list can therefore define item defaults which will
+	// be used if a completion item itself doesn't specify the value.
+	//
+	// @since 3.17.0
+	ItemDefaults *CompletionListItemDefaults `json:"itemDefaults,omitempty"`
+
+	// The completion items.
+	Items []CompletionItem `json:"items"`
+}
+
+// Completion options.
+type CompletionOptions struct {
+	WorkDoneProgressOptions
+	// Most tools trigger completion request automatically without explicitly requesting
+	// it using a keyboard shortcut (e.g. Ctrl+Space). Typically they do so when the user
+	// starts to type an identifier.
+	TriggerCharacters []string `json:"triggerCharacters,omitempty"`
+
+	// The list of all possible characters that commit a completion.
+	//
+	// @since 3.2.0
+	AllCommitCharacters []string `json:"allCommitCharacters,omitempty"`
+
+	// The server provides support to resolve additional
+	// information for a completion item.
+	ResolveProvider *bool `json:"resolveProvider,omitempty"`
+
+	// The server supports the following `CompletionItem` specific
+	// capabilities.
+	//
+	// @since 3.17.0
+	CompletionItem *CompletionOptionsCompletionItem `json:"completionItem,omitempty"`
+}
+
+// Registration options for a {@link CompletionRequest}.
+type CompletionRegistrationOptions struct {
+	TextDocumentRegistrationOptions
+	CompletionOptions
+}
+
+// Parameters for a {@link SignatureHelpRequest}.
+type SignatureHelpParams struct {
+	TextDocumentPositionParams
+	WorkDoneProgressParams
+	// The signature help context. This is only available if the client specifies
+	// to send this using the client capability `textDocument.signatureHelp.contextSupport === true`
+	//
+	// @since 3.15.0
+	Context *SignatureHelpContext `json:"context,omitempty"`
+}
+
+// Additional information about the context in which a signature help request was triggered.
+//
+// @since 3.15.0
+type SignatureHelpContext struct {
+	// Action that caused signature help to be triggered.
+	TriggerKind SignatureHelpTriggerKind `json:"triggerKind"`
+
+	// Character that caused signature help to be triggered.
+	//
+	// This is undefined when `triggerKind !== SignatureHelpTriggerKind.TriggerCharacter`
+	TriggerCharacter *string `json:"triggerCharacter,omitempty"`
+
+	// `true` if signature help was already showing when it was triggered.
+	//
+	// Retriggers occurs when the signature help is already active and can be caused by actions such as
+	// typing a trigger character, a cursor move, or document content changes.
+	IsRetrigger bool `json:"isRetrigger"`
+
+	// The currently active `SignatureHelp`.
+	//
+	// The `activeSignatureHelp` has its `SignatureHelp.activeSignature` field updated based on
+	// the user navigating through available signatures.
+	ActiveSignatureHelp *SignatureHelp `json:"activeSignatureHelp,omitempty"`
+}
+
+// Signature help represents the signature of something
+// callable. There can be multiple signature but only one
+// active and only one active parameter.
+type SignatureHelp struct {
+	// One or more signatures.
+	Signatures []SignatureInformation `json:"signatures"`
+
+	// The active signature. If omitted or the value lies outside the
+	// range of `signatures` the value defaults to zero or is ignored if
+	// the `SignatureHelp` has no signatures.
+	ActiveSignature *uint32 `json:"activeSignature,omitempty"`
+
+	// The active parameter of the active signature. If omitted or the value
+	// lies outside the range of `signatures[activeSignature].parameters`
+	// defaults to 0 if the active signature has parameters. If
+	// the active signature has no parameters it is ignored.
+	ActiveParameter *uint32 `json:"activeParameter,omitempty"`
+}
+
+// Represents the signature of something callable. A signature
+// can have a label, like a function-name, a doc-comment, and
+// a set of parameters.
+type SignatureInformation struct {
+	// The label of this signature. Will be shown in
+	// the UI.
+	Label string `json:"label"`
+
+	// The human-readable doc-comment of this signature. Will be shown
+	// in the UI but can be omitted.
+	Documentation *Or_String_MarkupContent `json:"documentation,omitempty"`
+
+	// The parameters of this signature.
+	Parameters []ParameterInformation `json:"parameters,omitempty"`
+
+	// The index of the active parameter.
+	//
+	// If provided, this is used in place of `SignatureHelp.activeParameter`.
+	//
+	// @since 3.16.0
+	ActiveParameter *uint32 `json:"activeParameter,omitempty"`
+}
+
+// Represents a parameter of a callable-signature. A parameter can
+// have a label and a doc-comment.
+type ParameterInformation struct {
+	// The label of this parameter information.
+	//
+	// Either a string or an inclusive start and exclusive end offsets within its containing
+	// signature label. (see SignatureInformation.label).
+	Label Or_String_Uint32Array `json:"label"`
+
+	// The human-readable doc-comment of this parameter. Will be shown
+	// in the UI but can be omitted.
+	Documentation *Or_String_MarkupContent `json:"documentation,omitempty"`
+}
+
+// Server Capabilities for a {@link SignatureHelpRequest}.
+type SignatureHelpOptions struct {
+	WorkDoneProgressOptions
+	// List of characters that trigger signature help automatically.
+	TriggerCharacters []string `json:"triggerCharacters,omitempty"`
+
+	// List of characters that re-trigger signature help.
+	//
+	// These trigger characters are only active when signature help is already showing. All trigger characters
+	// are also counted as re-trigger characters.
+	//
+	// @since 3.15.0
+	RetriggerCharacters []string `json:"retriggerCharacters,omitempty"`
+}
+
+// Registration options for a {@link SignatureHelpRequest}.
+type SignatureHelpRegistrationOptions struct {
+	TextDocumentRegistrationOptions
+	SignatureHelpOptions
+}
+
+// The parameters of a {@link CodeActionRequest}.
+type CodeActionParams struct {
+	WorkDoneProgressParams
+	PartialResultParams
+	// The document in which the command was invoked.
+	TextDocument TextDocumentIdentifier `json:"textDocument"`
+
+	// The range for which the command was invoked.
+	Range Range `json:"range"`
+
+	// Context carrying additional information.
+	Context CodeActionContext `json:"context"`
+}
+
+// Contains additional diagnostic information about the context in which
+// a {@link CodeActionProvider.provideCodeActions code action} is run.
+type CodeActionContext struct {
+	// An array of diagnostics known on the client side overlapping the range provided to the
+	// `textDocument/codeAction` request. They are provided so that the server knows which
+	// errors are currently presented to the user for the given range. There is no guarantee
+	// that these accurately reflect the error state of the resource. The primary parameter
+	// to compute code actions is the provided range.
+	Diagnostics []Diagnostic `json:"diagnostics"`
+
+	// Requested kind of actions to return.
+	//
+	// Actions not of this kind are filtered out by the client before being shown. So servers
+	// can omit computing them.
+	Only []CodeActionKind `json:"only,omitempty"`
+
+	// The reason why code actions were requested.
+	//
+	// @since 3.17.0
+	TriggerKind *CodeActionTriggerKind `json:"triggerKind,omitempty"`
+}
+
+// A code action represents a change that can be performed in code, e.g. to fix a problem or
+// to refactor code.
+//
+// A CodeAction must set either `edit` and/or a `command`. If both are supplied, the `edit` is applied first, then the `command` is executed.
+type CodeAction struct {
+	// A short, human-readable, title for this code action.
+	Title string `json:"title"`
+
+	// The kind of the code action.
+	//
+	// Used to filter code actions.
+	Kind *CodeActionKind `json:"kind,omitempty"`
+
+	// The diagnostics that this code action resolves.
+	Diagnostics []Diagnostic `json:"diagnostics,omitempty"`
+
+	// Marks this as a preferred action. Preferred actions are used by the `auto fix` command and can be targeted
+	// by keybindings.
+	//
+	// @since 3.15.0
+	IsPreferred *bool `json:"isPreferred,omitempty"`
+
+	// Marks that the code action cannot currently be applied.
+	//
+	// @since 3.16.0
+	Disabled *CodeActionDisabled `json:"disabled,omitempty"`
+
+	// The workspace edit this code action performs.
+	Edit *WorkspaceEdit `json:"edit,omitempty"`
+
+	// A command this code action executes. If a code action
+	// provides an edit and a command, first the edit is
+	// executed and then the command.
+	Command *Command `json:"command,omitempty"`
+
+	// A data entry field that is preserved on a code action between
+	// a `textDocument/codeAction` and a `codeAction/resolve` request.
+	//
+	// @since 3.16.0
+	Data interface{} `json:"data,omitempty"`
+}
+
+// Provider options for a {@link CodeActionRequest}.
+type CodeActionOptions struct {
+	WorkDoneProgressOptions
+	// CodeActionKinds that this server may return.
+	//
+	// The list of kinds may be generic, such as `CodeActionKind.Refactor`, or the server
+	// may list out every specific kind they provide.
+	CodeActionKinds []CodeActionKind `json:"codeActionKinds,omitempty"`
+
+	// The server provides support to resolve additional
+	// information for a code action.
+	//
+	// @since 3.16.0
+	ResolveProvider *bool `json:"resolveProvider,omitempty"`
+}
+
+// Registration options for a {@link CodeActionRequest}.
+type CodeActionRegistrationOptions struct {
+	TextDocumentRegistrationOptions
+	CodeActionOptions
+}
+
+// Value-object describing what options formatting should use.
+type FormattingOptions struct {
+	// Size of a tab in spaces.
+	TabSize uint32 `json:"tabSize"`
+
+	// Prefer spaces over tabs.
+	InsertSpaces bool `json:"insertSpaces"`
+
+	// Trim trailing whitespace on a line.
+	//
+	// @since 3.15.0
+	TrimTrailingWhitespace *bool `json:"trimTrailingWhitespace,omitempty"`
+
+	// Insert a newline character at the end of the file if one does not exist.
+	//
+	// @since 3.15.0
+	InsertFinalNewline *bool `json:"insertFinalNewline,omitempty"`
+
+	// Trim all newlines after the final newline at the end of the file.
+	//
+	// @since 3.15.0
+	TrimFinalNewlines *bool `json:"trimFinalNewlines,omitempty"`
+}
+
+// The parameters of a {@link DocumentFormattingRequest}.
+type DocumentFormattingParams struct {
+	WorkDoneProgressParams
+	// The document to format.
+	TextDocument TextDocumentIdentifier `json:"textDocument"`
+
+	// The format options.
+	Options FormattingOptions `json:"options"`
+}
+
+// Provider options for a {@link DocumentFormattingRequest}.
+type DocumentFormattingOptions struct {
+	WorkDoneProgressOptions
+}
+
+// Registration options for a {@link DocumentFormattingRequest}.
+type DocumentFormattingRegistrationOptions struct {
+	TextDocumentRegistrationOptions
+	DocumentFormattingOptions
+}
+
+// The parameters of a {@link DocumentRangeFormattingRequest}.
+type DocumentRangeFormattingParams struct {
+	WorkDoneProgressParams
+	// The document to format.
+	TextDocument TextDocumentIdentifier `json:"textDocument"`
+
+	// The range to format
+	Range Range `json:"range"`
+
+	// The format options
+	Options FormattingOptions `json:"options"`
+}
+
+// Provider options for a {@link DocumentRangeFormattingRequest}.
+type DocumentRangeFormattingOptions struct {
+	WorkDoneProgressOptions
+}
+
+// Registration options for a {@link DocumentRangeFormattingRequest}.
+type DocumentRangeFormattingRegistrationOptions struct {
+	TextDocumentRegistrationOptions
+	DocumentRangeFormattingOptions
+}
+
+// The parameters of a {@link DocumentOnTypeFormattingRequest}.
+type DocumentOnTypeFormattingParams struct {
+	// The document to format.
+	TextDocument TextDocumentIdentifier `json:"textDocument"`
+
+	// The position around which the on type formatting should happen.
+	// This is not necessarily the exact position where the character denoted
+	// by the property `ch` got typed.
+	Position Position `json:"position"`
+
+	// The character that has been typed that triggered the formatting
+	// on type request. That is not necessarily the last character that
+	// got inserted into the document since the client could auto insert
+	// characters as well (e.g. like automatic brace completion).
+	Ch string `json:"ch"`
+
+	// The formatting options.
+	Options FormattingOptions `json:"options"`
+}
+
+// Provider options for a {@link DocumentOnTypeFormattingRequest}.
+type DocumentOnTypeFormattingOptions struct {
+	// A character on which formatting should be triggered, like `{`.
+	FirstTriggerCharacter string `json:"firstTriggerCharacter"`
+
+	// More trigger characters.
+	MoreTriggerCharacter []string `json:"moreTriggerCharacter,omitempty"`
+}
+
+// Registration options for a {@link DocumentOnTypeFormattingRequest}.
+type DocumentOnTypeFormattingRegistrationOptions struct {
+	TextDocumentRegistrationOptions
+	DocumentOnTypeFormattingOptions
+}
+
+// The parameters of a {@link RenameRequest}.
+type RenameParams struct {
+	WorkDoneProgressParams
+	// The document to rename.
+	TextDocument TextDocumentIdentifier `json:"textDocument"`
+
+	// The position at which this request was sent.
+	Position Position `json:"position"`
+
+	// The new name of the symbol. If the given name is not valid the
+	// request must return a {@link ResponseError} with an
+	// appropriate message set.
+	NewName string `json:"newName"`
+}
+
+// Provider options for a {@link RenameRequest}.
+type RenameOptions struct {
+	WorkDoneProgressOptions
+	// Renames should be checked and tested before being executed.
+	//
+	// @since version 3.12.0
+	PrepareProvider *bool `json:"prepareProvider,omitempty"`
+}
+
+// Registration options for a {@link RenameRequest}.
+type RenameRegistrationOptions struct {
+	TextDocumentRegistrationOptions
+	RenameOptions
+}
+
+type PrepareRenameParams struct {
+	TextDocumentPositionParams
+	WorkDoneProgressParams
+}
+
+// The publish diagnostic notification's parameters.
+type PublishDiagnosticsParams struct {
+	// The URI for which diagnostic information is reported.
+	Uri DocumentURI `json:"uri"`
+
+	// Optional the version number of the document the diagnostics are published for.
+	//
+	// @since 3.15.0
+	Version *int32 `json:"version,omitempty"`
+
+	// An array of diagnostic information items.
+	Diagnostics []Diagnostic `json:"diagnostics"`
+}
+
+// Parameters of the document diagnostic request.
+//
+// @since 3.17.0
+type DocumentDiagnosticParams struct {
+	WorkDoneProgressParams
+	PartialResultParams
+	// The text document.
+	TextDocument TextDocumentIdentifier `json:"textDocument"`
+
+	// The additional identifier  provided during registration.
+	Identifier *string `json:"identifier,omitempty"`
+
+	// The result id of a previous response if provided.
+	PreviousResultId *string `json:"previousResultId,omitempty"`
+}
+
+// A diagnostic report with a full set of problems.
+//
+// @since 3.17.0
+type FullDocumentDiagnosticReport struct {
+	// A full document diagnostic report.
+	Kind string `json:"kind"`
+
+	// An optional result id. If provided it will
+	// be sent on the next diagnostic request for the
+	// same document.
+	ResultId *string `json:"resultId,omitempty"`
+
+	// The actual items.
+	Items []Diagnostic `json:"items"`
+}
+
+// A diagnostic report indicating that the last returned
+// report is still accurate.
+//
+// @since 3.17.0
+type UnchangedDocumentDiagnosticReport struct {
+	// A document diagnostic report indicating
+	// no changes to the last result. A server can
+	// only return `unchanged` if result ids are
+	// provided.
+	Kind string `json:"kind"`
+
+	// A result id which will be sent on the next
+	// diagnostic request for the same document.
+	ResultId string `json:"resultId"`
+}
+
+// A full diagnostic report with a set of related documents.
+//
+// @since 3.17.0
+type RelatedFullDocumentDiagnosticReport struct {
+	FullDocumentDiagnosticReport
+	// Diagnostics of related documents. This information is useful
+	// in programming languages where code in a file A can generate
+	// diagnostics in a file B which A depends on. An example of
+	// such a language is C/C++ where marco definitions in a file
+	// a.cpp and result in errors in a header file b.hpp.
+	//
+	// @since 3.17.0
+	RelatedDocuments map[DocumentURI]Or_FullDocumentDiagnosticReport_UnchangedDocumentDiagnosticReport `json:"relatedDocuments,omitempty"`
+}
+
+// An unchanged diagnostic report with a set of related documents.
+//
+// @since 3.17.0
+type RelatedUnchangedDocumentDiagnosticReport struct {
+	UnchangedDocumentDiagnosticReport
+	// Diagnostics of related documents. This information is useful
+	// in programming languages where code in a file A can generate
+	// diagnostics in a file B which A depends on. An example of
+	// such a language is C/C++ where marco definitions in a file
+	// a.cpp and result in errors in a header file b.hpp.
+	//
+	// @since 3.17.0
+	RelatedDocuments map[DocumentURI]Or_FullDocumentDiagnosticReport_UnchangedDocumentDiagnosticReport `json:"relatedDocuments,omitempty"`
+}
+
+// A partial result for a document diagnostic report.
+//
+// @since 3.17.0
+type DocumentDiagnosticReportPartialResult struct {
+	RelatedDocuments map[DocumentURI]Or_FullDocumentDiagnosticReport_UnchangedDocumentDiagnosticReport `json:"relatedDocuments"`
+}
+
+// Cancellation data returned from a diagnostic request.
+//
+// @since 3.17.0
+type DiagnosticServerCancellationData struct {
+	RetriggerRequest bool `json:"retriggerRequest"`
+}
+
+// Diagnostic options.
+//
+// @since 3.17.0
+type DiagnosticOptions struct {
+	WorkDoneProgressOptions
+	// An optional identifier under which the diagnostics are
+	// managed by the client.
+	Identifier *string `json:"identifier,omitempty"`
+
+	// Whether the language has inter file dependencies meaning that
+	// editing code in one file can result in a different diagnostic
+	// set in another file. Inter file dependencies are common for
+	// most programming languages and typically uncommon for linters.
+	InterFileDependencies bool `json:"interFileDependencies"`
+
+	// The server provides support for workspace diagnostics as well.
+	WorkspaceDiagnostics bool `json:"workspaceDiagnostics"`
+}
+
+// Diagnostic registration options.
+//
+// @since 3.17.0
+type DiagnosticRegistrationOptions struct {
+	TextDocumentRegistrationOptions
+	DiagnosticOptions
+	StaticRegistrationOptions
+}
+
+// A previous result id in a workspace pull request.
+//
+// @since 3.17.0
+type PreviousResultId struct {
+	// The URI for which the client knowns a
+	// result id.
+	Uri DocumentURI `json:"uri"`
+
+	// The value of the previous result id.
+	Value string `json:"value"`
+}
+
+// Parameters of the workspace diagnostic request.
+//
+// @since 3.17.0
+type WorkspaceDiagnosticParams struct {
+	WorkDoneProgressParams
+	PartialResultParams
+	// The additional identifier provided during registration.
+	Identifier *string `json:"identifier,omitempty"`
+
+	// The currently known diagnostic reports with their
+	// previous result ids.
+	PreviousResultIds []PreviousResultId `json:"previousResultIds"`
+}
+
+// A workspace diagnostic report.
+//
+// @since 3.17.0
+type WorkspaceDiagnosticReport struct {
+	Items []WorkspaceDocumentDiagnosticReport `json:"items"`
+}
+
+// A partial result for a workspace diagnostic report.
+//
+// @since 3.17.0
+type WorkspaceDiagnosticReportPartialResult struct {
+	Items []WorkspaceDocumentDiagnosticReport `json:"items"`
+}
+
+// A full document diagnostic report for a workspace diagnostic result.
+//
+// @since 3.17.0
+type WorkspaceFullDocumentDiagnosticReport struct {
+	FullDocumentDiagnosticReport
+	// The URI for which diagnostic information is reported.
+	Uri DocumentURI `json:"uri"`
+
+	// The version number for which the diagnostics are reported.
+	// If the document is not marked as open `null` can be provided.
+	Version *int32 `json:"version"`
+}
+
+// An unchanged document diagnostic report for a workspace diagnostic result.
+//
+// @since 3.17.0
+type WorkspaceUnchangedDocumentDiagnosticReport struct {
+	UnchangedDocumentDiagnosticReport
+	// The URI for which diagnostic information is reported.
+	Uri DocumentURI `json:"uri"`
+
+	// The version number for which the diagnostics are reported.
+	// If the document is not marked as open `null` can be provided.
+	Version *int32 `json:"version"`
+}
+
+type PrepareRenameResultRange struct {
+	Range       Range  `json:"range"`
+	Placeholder string `json:"placeholder"`
+}
+
+type PrepareRenameResultDefaultBehavior struct {
+	DefaultBehavior bool `json:"defaultBehavior"`
+}
+
+type TextDocumentContentChangeEventRange struct {
+	// The range of the document that changed.
+	Range Range `json:"range"`
+
+	// The optional length of the range that got replaced.
+	//
+	// @deprecated use range instead.
+	RangeLength *uint32 `json:"rangeLength,omitempty"`
+
+	// The new text for the provided range.
+	Text string `json:"text"`
+}
+
+type TextDocumentContentChangeEventText struct {
+	// The new text of the whole document.
+	Text string `json:"text"`
+}
+
+type MarkedStringLanguage struct {
+	Language string `json:"language"`
+	Value    string `json:"value"`
+}
+
+type TextDocumentFilterLanguage struct {
+	// A language id, like `typescript`.
+	Language string `json:"language"`
+
+	// A Uri {@link Uri.scheme scheme}, like `file` or `untitled`.
+	Scheme *string `json:"scheme,omitempty"`
+
+	// A glob pattern, like `*.{ts,js}`.
+	Pattern *string `json:"pattern,omitempty"`
+}
+
+type TextDocumentFilterScheme struct {
+	// A language id, like `typescript`.
+	Language *string `json:"language,omitempty"`
+
+	// A Uri {@link Uri.scheme scheme}, like `file` or `untitled`.
+	Scheme string `json:"scheme"`
+
+	// A glob pattern, like `*.{ts,js}`.
+	Pattern *string `json:"pattern,omitempty"`
+}
+
+type TextDocumentFilterPattern struct {
+	// A language id, like `typescript`.
+	Language *string `json:"language,omitempty"`
+
+	// A Uri {@link Uri.scheme scheme}, like `file` or `untitled`.
+	Scheme *string `json:"scheme,omitempty"`
+
+	// A glob pattern, like `*.{ts,js}`.
+	Pattern string `json:"pattern"`
+}
+
+type NotebookDocumentFilterNotebookType struct {
+	// The type of the enclosing notebook.
+	NotebookType string `json:"notebookType"`
+
+	// A Uri {@link Uri.scheme scheme}, like `file` or `untitled`.
+	Scheme *string `json:"scheme,omitempty"`
+
+	// A glob pattern.
+	Pattern *string `json:"pattern,omitempty"`
+}
+
+type NotebookDocumentFilterScheme struct {
+	// The type of the enclosing notebook.
+	NotebookType *string `json:"notebookType,omitempty"`
+
+	// A Uri {@link Uri.scheme scheme}, like `file` or `untitled`.
+	Scheme string `json:"scheme"`
+
+	// A glob pattern.
+	Pattern *string `json:"pattern,omitempty"`
+}
+
+type NotebookDocumentFilterPattern struct {
+	// The type of the enclosing notebook.
+	NotebookType *string `json:"notebookType,omitempty"`
+
+	// A Uri {@link Uri.scheme scheme}, like `file` or `untitled`.
+	Scheme *string `json:"scheme,omitempty"`
+
+	// A glob pattern.
+	Pattern string `json:"pattern"`
+}
+
+type XInitializeParamsClientInfo struct {
+	// The name of the client as defined by the client.
+	Name string `json:"name"`
+
+	// The client's version as defined by the client.
+	Version *string `json:"version,omitempty"`
+}
+
+type InitializeResultServerInfo struct {
+	// The name of the server as defined by the server.
+	Name string `json:"name"`
+
+	// The server's version as defined by the server.
+	Version *string `json:"version,omitempty"`
+}
+
+type WorkspaceEditClientCapabilitiesChangeAnnotationSupport struct {
+	// Whether the client groups edits with equal labels into tree nodes,
+	// for instance all edits labelled with "Changes in Strings" would
+	// be a tree node.
+	GroupsOnLabel *bool `json:"groupsOnLabel,omitempty"`
+}
+
+type WorkspaceSymbolClientCapabilitiesSymbolKind struct {
+	ValueSet []SymbolKind `json:"valueSet,omitempty"`
+}
+
+type WorkspaceSymbolClientCapabilitiesTagSupport struct {
+	ValueSet []SymbolTag `json:"valueSet"`
+}
+
+type WorkspaceSymbolClientCapabilitiesResolveSupport struct {
+	// The properties that a client can resolve lazily.
+	Properties []string `json:"properties"`
+}
+
+type CompletionClientCapabilitiesCompletionItem struct {
+	// Client supports snippets as insert text.
+	SnippetSupport *bool `json:"snippetSupport,omitempty"`
+
+	// Client supports commit characters on a completion item.
+	CommitCharactersSupport *bool `json:"commitCharactersSupport,omitempty"`
+
+	// Client supports the following content formats for the documentation
+	// property. The order describes the preferred format of the client.
+	DocumentationFormat []MarkupKind `json:"documentationFormat,omitempty"`
+
+	// Client supports the deprecated property on a completion item.
+	DeprecatedSupport *bool `json:"deprecatedSupport,omitempty"`
+
+	// Client supports the preselect property on a completion item.
+	PreselectSupport *bool `json:"preselectSupport,omitempty"`
+
+	// Client supports the tag property on a completion item.
+	//
+	// @since 3.15.0
+	TagSupport *CompletionClientCapabilitiesCompletionItemTagSupport `json:"tagSupport,omitempty"`
+
+	// Client support insert replace edit to control different behavior if a
+	// completion item is inserted in the text or should replace text.
+	//
+	// @since 3.16.0
+	InsertReplaceSupport *bool `json:"insertReplaceSupport,omitempty"`
+
+	// Indicates which properties a client can resolve lazily on a completion
+	// item.
+	//
+	// @since 3.16.0
+	ResolveSupport *CompletionClientCapabilitiesCompletionItemResolveSupport `json:"resolveSupport,omitempty"`
+
+	// The client supports the `insertTextMode` property on
+	// a completion item to override the whitespace handling mode
+	// as defined by the client.
+	//
+	// @since 3.16.0
+	InsertTextModeSupport *CompletionClientCapabilitiesCompletionItemInsertTextModeSupport `json:"insertTextModeSupport,omitempty"`
+
+	// The client has support for completion item label
+	// details (see also `CompletionItemLabelDetails`).
+	//
+	// @since 3.17.0
+	LabelDetailsSupport *bool `json:"labelDetailsSupport,omitempty"`
+}
+
+type CompletionClientCapabilitiesCompletionItemTagSupport struct {
+	ValueSet []CompletionItemTag `json:"valueSet"`
+}
+
+type CompletionClientCapabilitiesCompletionItemResolveSupport struct {
+	// The properties that a client can resolve lazily.
+	Properties []string `json:"properties"`
+}
+
+type CompletionClientCapabilitiesCompletionItemInsertTextModeSupport struct {
+	ValueSet []InsertTextMode `json:"valueSet"`
+}
+
+type CompletionClientCapabilitiesCompletionItemKind struct {
+	ValueSet []CompletionItemKind `json:"valueSet,omitempty"`
+}
+
+type CompletionClientCapabilitiesCompletionList struct {
+	// The client supports the following itemDefaults on
+	// a completion list.
+	ItemDefaults []string `json:"itemDefaults,omitempty"`
+}
+
+type SignatureHelpClientCapabilitiesSignatureInformation struct {
+	// Client supports the following content formats for the documentation
+	// property. The order describes the preferred format of the client.
+	DocumentationFormat []MarkupKind `json:"documentationFormat,omitempty"`
+
+	// Client capabilities specific to parameter information.
+	ParameterInformation *SignatureHelpClientCapabilitiesSignatureInformationParameterInformation `json:"parameterInformation,omitempty"`
+
+	// The client supports the `activeParameter` property on `SignatureInformation`
+	// literal.
+	//
+	// @since 3.16.0
+	ActiveParameterSupport *bool `json:"activeParameterSupport,omitempty"`
+}
+
+type SignatureHelpClientCapabilitiesSignatureInformationParameterInformation struct {
+	// The client supports processing label offsets instead of a
+	// simple label string.
+	//
+	// @since 3.14.0
+	LabelOffsetSupport *bool `json:"labelOffsetSupport,omitempty"`
+}
+
+type DocumentSymbolClientCapabilitiesSymbolKind struct {
+	ValueSet []SymbolKind `json:"valueSet,omitempty"`
+}
+
+type DocumentSymbolClientCapabilitiesTagSupport struct {
+	ValueSet []SymbolTag `json:"valueSet"`
+}
+
+type CodeActionClientCapabilitiesCodeActionLiteralSupport struct {
+	// The code action kind is support with the following value
+	// set.
+	CodeActionKind CodeActionClientCapabilitiesCodeActionLiteralSupportCodeActionKind `json:"codeActionKind"`
+}
+
+type CodeActionClientCapabilitiesCodeActionLiteralSupportCodeActionKind struct {
+	// The code action kind values the client supports. When this
+	// property exists the client also guarantees that it will
+	// handle values outside its set gracefully and falls back
+	// to a default value when unknown.
+	ValueSet []CodeActionKind `json:"valueSet"`
+}
+
+type CodeActionClientCapabilitiesResolveSupport struct {
+	// The properties that a client can resolve lazily.
+	Properties []string `json:"properties"`
+}
+
+type FoldingRangeClientCapabilitiesFoldingRangeKind struct {
+	ValueSet []FoldingRangeKind `json:"valueSet,omitempty"`
+}
+
+type FoldingRangeClientCapabilitiesFoldingRange struct {
+	// If set, the client signals that it supports setting collapsedText on
+	// folding ranges to display custom labels instead of the default text.
+	//
+	// @since 3.17.0
+	CollapsedText *bool `json:"collapsedText,omitempty"`
+}
+
+type PublishDiagnosticsClientCapabilitiesTagSupport struct {
+	ValueSet []DiagnosticTag `json:"valueSet"`
+}
+
+type SemanticTokensClientCapabilitiesRequests struct {
+	// The client will send the `textDocument/semanticTokens/range` request if
+	// the server provides a corresponding handler.
+	Range *SemanticTokensClientCapabilitiesRequestsRange `json:"range,omitempty"`
+
+	// The client will send the `textDocument/semanticTokens/full` request if
+	// the server provides a corresponding handler.
+	Full *SemanticTokensClientCapabilitiesRequestsFull `json:"full,omitempty"`
+}
+
+type SemanticTokensClientCapabilitiesRequestsRange2 struct {
+}
+
+type SemanticTokensClientCapabilitiesRequestsFull2 struct {
+	// The server supports deltas for full documents.
+	Delta *bool `json:"delta,omitempty"`
+}
+
+type InlayHintClientCapabilitiesResolveSupport struct {
+	// The properties that a client can resolve lazily.
+	Properties []string `json:"properties"`
+}
+
+type ShowMessageRequestClientCapabilitiesMessageActionItem struct {
+	// Whether the client supports additional attributes which
+	// are preserved and send back to the server in the
+	// request's response.
+	AdditionalPropertiesSupport *bool `json:"additionalPropertiesSupport,omitempty"`
+}
+
+type GeneralClientCapabilitiesStaleRequestSupport struct {
+	// The client will actively cancel the request.
+	Cancel bool `json:"cancel"`
+
+	// The list of requests for which the client
+	// will retry the request if it receives a
+	// response with error code `ContentModified`
+	RetryOnContentModified []string `json:"retryOnContentModified"`
+}
+
+type ServerCapabilitiesWorkspace struct {
+	// The server supports workspace folder.
+	//
+	// @since 3.6.0
+	WorkspaceFolders *WorkspaceFoldersServerCapabilities `json:"workspaceFolders,omitempty"`
+
+	// The server is interested in notifications/requests for operations on files.
+	//
+	// @since 3.16.0
+	FileOperations *FileOperationOptions `json:"fileOperations,omitempty"`
+}
+
+type WorkspaceSymbolLocationUri struct {
+	Uri DocumentURI `json:"uri"`
+}
+
+type NotebookDocumentChangeEventCells struct {
+	// Changes to the cell structure to add or
+	// remove cells.
+	Structure *NotebookDocumentChangeEventCellsStructure `json:"structure,omitempty"`
+
+	// Changes to notebook cells properties like its
+	// kind, execution summary or metadata.
+	Data []NotebookCell `json:"data,omitempty"`
+
+	// Changes to the text content of notebook cells.
+	TextContent []NotebookDocumentChangeEventCellsTextContent `json:"textContent,omitempty"`
+}
+
+type NotebookDocumentChangeEventCellsStructure struct {
+	// The change to the cell array.
+	Array NotebookCellArrayChange `json:"array"`
+
+	// Additional opened cell text documents.
+	DidOpen []TextDocumentItem `json:"didOpen,omitempty"`
+
+	// Additional closed cell text documents.
+	DidClose []TextDocumentIdentifier `json:"didClose,omitempty"`
+}
+
+type NotebookDocumentChangeEventCellsTextContent struct {
+	Document VersionedTextDocumentIdentifier  `json:"document"`
+	Changes  []TextDocumentContentChangeEvent `json:"changes"`
+}
+
+type NotebookDocumentSyncOptionsNotebookSelectorNotebook struct {
+	// The notebook to be synced If a string
+	// value is provided it matches against the
+	// notebook type. '*' matches every notebook.
+	Notebook Or_String_NotebookDocumentFilter `json:"notebook"`
+
+	// The cells of the matching notebook to be synced.
+	Cells []NotebookDocumentSyncOptionsNotebookSelectorNotebookCells `json:"cells,omitempty"`
+}
+
+type NotebookDocumentSyncOptionsNotebookSelectorNotebookCells struct {
+	Language string `json:"language"`
+}
+
+type NotebookDocumentSyncOptionsNotebookSelectorCells struct {
+	// The notebook to be synced If a string
+	// value is provided it matches against the
+	// notebook type. '*' matches every notebook.
+	Notebook *Or_String_NotebookDocumentFilter `json:"notebook,omitempty"`
+
+	// The cells of the matching notebook to be synced.
+	Cells []NotebookDocumentSyncOptionsNotebookSelectorCellsCells `json:"cells"`
+}
+
+type NotebookDocumentSyncOptionsNotebookSelectorCellsCells struct {
+	Language string `json:"language"`
+}
+
+type SemanticTokensOptionsRange2 struct {
+}
+
+type SemanticTokensOptionsFull2 struct {
+	// The server supports deltas for full documents.
+	Delta *bool `json:"delta,omitempty"`
+}
+
+type CompletionListItemDefaults struct {
+	// A default commit character set.
+	//
+	// @since 3.17.0
+	CommitCharacters []string `json:"commitCharacters,omitempty"`
+
+	// A default edit range.
+	//
+	// @since 3.17.0
+	EditRange *CompletionListItemDefaultsEditRange `json:"editRange,omitempty"`
+
+	// A default insert text format.
+	//
+	// @since 3.17.0
+	InsertTextFormat *InsertTextFormat `json:"insertTextFormat,omitempty"`
+
+	// A default insert text mode.
+	//
+	// @since 3.17.0
+	InsertTextMode *InsertTextMode `json:"insertTextMode,omitempty"`
+
+	// A default data value.
+	//
+	// @since 3.17.0
+	Data interface{} `json:"data,omitempty"`
+}
+
+type CompletionListItemDefaultsEditRangeInsert struct {
+	Insert  Range `json:"insert"`
+	Replace Range `json:"replace"`
+}
+
+type CompletionOptionsCompletionItem struct {
+	// The server has support for completion item label
+	// details (see also `CompletionItemLabelDetails`) when
+	// receiving a completion item in a resolve call.
+	//
+	// @since 3.17.0
+	LabelDetailsSupport *bool `json:"labelDetailsSupport,omitempty"`
+}
+
+type CodeActionDisabled struct {
+	// Human readable description of why the code action is currently disabled.
+	//
+	// This is displayed in the code actions UI.
+	Reason string `json:"reason"`
+}
+
+type And_ConfigurationParams_PartialResultParams struct {
+	ConfigurationParams
+	PartialResultParams
+}
+
+type And_WorkDoneProgressOptions_TextDocumentRegistrationOptions struct {
+	WorkDoneProgressOptions
+	TextDocumentRegistrationOptions
+}
+
+// Or_TextEdit_AnnotatedTextEdit holds a value of one of several types.
+type Or_TextEdit_AnnotatedTextEdit struct {
+	// Value is one of AnnotatedTextEdit, TextEdit.
+	Value interface{}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t Or_TextEdit_AnnotatedTextEdit) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.Value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. The type of the value is
+// determined by the shape of the JSON value.
+func (t *Or_TextEdit_AnnotatedTextEdit) UnmarshalJSON(b []byte) error {
+	if isNull(b) {
+		t.Value = nil
+		return nil
+	}
+	if hasFields(b, "range", "newText", "annotationId") {
+		var v AnnotatedTextEdit
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	if hasFields(b, "range", "newText") {
+		var v TextEdit
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot unmarshal %s into Or_TextEdit_AnnotatedTextEdit", b)
+}
+
+// Or_TextDocumentEdit_CreateFile_RenameFile_DeleteFile holds a value of one of several types.
+type Or_TextDocumentEdit_CreateFile_RenameFile_DeleteFile struct {
+	// Value is one of RenameFile, CreateFile, DeleteFile, TextDocumentEdit.
+	Value interface{}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t Or_TextDocumentEdit_CreateFile_RenameFile_DeleteFile) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.Value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. The type of the value is
+// determined by the shape of the JSON value.
+func (t *Or_TextDocumentEdit_CreateFile_RenameFile_DeleteFile) UnmarshalJSON(b []byte) error {
+	if isNull(b) {
+		t.Value = nil
+		return nil
+	}
+	if hasFields(b, "kind", "oldUri", "newUri") && hasValue(b, "kind", "\"rename\"") {
+		var v RenameFile
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	if hasFields(b, "kind", "uri") && hasValue(b, "kind", "\"create\"") {
+		var v CreateFile
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	if hasFields(b, "kind", "uri") && hasValue(b, "kind", "\"delete\"") {
+		var v DeleteFile
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	if hasFields(b, "textDocument", "edits") {
+		var v TextDocumentEdit
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot unmarshal %s into Or_TextDocumentEdit_CreateFile_RenameFile_DeleteFile", b)
+}
+
+// Or_Int32_String holds a value of one of several types.
+type Or_Int32_String struct {
+	// Value is one of int32, string.
+	Value interface{}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t Or_Int32_String) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.Value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. The type of the value is
+// determined by the shape of the JSON value.
+func (t *Or_Int32_String) UnmarshalJSON(b []byte) error {
+	if isNull(b) {
+		t.Value = nil
+		return nil
+	}
+	if isInteger(b) {
+		var v int32
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	if isString(b) {
+		var v string
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot unmarshal %s into Or_Int32_String", b)
+}
+
+// SemanticTokensClientCapabilitiesRequestsRange holds a value of one of several types.
+type SemanticTokensClientCapabilitiesRequestsRange struct {
+	// Value is one of bool, SemanticTokensClientCapabilitiesRequestsRange2.
+	Value interface{}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t SemanticTokensClientCapabilitiesRequestsRange) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.Value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. The type of the value is
+// determined by the shape of the JSON value.
+func (t *SemanticTokensClientCapabilitiesRequestsRange) UnmarshalJSON(b []byte) error {
+	if isNull(b) {
+		t.Value = nil
+		return nil
+	}
+	if isBool(b) {
+		var v bool
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	if isObject(b) {
+		var v SemanticTokensClientCapabilitiesRequestsRange2
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot unmarshal %s into SemanticTokensClientCapabilitiesRequestsRange", b)
+}
+
+// SemanticTokensClientCapabilitiesRequestsFull holds a value of one of several types.
+type SemanticTokensClientCapabilitiesRequestsFull struct {
+	// Value is one of bool, SemanticTokensClientCapabilitiesRequestsFull2.
+	Value interface{}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t SemanticTokensClientCapabilitiesRequestsFull) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.Value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. The type of the value is
+// determined by the shape of the JSON value.
+func (t *SemanticTokensClientCapabilitiesRequestsFull) UnmarshalJSON(b []byte) error {
+	if isNull(b) {
+		t.Value = nil
+		return nil
+	}
+	if isBool(b) {
+		var v bool
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	if isObject(b) {
+		var v SemanticTokensClientCapabilitiesRequestsFull2
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot unmarshal %s into SemanticTokensClientCapabilitiesRequestsFull", b)
+}
+
+// Or_TextDocumentSyncOptions_TextDocumentSyncKind holds a value of one of several types.
+type Or_TextDocumentSyncOptions_TextDocumentSyncKind struct {
+	// Value is one of TextDocumentSyncOptions, TextDocumentSyncKind.
+	Value interface{}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t Or_TextDocumentSyncOptions_TextDocumentSyncKind) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.Value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. The type of the value is
+// determined by the shape of the JSON value.
+func (t *Or_TextDocumentSyncOptions_TextDocumentSyncKind) UnmarshalJSON(b []byte) error {
+	if isNull(b) {
+		t.Value = nil
+		return nil
+	}
+	if isObject(b) {
+		var v TextDocumentSyncOptions
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	if isInteger(b) {
+		var v TextDocumentSyncKind
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot unmarshal %s into Or_TextDocumentSyncOptions_TextDocumentSyncKind", b)
+}
+
+// Or_NotebookDocumentSyncOptions_NotebookDocumentSyncRegistrationOptions holds a value of one of several types.
+type Or_NotebookDocumentSyncOptions_NotebookDocumentSyncRegistrationOptions struct {
+	// Value is one of NotebookDocumentSyncOptions, NotebookDocumentSyncRegistrationOptions.
+	Value interface{}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t Or_NotebookDocumentSyncOptions_NotebookDocumentSyncRegistrationOptions) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.Value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. The type of the value is
+// determined by the shape of the JSON value.
+func (t *Or_NotebookDocumentSyncOptions_NotebookDocumentSyncRegistrationOptions) UnmarshalJSON(b []byte) error {
+	if isNull(b) {
+		t.Value = nil
+		return nil
+	}
+	if hasFields(b, "notebookSelector") {
+		var v NotebookDocumentSyncOptions
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	if hasFields(b, "notebookSelector") {
+		var v NotebookDocumentSyncRegistrationOptions
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot unmarshal %s into Or_NotebookDocumentSyncOptions_NotebookDocumentSyncRegistrationOptions", b)
+}
+
+// Or_Bool_HoverOptions holds a value of one of several types.
+type Or_Bool_HoverOptions struct {
+	// Value is one of bool, HoverOptions.
+	Value interface{}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t Or_Bool_HoverOptions) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.Value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. The type of the value is
+// determined by the shape of the JSON value.
+func (t *Or_Bool_HoverOptions) UnmarshalJSON(b []byte) error {
+	if isNull(b) {
+		t.Value = nil
+		return nil
+	}
+	if isBool(b) {
+		var v bool
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	if isObject(b) {
+		var v HoverOptions
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot unmarshal %s into Or_Bool_HoverOptions", b)
+}
+
+// Or_Bool_DeclarationOptions_DeclarationRegistrationOptions holds a value of one of several types.
+type Or_Bool_DeclarationOptions_DeclarationRegistrationOptions struct {
+	// Value is one of DeclarationRegistrationOptions, bool, DeclarationOptions.
+	Value interface{}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t Or_Bool_DeclarationOptions_DeclarationRegistrationOptions) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.Value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. The type of the value is
+// determined by the shape of the JSON value.
+func (t *Or_Bool_DeclarationOptions_DeclarationRegistrationOptions) UnmarshalJSON(b []byte) error {
+	if isNull(b) {
+		t.Value = nil
+		return nil
+	}
+	if hasFields(b, "documentSelector") {
+		var v DeclarationRegistrationOptions
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	if isBool(b) {
+		var v bool
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	if isObject(b) {
+		var v DeclarationOptions
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot unmarshal %s into Or_Bool_DeclarationOptions_DeclarationRegistrationOptions", b)
+}
+
+// Or_Bool_DefinitionOptions holds a value of one of several types.
+type Or_Bool_DefinitionOptions struct {
+	// Value is one of bool, DefinitionOptions.
+	Value interface{}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t Or_Bool_DefinitionOptions) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.Value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. The type of the value is
+// determined by the shape of the JSON value.
+func (t *Or_Bool_DefinitionOptions) UnmarshalJSON(b []byte) error {
+	if isNull(b) {
+		t.Value = nil
+		return nil
+	}
+	if isBool(b) {
+		var v bool
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	if isObject(b) {
+		var v DefinitionOptions
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot unmarshal %s into Or_Bool_DefinitionOptions", b)
+}
+
+// Or_Bool_TypeDefinitionOptions_TypeDefinitionRegistrationOptions holds a value of one of several types.
+type Or_Bool_TypeDefinitionOptions_TypeDefinitionRegistrationOptions struct {
+	// Value is one of TypeDefinitionRegistrationOptions, bool, TypeDefinitionOptions.
+	Value interface{}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t Or_Bool_TypeDefinitionOptions_TypeDefinitionRegistrationOptions) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.Value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. The type of the value is
+// determined by the shape of the JSON value.
+func (t *Or_Bool_TypeDefinitionOptions_TypeDefinitionRegistrationOptions) UnmarshalJSON(b []byte) error {
+	if isNull(b) {
+		t.Value = nil
+		return nil
+	}
+	if hasFields(b, "documentSelector") {
+		var v TypeDefinitionRegistrationOptions
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	if isBool(b) {
+		var v bool
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	if isObject(b) {
+		var v TypeDefinitionOptions
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot unmarshal %s into Or_Bool_TypeDefinitionOptions_TypeDefinitionRegistrationOptions", b)
+}
+
+// Or_Bool_ImplementationOptions_ImplementationRegistrationOptions holds a value of one of several types.
+type Or_Bool_ImplementationOptions_ImplementationRegistrationOptions struct {
+	// Value is one of ImplementationRegistrationOptions, bool, ImplementationOptions.
+	Value interface{}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t Or_Bool_ImplementationOptions_ImplementationRegistrationOptions) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.Value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. The type of the value is
+// determined by the shape of the JSON value.
+func (t *Or_Bool_ImplementationOptions_ImplementationRegistrationOptions) UnmarshalJSON(b []byte) error {
+	if isNull(b) {
+		t.Value = nil
+		return nil
+	}
+	if hasFields(b, "documentSelector") {
+		var v ImplementationRegistrationOptions
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	if isBool(b) {
+		var v bool
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	if isObject(b) {
+		var v ImplementationOptions
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot unmarshal %s into Or_Bool_ImplementationOptions_ImplementationRegistrationOptions", b)
+}
+
+// Or_Bool_ReferenceOptions holds a value of one of several types.
+type Or_Bool_ReferenceOptions struct {
+	// Value is one of bool, ReferenceOptions.
+	Value interface{}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t Or_Bool_ReferenceOptions) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.Value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. The type of the value is
+// determined by the shape of the JSON value.
+func (t *Or_Bool_ReferenceOptions) UnmarshalJSON(b []byte) error {
+	if isNull(b) {
+		t.Value = nil
+		return nil
+	}
+	if isBool(b) {
+		var v bool
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	if isObject(b) {
+		var v ReferenceOptions
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot unmarshal %s into Or_Bool_ReferenceOptions", b)
+}
+
+// Or_Bool_DocumentHighlightOptions holds a value of one of several types.
+type Or_Bool_DocumentHighlightOptions struct {
+	// Value is one of bool, DocumentHighlightOptions.
+	Value interface{}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t Or_Bool_DocumentHighlightOptions) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.Value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. The type of the value is
+// determined by the shape of the JSON value.
+func (t *Or_Bool_DocumentHighlightOptions) UnmarshalJSON(b []byte) error {
+	if isNull(b) {
+		t.Value = nil
+		return nil
+	}
+	if isBool(b) {
+		var v bool
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	if isObject(b) {
+		var v DocumentHighlightOptions
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot unmarshal %s into Or_Bool_DocumentHighlightOptions", b)
+}
+
+// Or_Bool_DocumentSymbolOptions holds a value of one of several types.
+type Or_Bool_DocumentSymbolOptions struct {
+	// Value is one of bool, DocumentSymbolOptions.
+	Value interface{}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t Or_Bool_DocumentSymbolOptions) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.Value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. The type of the value is
+// determined by the shape of the JSON value.
+func (t *Or_Bool_DocumentSymbolOptions) UnmarshalJSON(b []byte) error {
+	if isNull(b) {
+		t.Value = nil
+		return nil
+	}
+	if isBool(b) {
+		var v bool
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	if isObject(b) {
+		var v DocumentSymbolOptions
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot unmarshal %s into Or_Bool_DocumentSymbolOptions", b)
+}
+
+// Or_Bool_CodeActionOptions holds a value of one of several types.
+type Or_Bool_CodeActionOptions struct {
+	// Value is one of bool, CodeActionOptions.
+	Value interface{}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t Or_Bool_CodeActionOptions) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.Value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. The type of the value is
+// determined by the shape of the JSON value.
+func (t *Or_Bool_CodeActionOptions) UnmarshalJSON(b []byte) error {
+	if isNull(b) {
+		t.Value = nil
+		return nil
+	}
+	if isBool(b) {
+		var v bool
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	if isObject(b) {
+		var v CodeActionOptions
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot unmarshal %s into Or_Bool_CodeActionOptions", b)
+}
+
+// Or_Bool_DocumentColorOptions_DocumentColorRegistrationOptions holds a value of one of several types.
+type Or_Bool_DocumentColorOptions_DocumentColorRegistrationOptions struct {
+	// Value is one of DocumentColorRegistrationOptions, bool, DocumentColorOptions.
+	Value interface{}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t Or_Bool_DocumentColorOptions_DocumentColorRegistrationOptions) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.Value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. The type of the value is
+// determined by the shape of the JSON value.
+func (t *Or_Bool_DocumentColorOptions_DocumentColorRegistrationOptions) UnmarshalJSON(b []byte) error {
+	if isNull(b) {
+		t.Value = nil
+		return nil
+	}
+	if hasFields(b, "documentSelector") {
+		var v DocumentColorRegistrationOptions
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	if isBool(b) {
+		var v bool
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	if isObject(b) {
+		var v DocumentColorOptions
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot unmarshal %s into Or_Bool_DocumentColorOptions_DocumentColorRegistrationOptions", b)
+}
+
+// Or_Bool_WorkspaceSymbolOptions holds a value of one of several types.
+type Or_Bool_WorkspaceSymbolOptions struct {
+	// Value is one of bool, WorkspaceSymbolOptions.
+	Value interface{}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t Or_Bool_WorkspaceSymbolOptions) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.Value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. The type of the value is
+// determined by the shape of the JSON value.
+func (t *Or_Bool_WorkspaceSymbolOptions) UnmarshalJSON(b []byte) error {
+	if isNull(b) {
+		t.Value = nil
+		return nil
+	}
+	if isBool(b) {
+		var v bool
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	if isObject(b) {
+		var v WorkspaceSymbolOptions
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot unmarshal %s into Or_Bool_WorkspaceSymbolOptions", b)
+}
+
+// Or_Bool_DocumentFormattingOptions holds a value of one of several types.
+type Or_Bool_DocumentFormattingOptions struct {
+	// Value is one of bool, DocumentFormattingOptions.
+	Value interface{}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t Or_Bool_DocumentFormattingOptions) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.Value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. The type of the value is
+// determined by the shape of the JSON value.
+func (t *Or_Bool_DocumentFormattingOptions) UnmarshalJSON(b []byte) error {
+	if isNull(b) {
+		t.Value = nil
+		return nil
+	}
+	if isBool(b) {
+		var v bool
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	if isObject(b) {
+		var v DocumentFormattingOptions
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot unmarshal %s into Or_Bool_DocumentFormattingOptions", b)
+}
+
+// Or_Bool_DocumentRangeFormattingOptions holds a value of one of several types.
+type Or_Bool_DocumentRangeFormattingOptions struct {
+	// Value is one of bool, DocumentRangeFormattingOptions.
+	Value interface{}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t Or_Bool_DocumentRangeFormattingOptions) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.Value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. The type of the value is
+// determined by the shape of the JSON value.
+func (t *Or_Bool_DocumentRangeFormattingOptions) UnmarshalJSON(b []byte) error {
+	if isNull(b) {
+		t.Value = nil
+		return nil
+	}
+	if isBool(b) {
+		var v bool
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	if isObject(b) {
+		var v DocumentRangeFormattingOptions
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot unmarshal %s into Or_Bool_DocumentRangeFormattingOptions", b)
+}
+
+// Or_Bool_RenameOptions holds a value of one of several types.
+type Or_Bool_RenameOptions struct {
+	// Value is one of bool, RenameOptions.
+	Value interface{}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t Or_Bool_RenameOptions) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.Value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. The type of the value is
+// determined by the shape of the JSON value.
+func (t *Or_Bool_RenameOptions) UnmarshalJSON(b []byte) error {
+	if isNull(b) {
+		t.Value = nil
+		return nil
+	}
+	if isBool(b) {
+		var v bool
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	if isObject(b) {
+		var v RenameOptions
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot unmarshal %s into Or_Bool_RenameOptions", b)
+}
+
+// Or_Bool_FoldingRangeOptions_FoldingRangeRegistrationOptions holds a value of one of several types.
+type Or_Bool_FoldingRangeOptions_FoldingRangeRegistrationOptions struct {
+	// Value is one of FoldingRangeRegistrationOptions, bool, FoldingRangeOptions.
+	Value interface{}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t Or_Bool_FoldingRangeOptions_FoldingRangeRegistrationOptions) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.Value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. The type of the value is
+// determined by the shape of the JSON value.
+func (t *Or_Bool_FoldingRangeOptions_FoldingRangeRegistrationOptions) UnmarshalJSON(b []byte) error {
+	if isNull(b) {
+		t.Value = nil
+		return nil
+	}
+	if hasFields(b, "documentSelector") {
+		var v FoldingRangeRegistrationOptions
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	if isBool(b) {
+		var v bool
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	if isObject(b) {
+		var v FoldingRangeOptions
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot unmarshal %s into Or_Bool_FoldingRangeOptions_FoldingRangeRegistrationOptions", b)
+}
+
+// Or_Bool_SelectionRangeOptions_SelectionRangeRegistrationOptions holds a value of one of several types.
+type Or_Bool_SelectionRangeOptions_SelectionRangeRegistrationOptions struct {
+	// Value is one of SelectionRangeRegistrationOptions, bool, SelectionRangeOptions.
+	Value interface{}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t Or_Bool_SelectionRangeOptions_SelectionRangeRegistrationOptions) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.Value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. The type of the value is
+// determined by the shape of the JSON value.
+func (t *Or_Bool_SelectionRangeOptions_SelectionRangeRegistrationOptions) UnmarshalJSON(b []byte) error {
+	if isNull(b) {
+		t.Value = nil
+		return nil
+	}
+	if hasFields(b, "documentSelector") {
+		var v SelectionRangeRegistrationOptions
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	if isBool(b) {
+		var v bool
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	if isObject(b) {
+		var v SelectionRangeOptions
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot unmarshal %s into Or_Bool_SelectionRangeOptions_SelectionRangeRegistrationOptions", b)
+}
+
+// Or_Bool_CallHierarchyOptions_CallHierarchyRegistrationOptions holds a value of one of several types.
+type Or_Bool_CallHierarchyOptions_CallHierarchyRegistrationOptions struct {
+	// Value is one of CallHierarchyRegistrationOptions, bool, CallHierarchyOptions.
+	Value interface{}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t Or_Bool_CallHierarchyOptions_CallHierarchyRegistrationOptions) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.Value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. The type of the value is
+// determined by the shape of the JSON value.
+func (t *Or_Bool_CallHierarchyOptions_CallHierarchyRegistrationOptions) UnmarshalJSON(b []byte) error {
+	if isNull(b) {
+		t.Value = nil
+		return nil
+	}
+	if hasFields(b, "documentSelector") {
+		var v CallHierarchyRegistrationOptions
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	if isBool(b) {
+		var v bool
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	if isObject(b) {
+		var v CallHierarchyOptions
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot unmarshal %s into Or_Bool_CallHierarchyOptions_CallHierarchyRegistrationOptions", b)
+}
+
+// Or_Bool_LinkedEditingRangeOptions_LinkedEditingRangeRegistrationOptions holds a value of one of several types.
+type Or_Bool_LinkedEditingRangeOptions_LinkedEditingRangeRegistrationOptions struct {
+	// Value is one of LinkedEditingRangeRegistrationOptions, bool, LinkedEditingRangeOptions.
+	Value interface{}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t Or_Bool_LinkedEditingRangeOptions_LinkedEditingRangeRegistrationOptions) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.Value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. The type of the value is
+// determined by the shape of the JSON value.
+func (t *Or_Bool_LinkedEditingRangeOptions_LinkedEditingRangeRegistrationOptions) UnmarshalJSON(b []byte) error {
+	if isNull(b) {
+		t.Value = nil
+		return nil
+	}
+	if hasFields(b, "documentSelector") {
+		var v LinkedEditingRangeRegistrationOptions
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	if isBool(b) {
+		var v bool
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	if isObject(b) {
+		var v LinkedEditingRangeOptions
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot unmarshal %s into Or_Bool_LinkedEditingRangeOptions_LinkedEditingRangeRegistrationOptions", b)
+}
+
+// Or_SemanticTokensOptions_SemanticTokensRegistrationOptions holds a value of one of several types.
+type Or_SemanticTokensOptions_SemanticTokensRegistrationOptions struct {
+	// Value is one of SemanticTokensRegistrationOptions, SemanticTokensOptions.
+	Value interface{}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t Or_SemanticTokensOptions_SemanticTokensRegistrationOptions) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.Value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. The type of the value is
+// determined by the shape of the JSON value.
+func (t *Or_SemanticTokensOptions_SemanticTokensRegistrationOptions) UnmarshalJSON(b []byte) error {
+	if isNull(b) {
+		t.Value = nil
+		return nil
+	}
+	if hasFields(b, "documentSelector", "legend") {
+		var v SemanticTokensRegistrationOptions
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	if hasFields(b, "legend") {
+		var v SemanticTokensOptions
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot unmarshal %s into Or_SemanticTokensOptions_SemanticTokensRegistrationOptions", b)
+}
+
+// Or_Bool_MonikerOptions_MonikerRegistrationOptions holds a value of one of several types.
+type Or_Bool_MonikerOptions_MonikerRegistrationOptions struct {
+	// Value is one of MonikerRegistrationOptions, bool, MonikerOptions.
+	Value interface{}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t Or_Bool_MonikerOptions_MonikerRegistrationOptions) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.Value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. The type of the value is
+// determined by the shape of the JSON value.
+func (t *Or_Bool_MonikerOptions_MonikerRegistrationOptions) UnmarshalJSON(b []byte) error {
+	if isNull(b) {
+		t.Value = nil
+		return nil
+	}
+	if hasFields(b, "documentSelector") {
+		var v MonikerRegistrationOptions
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	if isBool(b) {
+		var v bool
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	if isObject(b) {
+		var v MonikerOptions
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot unmarshal %s into Or_Bool_MonikerOptions_MonikerRegistrationOptions", b)
+}
+
+// Or_Bool_TypeHierarchyOptions_TypeHierarchyRegistrationOptions holds a value of one of several types.
+type Or_Bool_TypeHierarchyOptions_TypeHierarchyRegistrationOptions struct {
+	// Value is one of TypeHierarchyRegistrationOptions, bool, TypeHierarchyOptions.
+	Value interface{}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t Or_Bool_TypeHierarchyOptions_TypeHierarchyRegistrationOptions) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.Value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. The type of the value is
+// determined by the shape of the JSON value.
+func (t *Or_Bool_TypeHierarchyOptions_TypeHierarchyRegistrationOptions) UnmarshalJSON(b []byte) error {
+	if isNull(b) {
+		t.Value = nil
+		return nil
+	}
+	if hasFields(b, "documentSelector") {
+		var v TypeHierarchyRegistrationOptions
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	if isBool(b) {
+		var v bool
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	if isObject(b) {
+		var v TypeHierarchyOptions
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot unmarshal %s into Or_Bool_TypeHierarchyOptions_TypeHierarchyRegistrationOptions", b)
+}
+
+// Or_Bool_InlineValueOptions_InlineValueRegistrationOptions holds a value of one of several types.
+type Or_Bool_InlineValueOptions_InlineValueRegistrationOptions struct {
+	// Value is one of InlineValueRegistrationOptions, bool, InlineValueOptions.
+	Value interface{}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t Or_Bool_InlineValueOptions_InlineValueRegistrationOptions) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.Value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. The type of the value is
+// determined by the shape of the JSON value.
+func (t *Or_Bool_InlineValueOptions_InlineValueRegistrationOptions) UnmarshalJSON(b []byte) error {
+	if isNull(b) {
+		t.Value = nil
+		return nil
+	}
+	if hasFields(b, "documentSelector") {
+		var v InlineValueRegistrationOptions
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	if isBool(b) {
+		var v bool
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	if isObject(b) {
+		var v InlineValueOptions
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot unmarshal %s into Or_Bool_InlineValueOptions_InlineValueRegistrationOptions", b)
+}
+
+// Or_Bool_InlayHintOptions_InlayHintRegistrationOptions holds a value of one of several types.
+type Or_Bool_InlayHintOptions_InlayHintRegistrationOptions struct {
+	// Value is one of InlayHintRegistrationOptions, bool, InlayHintOptions.
+	Value interface{}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t Or_Bool_InlayHintOptions_InlayHintRegistrationOptions) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.Value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. The type of the value is
+// determined by the shape of the JSON value.
+func (t *Or_Bool_InlayHintOptions_InlayHintRegistrationOptions) UnmarshalJSON(b []byte) error {
+	if isNull(b) {
+		t.Value = nil
+		return nil
+	}
+	if hasFields(b, "documentSelector") {
+		var v InlayHintRegistrationOptions
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	if isBool(b) {
+		var v bool
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	if isObject(b) {
+		var v InlayHintOptions
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot unmarshal %s into Or_Bool_InlayHintOptions_InlayHintRegistrationOptions", b)
+}
+
+// Or_DiagnosticOptions_DiagnosticRegistrationOptions holds a value of one of several types.
+type Or_DiagnosticOptions_DiagnosticRegistrationOptions struct {
+	// Value is one of DiagnosticRegistrationOptions, DiagnosticOptions.
+	Value interface{}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t Or_DiagnosticOptions_DiagnosticRegistrationOptions) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.Value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. The type of the value is
+// determined by the shape of the JSON value.
+func (t *Or_DiagnosticOptions_DiagnosticRegistrationOptions) UnmarshalJSON(b []byte) error {
+	if isNull(b) {
+		t.Value = nil
+		return nil
+	}
+	if hasFields(b, "documentSelector", "interFileDependencies", "workspaceDiagnostics") {
+		var v DiagnosticRegistrationOptions
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	if hasFields(b, "interFileDependencies", "workspaceDiagnostics") {
+		var v DiagnosticOptions
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot unmarshal %s into Or_DiagnosticOptions_DiagnosticRegistrationOptions", b)
+}
+
+// Or_String_Bool holds a value of one of several types.
+type Or_String_Bool struct {
+	// Value is one of string, bool.
+	Value interface{}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t Or_String_Bool) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.Value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. The type of the value is
+// determined by the shape of the JSON value.
+func (t *Or_String_Bool) UnmarshalJSON(b []byte) error {
+	if isNull(b) {
+		t.Value = nil
+		return nil
+	}
+	if isString(b) {
+		var v string
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	if isBool(b) {
+		var v bool
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot unmarshal %s into Or_String_Bool", b)
+}
+
+// Or_String_StringSlice holds a value of one of several types.
+type Or_String_StringSlice struct {
+	// Value is one of string, []string.
+	Value interface{}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t Or_String_StringSlice) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.Value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. The type of the value is
+// determined by the shape of the JSON value.
+func (t *Or_String_StringSlice) UnmarshalJSON(b []byte) error {
+	if isNull(b) {
+		t.Value = nil
+		return nil
+	}
+	if isString(b) {
+		var v string
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	if isArrayOf(b, func(b []byte) bool { return isString(b) }) {
+		var v []string
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot unmarshal %s into Or_String_StringSlice", b)
+}
+
+// Or_WorkspaceFolder_URI holds a value of one of several types.
+type Or_WorkspaceFolder_URI struct {
+	// Value is one of WorkspaceFolder, URI.
+	Value interface{}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t Or_WorkspaceFolder_URI) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.Value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. The type of the value is
+// determined by the shape of the JSON value.
+func (t *Or_WorkspaceFolder_URI) UnmarshalJSON(b []byte) error {
+	if isNull(b) {
+		t.Value = nil
+		return nil
+	}
+	if hasFields(b, "uri", "name") {
+		var v WorkspaceFolder
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	if isString(b) {
+		var v URI
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot unmarshal %s into Or_WorkspaceFolder_URI", b)
+}
+
+// WorkspaceSymbolLocation holds a value of one of several types.
+type WorkspaceSymbolLocation struct {
+	// Value is one of Location, WorkspaceSymbolLocationUri.
+	Value interface{}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t WorkspaceSymbolLocation) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.Value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. The type of the value is
+// determined by the shape of the JSON value.
+func (t *WorkspaceSymbolLocation) UnmarshalJSON(b []byte) error {
+	if isNull(b) {
+		t.Value = nil
+		return nil
+	}
+	if hasFields(b, "uri", "range") {
+		var v Location
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	if hasFields(b, "uri") {
+		var v WorkspaceSymbolLocationUri
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot unmarshal %s into WorkspaceSymbolLocation", b)
+}
+
+// Or_Bool_SaveOptions holds a value of one of several types.
+type Or_Bool_SaveOptions struct {
+	// Value is one of bool, SaveOptions.
+	Value interface{}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t Or_Bool_SaveOptions) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.Value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. The type of the value is
+// determined by the shape of the JSON value.
+func (t *Or_Bool_SaveOptions) UnmarshalJSON(b []byte) error {
+	if isNull(b) {
+		t.Value = nil
+		return nil
+	}
+	if isBool(b) {
+		var v bool
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	if isObject(b) {
+		var v SaveOptions
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot unmarshal %s into Or_Bool_SaveOptions", b)
+}
+
+// NotebookDocumentSyncOptionsNotebookSelector holds a value of one of several types.
+type NotebookDocumentSyncOptionsNotebookSelector struct {
+	// Value is one of NotebookDocumentSyncOptionsNotebookSelectorNotebook, NotebookDocumentSyncOptionsNotebookSelectorCells.
+	Value interface{}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t NotebookDocumentSyncOptionsNotebookSelector) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.Value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. The type of the value is
+// determined by the shape of the JSON value.
+func (t *NotebookDocumentSyncOptionsNotebookSelector) UnmarshalJSON(b []byte) error {
+	if isNull(b) {
+		t.Value = nil
+		return nil
+	}
+	if hasFields(b, "notebook") {
+		var v NotebookDocumentSyncOptionsNotebookSelectorNotebook
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	if hasFields(b, "cells") {
+		var v NotebookDocumentSyncOptionsNotebookSelectorCells
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot unmarshal %s into NotebookDocumentSyncOptionsNotebookSelector", b)
+}
+
+// Or_String_NotebookDocumentFilter holds a value of one of several types.
+type Or_String_NotebookDocumentFilter struct {
+	// Value is one of string, NotebookDocumentFilter.
+	Value interface{}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t Or_String_NotebookDocumentFilter) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.Value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. The type of the value is
+// determined by the shape of the JSON value.
+func (t *Or_String_NotebookDocumentFilter) UnmarshalJSON(b []byte) error {
+	if isNull(b) {
+		t.Value = nil
+		return nil
+	}
+	if isString(b) {
+		var v string
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	{
+		var v NotebookDocumentFilter
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot unmarshal %s into Or_String_NotebookDocumentFilter", b)
+}
+
+// Or_MarkupContent_MarkedString_MarkedStringSlice holds a value of one of several types.
+type Or_MarkupContent_MarkedString_MarkedStringSlice struct {
+	// Value is one of MarkupContent, MarkedString, []MarkedString.
+	Value interface{}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t Or_MarkupContent_MarkedString_MarkedStringSlice) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.Value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. The type of the value is
+// determined by the shape of the JSON value.
+func (t *Or_MarkupContent_MarkedString_MarkedStringSlice) UnmarshalJSON(b []byte) error {
+	if isNull(b) {
+		t.Value = nil
+		return nil
+	}
+	if hasFields(b, "kind", "value") {
+		var v MarkupContent
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	{
+		var v MarkedString
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	if isArrayOf(b, func(b []byte) bool { return true }) {
+		var v []MarkedString
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot unmarshal %s into Or_MarkupContent_MarkedString_MarkedStringSlice", b)
+}
+
+// SemanticTokensOptionsRange holds a value of one of several types.
+type SemanticTokensOptionsRange struct {
+	// Value is one of bool, SemanticTokensOptionsRange2.
+	Value interface{}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t SemanticTokensOptionsRange) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.Value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. The type of the value is
+// determined by the shape of the JSON value.
+func (t *SemanticTokensOptionsRange) UnmarshalJSON(b []byte) error {
+	if isNull(b) {
+		t.Value = nil
+		return nil
+	}
+	if isBool(b) {
+		var v bool
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	if isObject(b) {
+		var v SemanticTokensOptionsRange2
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot unmarshal %s into SemanticTokensOptionsRange", b)
+}
+
+// SemanticTokensOptionsFull holds a value of one of several types.
+type SemanticTokensOptionsFull struct {
+	// Value is one of bool, SemanticTokensOptionsFull2.
+	Value interface{}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t SemanticTokensOptionsFull) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.Value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. The type of the value is
+// determined by the shape of the JSON value.
+func (t *SemanticTokensOptionsFull) UnmarshalJSON(b []byte) error {
+	if isNull(b) {
+		t.Value = nil
+		return nil
+	}
+	if isBool(b) {
+		var v bool
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	if isObject(b) {
+		var v SemanticTokensOptionsFull2
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot unmarshal %s into SemanticTokensOptionsFull", b)
+}
+
+// Or_String_MarkupContent holds a value of one of several types.
+type Or_String_MarkupContent struct {
+	// Value is one of MarkupContent, string.
+	Value interface{}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t Or_String_MarkupContent) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.Value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. The type of the value is
+// determined by the shape of the JSON value.
+func (t *Or_String_MarkupContent) UnmarshalJSON(b []byte) error {
+	if isNull(b) {
+		t.Value = nil
+		return nil
+	}
+	if hasFields(b, "kind", "value") {
+		var v MarkupContent
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	if isString(b) {
+		var v string
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot unmarshal %s into Or_String_MarkupContent", b)
+}
+
+// Or_String_InlayHintLabelPartSlice holds a value of one of several types.
+type Or_String_InlayHintLabelPartSlice struct {
+	// Value is one of []InlayHintLabelPart, string.
+	Value interface{}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t Or_String_InlayHintLabelPartSlice) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.Value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. The type of the value is
+// determined by the shape of the JSON value.
+func (t *Or_String_InlayHintLabelPartSlice) UnmarshalJSON(b []byte) error {
+	if isNull(b) {
+		t.Value = nil
+		return nil
+	}
+	if isArrayOf(b, func(b []byte) bool { return hasFields(b, "value") }) {
+		var v []InlayHintLabelPart
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	if isString(b) {
+		var v string
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot unmarshal %s into Or_String_InlayHintLabelPartSlice", b)
+}
+
+// Or_TextEdit_InsertReplaceEdit holds a value of one of several types.
+type Or_TextEdit_InsertReplaceEdit struct {
+	// Value is one of InsertReplaceEdit, TextEdit.
+	Value interface{}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t Or_TextEdit_InsertReplaceEdit) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.Value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. The type of the value is
+// determined by the shape of the JSON value.
+func (t *Or_TextEdit_InsertReplaceEdit) UnmarshalJSON(b []byte) error {
+	if isNull(b) {
+		t.Value = nil
+		return nil
+	}
+	if hasFields(b, "newText", "insert", "replace") {
+		var v InsertReplaceEdit
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	if hasFields(b, "range", "newText") {
+		var v TextEdit
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot unmarshal %s into Or_TextEdit_InsertReplaceEdit", b)
+}
+
+// CompletionListItemDefaultsEditRange holds a value of one of several types.
+type CompletionListItemDefaultsEditRange struct {
+	// Value is one of Range, CompletionListItemDefaultsEditRangeInsert.
+	Value interface{}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t CompletionListItemDefaultsEditRange) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.Value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. The type of the value is
+// determined by the shape of the JSON value.
+func (t *CompletionListItemDefaultsEditRange) UnmarshalJSON(b []byte) error {
+	if isNull(b) {
+		t.Value = nil
+		return nil
+	}
+	if hasFields(b, "start", "end") {
+		var v Range
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	if hasFields(b, "insert", "replace") {
+		var v CompletionListItemDefaultsEditRangeInsert
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot unmarshal %s into CompletionListItemDefaultsEditRange", b)
+}
+
+// Or_String_Uint32Array holds a value of one of several types.
+type Or_String_Uint32Array struct {
+	// Value is one of string, [2]uint32.
+	Value interface{}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t Or_String_Uint32Array) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.Value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. The type of the value is
+// determined by the shape of the JSON value.
+func (t *Or_String_Uint32Array) UnmarshalJSON(b []byte) error {
+	if isNull(b) {
+		t.Value = nil
+		return nil
+	}
+	if isString(b) {
+		var v string
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	if isArrayOf(b, func(b []byte) bool { return true }) {
+		var v [2]uint32
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot unmarshal %s into Or_String_Uint32Array", b)
+}
+
+// Or_FullDocumentDiagnosticReport_UnchangedDocumentDiagnosticReport holds a value of one of several types.
+type Or_FullDocumentDiagnosticReport_UnchangedDocumentDiagnosticReport struct {
+	// Value is one of FullDocumentDiagnosticReport, UnchangedDocumentDiagnosticReport.
+	Value interface{}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t Or_FullDocumentDiagnosticReport_UnchangedDocumentDiagnosticReport) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.Value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. The type of the value is
+// determined by the shape of the JSON value.
+func (t *Or_FullDocumentDiagnosticReport_UnchangedDocumentDiagnosticReport) UnmarshalJSON(b []byte) error {
+	if isNull(b) {
+		t.Value = nil
+		return nil
+	}
+	if hasFields(b, "kind", "items") && hasValue(b, "kind", "\"full\"") {
+		var v FullDocumentDiagnosticReport
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	if hasFields(b, "kind", "resultId") && hasValue(b, "kind", "\"unchanged\"") {
+		var v UnchangedDocumentDiagnosticReport
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot unmarshal %s into Or_FullDocumentDiagnosticReport_UnchangedDocumentDiagnosticReport", b)
+}
+
+// Or_Definition_DefinitionLinkSlice holds a value of one of several types.
+type Or_Definition_DefinitionLinkSlice struct {
+	// Value is one of []DefinitionLink, Definition.
+	Value interface{}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t Or_Definition_DefinitionLinkSlice) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.Value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. The type of the value is
+// determined by the shape of the JSON value.
+func (t *Or_Definition_DefinitionLinkSlice) UnmarshalJSON(b []byte) error {
+	if isNull(b) {
+		t.Value = nil
+		return nil
+	}
+	if isArrayOf(b, func(b []byte) bool { return hasFields(b, "targetUri", "targetRange", "targetSelectionRange") }) {
+		var v []DefinitionLink
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	{
+		var v Definition
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot unmarshal %s into Or_Definition_DefinitionLinkSlice", b)
+}
+
+// Or_LocationSlice_DefinitionLinkSlice holds a value of one of several types.
+type Or_LocationSlice_DefinitionLinkSlice struct {
+	// Value is one of []DefinitionLink, []Location.
+	Value interface{}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t Or_LocationSlice_DefinitionLinkSlice) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.Value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. The type of the value is
+// determined by the shape of the JSON value.
+func (t *Or_LocationSlice_DefinitionLinkSlice) UnmarshalJSON(b []byte) error {
+	if isNull(b) {
+		t.Value = nil
+		return nil
+	}
+	if isArrayOf(b, func(b []byte) bool { return hasFields(b, "targetUri", "targetRange", "targetSelectionRange") }) {
+		var v []DefinitionLink
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	if isArrayOf(b, func(b []byte) bool { return hasFields(b, "uri", "range") }) {
+		var v []Location
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot unmarshal %s into Or_LocationSlice_DefinitionLinkSlice", b)
+}
+
+// Or_Declaration_DeclarationLinkSlice holds a value of one of several types.
+type Or_Declaration_DeclarationLinkSlice struct {
+	// Value is one of []DeclarationLink, Declaration.
+	Value interface{}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t Or_Declaration_DeclarationLinkSlice) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.Value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. The type of the value is
+// determined by the shape of the JSON value.
+func (t *Or_Declaration_DeclarationLinkSlice) UnmarshalJSON(b []byte) error {
+	if isNull(b) {
+		t.Value = nil
+		return nil
+	}
+	if isArrayOf(b, func(b []byte) bool { return hasFields(b, "targetUri", "targetRange", "targetSelectionRange") }) {
+		var v []DeclarationLink
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	{
+		var v Declaration
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot unmarshal %s into Or_Declaration_DeclarationLinkSlice", b)
+}
+
+// Or_LocationSlice_DeclarationLinkSlice holds a value of one of several types.
+type Or_LocationSlice_DeclarationLinkSlice struct {
+	// Value is one of []DeclarationLink, []Location.
+	Value interface{}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t Or_LocationSlice_DeclarationLinkSlice) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.Value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. The type of the value is
+// determined by the shape of the JSON value.
+func (t *Or_LocationSlice_DeclarationLinkSlice) UnmarshalJSON(b []byte) error {
+	if isNull(b) {
+		t.Value = nil
+		return nil
+	}
+	if isArrayOf(b, func(b []byte) bool { return hasFields(b, "targetUri", "targetRange", "targetSelectionRange") }) {
+		var v []DeclarationLink
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	if isArrayOf(b, func(b []byte) bool { return hasFields(b, "uri", "range") }) {
+		var v []Location
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot unmarshal %s into Or_LocationSlice_DeclarationLinkSlice", b)
+}
+
+// Or_SemanticTokens_SemanticTokensDelta holds a value of one of several types.
+type Or_SemanticTokens_SemanticTokensDelta struct {
+	// Value is one of SemanticTokens, SemanticTokensDelta.
+	Value interface{}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t Or_SemanticTokens_SemanticTokensDelta) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.Value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. The type of the value is
+// determined by the shape of the JSON value.
+func (t *Or_SemanticTokens_SemanticTokensDelta) UnmarshalJSON(b []byte) error {
+	if isNull(b) {
+		t.Value = nil
+		return nil
+	}
+	if hasFields(b, "data") {
+		var v SemanticTokens
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	if hasFields(b, "edits") {
+		var v SemanticTokensDelta
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot unmarshal %s into Or_SemanticTokens_SemanticTokensDelta", b)
+}
+
+// Or_SemanticTokensPartialResult_SemanticTokensDeltaPartialResult holds a value of one of several types.
+type Or_SemanticTokensPartialResult_SemanticTokensDeltaPartialResult struct {
+	// Value is one of SemanticTokensPartialResult, SemanticTokensDeltaPartialResult.
+	Value interface{}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t Or_SemanticTokensPartialResult_SemanticTokensDeltaPartialResult) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.Value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. The type of the value is
+// determined by the shape of the JSON value.
+func (t *Or_SemanticTokensPartialResult_SemanticTokensDeltaPartialResult) UnmarshalJSON(b []byte) error {
+	if isNull(b) {
+		t.Value = nil
+		return nil
+	}
+	if hasFields(b, "data") {
+		var v SemanticTokensPartialResult
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	if hasFields(b, "edits") {
+		var v SemanticTokensDeltaPartialResult
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot unmarshal %s into Or_SemanticTokensPartialResult_SemanticTokensDeltaPartialResult", b)
+}
+
+// Or_CompletionItemSlice_CompletionList holds a value of one of several types.
+type Or_CompletionItemSlice_CompletionList struct {
+	// Value is one of CompletionList, []CompletionItem.
+	Value interface{}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t Or_CompletionItemSlice_CompletionList) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.Value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. The type of the value is
+// determined by the shape of the JSON value.
+func (t *Or_CompletionItemSlice_CompletionList) UnmarshalJSON(b []byte) error {
+	if isNull(b) {
+		t.Value = nil
+		return nil
+	}
+	if hasFields(b, "isIncomplete", "items") {
+		var v CompletionList
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	if isArrayOf(b, func(b []byte) bool { return hasFields(b, "label") }) {
+		var v []CompletionItem
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot unmarshal %s into Or_CompletionItemSlice_CompletionList", b)
+}
+
+// Or_SymbolInformationSlice_DocumentSymbolSlice holds a value of one of several types.
+type Or_SymbolInformationSlice_DocumentSymbolSlice struct {
+	// Value is one of []DocumentSymbol, []SymbolInformation.
+	Value interface{}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t Or_SymbolInformationSlice_DocumentSymbolSlice) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.Value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. The type of the value is
+// determined by the shape of the JSON value.
+func (t *Or_SymbolInformationSlice_DocumentSymbolSlice) UnmarshalJSON(b []byte) error {
+	if isNull(b) {
+		t.Value = nil
+		return nil
+	}
+	if isArrayOf(b, func(b []byte) bool { return hasFields(b, "name", "kind", "range", "selectionRange") }) {
+		var v []DocumentSymbol
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	if isArrayOf(b, func(b []byte) bool { return hasFields(b, "name", "kind", "location") }) {
+		var v []SymbolInformation
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot unmarshal %s into Or_SymbolInformationSlice_DocumentSymbolSlice", b)
+}
+
+// Or_Command_CodeAction holds a value of one of several types.
+type Or_Command_CodeAction struct {
+	// Value is one of Command, CodeAction.
+	Value interface{}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t Or_Command_CodeAction) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.Value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. The type of the value is
+// determined by the shape of the JSON value.
+func (t *Or_Command_CodeAction) UnmarshalJSON(b []byte) error {
+	if isNull(b) {
+		t.Value = nil
+		return nil
+	}
+	if hasFields(b, "title", "command") {
+		var v Command
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	if hasFields(b, "title") {
+		var v CodeAction
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot unmarshal %s into Or_Command_CodeAction", b)
+}
+
+// Or_SymbolInformationSlice_WorkspaceSymbolSlice holds a value of one of several types.
+type Or_SymbolInformationSlice_WorkspaceSymbolSlice struct {
+	// Value is one of []SymbolInformation, []WorkspaceSymbol.
+	Value interface{}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t Or_SymbolInformationSlice_WorkspaceSymbolSlice) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.Value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. The type of the value is
+// determined by the shape of the JSON value.
+func (t *Or_SymbolInformationSlice_WorkspaceSymbolSlice) UnmarshalJSON(b []byte) error {
+	if isNull(b) {
+		t.Value = nil
+		return nil
+	}
+	if isArrayOf(b, func(b []byte) bool { return hasFields(b, "name", "kind", "location") }) {
+		var v []SymbolInformation
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	if isArrayOf(b, func(b []byte) bool { return hasFields(b, "name", "kind", "location") }) {
+		var v []WorkspaceSymbol
+		if err := json.Unmarshal(b, &v); err == nil {
+			t.Value = v
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot unmarshal %s into Or_SymbolInformationSlice_WorkspaceSymbolSlice", b)
+}
+
+// firstByte returns the first non-whitespace byte of a JSON value.
+func firstByte(b []byte) byte {
+	for _, c := range b {
+		switch c {
+		case ' ', '\t', '\r', '\n':
+		default:
+			return c
+		}
+	}
+	return 0
+}
+
+func isNull(b []byte) bool   { return firstByte(b) == 'n' }
+func isString(b []byte) bool { return firstByte(b) == '"' }
+func isObject(b []byte) bool { return firstByte(b) == '{' }
+
+func isBool(b []byte) bool {
+	c := firstByte(b)
+	return c == 't' || c == 'f'
+}
+
+func isNumber(b []byte) bool {
+	c := firstByte(b)
+	return c == '-' || '0' <= c && c <= '9'
+}
+
+func isInteger(b []byte) bool {
+	return isNumber(b) && !bytes.ContainsAny(b, ".eE")
+}
+
+// isValue reports whether b is the JSON literal v.
+func isValue(b []byte, v string) bool {
+	return string(bytes.TrimSpace(b)) == v
+}
+
+// isArrayOf reports whether b is an array, which is either empty or whose
+// first element satisfies elem.
+func isArrayOf(b []byte, elem func(b []byte) bool) bool {
+	if firstByte(b) != '[' {
+		return false
+	}
+	var elems []json.RawMessage
+	if err := json.Unmarshal(b, &elems); err != nil {
+		return false
+	}
+	return len(elems) == 0 || elem(elems[0])
+}
+
+// hasFields reports whether b is an object containing all given fields.
+func hasFields(b []byte, fields ...string) bool {
+	if !isObject(b) {
+		return false
+	}
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return false
+	}
+	for _, f := range fields {
+		if _, ok := m[f]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// hasValue reports whether b is an object whose field is the JSON literal v.
+func hasValue(b []byte, field string, v string) bool {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return false
+	}
+	return isValue(m[field], v)
+}
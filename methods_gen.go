@@ -0,0 +1,1170 @@
+package lsp
+
+import "encoding/json"
+
+// MessageDirection is the direction in which a request or notification is
+// sent.
+type MessageDirection string
+
+const (
+	MessageDirectionClientToServer MessageDirection = "clientToServer"
+	MessageDirectionServerToClient MessageDirection = "serverToClient"
+	MessageDirectionBoth           MessageDirection = "both"
+)
+
+// Null is the type of absent parameters and results. It is encoded as JSON
+// null.
+type Null struct{}
+
+// MarshalJSON implements json.Marshaler.
+func (Null) MarshalJSON() ([]byte, error) {
+	return []byte("null"), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (*Null) UnmarshalJSON([]byte) error {
+	return nil
+}
+
+// The method names of all requests and notifications.
+const (
+
+	// A request to resolve the implementation locations of a symbol at a given text
+	// document position. The request's parameter is of type [TextDocumentPositionParams]
+	// (#TextDocumentPositionParams) the response is of type {@link Definition} or a
+	// Thenable that resolves to such.
+	MethodTextDocumentImplementation = "textDocument/implementation"
+
+	// A request to resolve the type definition locations of a symbol at a given text
+	// document position. The request's parameter is of type [TextDocumentPositionParams]
+	// (#TextDocumentPositionParams) the response is of type {@link Definition} or a
+	// Thenable that resolves to such.
+	MethodTextDocumentTypeDefinition = "textDocument/typeDefinition"
+
+	// The `workspace/workspaceFolders` is sent from the server to the client to fetch the open workspace folders.
+	MethodWorkspaceWorkspaceFolders = "workspace/workspaceFolders"
+
+	// The 'workspace/configuration' request is sent from the server to the client to fetch a certain
+	// configuration setting.
+	//
+	// This pull model replaces the old push model were the client signaled configuration change via an
+	// event. If the server still needs to react to configuration changes (since the server caches the
+	// result of `workspace/configuration` requests) the server should register for an empty configuration
+	// change event and empty the cache if such an event is received.
+	MethodWorkspaceConfiguration = "workspace/configuration"
+
+	// A request to list all color symbols found in a given text document. The request's
+	// parameter is of type {@link DocumentColorParams} the
+	// response is of type {@link ColorInformation ColorInformation[]} or a Thenable
+	// that resolves to such.
+	MethodTextDocumentDocumentColor = "textDocument/documentColor"
+
+	// A request to list all presentation for a color. The request's
+	// parameter is of type {@link ColorPresentationParams} the
+	// response is of type {@link ColorInformation ColorInformation[]} or a Thenable
+	// that resolves to such.
+	MethodTextDocumentColorPresentation = "textDocument/colorPresentation"
+
+	// A request to provide folding ranges in a document. The request's
+	// parameter is of type {@link FoldingRangeParams}, the
+	// response is of type {@link FoldingRangeList} or a Thenable
+	// that resolves to such.
+	MethodTextDocumentFoldingRange = "textDocument/foldingRange"
+
+	// A request to resolve the type definition locations of a symbol at a given text
+	// document position. The request's parameter is of type [TextDocumentPositionParams]
+	// (#TextDocumentPositionParams) the response is of type {@link Declaration}
+	// or a typed array of {@link DeclarationLink} or a Thenable that resolves
+	// to such.
+	MethodTextDocumentDeclaration = "textDocument/declaration"
+
+	// A request to provide selection ranges in a document. The request's
+	// parameter is of type {@link SelectionRangeParams}, the
+	// response is of type {@link SelectionRange SelectionRange[]} or a Thenable
+	// that resolves to such.
+	MethodTextDocumentSelectionRange = "textDocument/selectionRange"
+
+	// The `window/workDoneProgress/create` request is sent from the server to the client to initiate progress
+	// reporting from the server.
+	MethodWindowWorkDoneProgressCreate = "window/workDoneProgress/create"
+
+	// A request to result a `CallHierarchyItem` in a document at a given position.
+	// Can be used as an input to an incoming or outgoing call hierarchy.
+	//
+	// @since 3.16.0
+	MethodTextDocumentPrepareCallHierarchy = "textDocument/prepareCallHierarchy"
+
+	// A request to resolve the incoming calls for a given `CallHierarchyItem`.
+	//
+	// @since 3.16.0
+	MethodCallHierarchyIncomingCalls = "callHierarchy/incomingCalls"
+
+	// A request to resolve the outgoing calls for a given `CallHierarchyItem`.
+	//
+	// @since 3.16.0
+	MethodCallHierarchyOutgoingCalls = "callHierarchy/outgoingCalls"
+
+	// @since 3.16.0
+	MethodTextDocumentSemanticTokensFull = "textDocument/semanticTokens/full"
+
+	// @since 3.16.0
+	MethodTextDocumentSemanticTokensFullDelta = "textDocument/semanticTokens/full/delta"
+
+	// @since 3.16.0
+	MethodTextDocumentSemanticTokensRange = "textDocument/semanticTokens/range"
+
+	// @since 3.16.0
+	MethodWorkspaceSemanticTokensRefresh = "workspace/semanticTokens/refresh"
+
+	// A request to show a document. This request might open an
+	// external program depending on the value of the URI to open.
+	// For example a request to open `https://code.visualstudio.com/`
+	// will very likely open the URI in a WEB browser.
+	//
+	// @since 3.16.0
+	MethodWindowShowDocument = "window/showDocument"
+
+	// A request to provide ranges that can be edited together.
+	//
+	// @since 3.16.0
+	MethodTextDocumentLinkedEditingRange = "textDocument/linkedEditingRange"
+
+	// The will create files request is sent from the client to the server before files are actually
+	// created as long as the creation is triggered from within the client.
+	//
+	// @since 3.16.0
+	MethodWorkspaceWillCreateFiles = "workspace/willCreateFiles"
+
+	// The will rename files request is sent from the client to the server before files are actually
+	// renamed as long as the rename is triggered from within the client.
+	//
+	// @since 3.16.0
+	MethodWorkspaceWillRenameFiles = "workspace/willRenameFiles"
+
+	// The did delete files notification is sent from the client to the server when
+	// files were deleted from within the client.
+	//
+	// @since 3.16.0
+	MethodWorkspaceWillDeleteFiles = "workspace/willDeleteFiles"
+
+	// A request to get the moniker of a symbol at a given text document position.
+	// The request parameter is of type {@link TextDocumentPositionParams}.
+	// The response is of type {@link Moniker Moniker[]} or `null`.
+	MethodTextDocumentMoniker = "textDocument/moniker"
+
+	// A request to result a `TypeHierarchyItem` in a document at a given position.
+	// Can be used as an input to a subtypes or supertypes type hierarchy.
+	//
+	// @since 3.17.0
+	MethodTextDocumentPrepareTypeHierarchy = "textDocument/prepareTypeHierarchy"
+
+	// A request to resolve the supertypes for a given `TypeHierarchyItem`.
+	//
+	// @since 3.17.0
+	MethodTypeHierarchySupertypes = "typeHierarchy/supertypes"
+
+	// A request to resolve the subtypes for a given `TypeHierarchyItem`.
+	//
+	// @since 3.17.0
+	MethodTypeHierarchySubtypes = "typeHierarchy/subtypes"
+
+	// A request to provide inline values in a document. The request's parameter is of
+	// type {@link InlineValueParams}, the response is of type
+	// {@link InlineValue InlineValue[]} or a Thenable that resolves to such.
+	//
+	// @since 3.17.0
+	MethodTextDocumentInlineValue = "textDocument/inlineValue"
+
+	// @since 3.17.0
+	MethodWorkspaceInlineValueRefresh = "workspace/inlineValue/refresh"
+
+	// A request to provide inlay hints in a document. The request's parameter is of
+	// type {@link InlayHintsParams}, the response is of type
+	// {@link InlayHint InlayHint[]} or a Thenable that resolves to such.
+	//
+	// @since 3.17.0
+	MethodTextDocumentInlayHint = "textDocument/inlayHint"
+
+	// A request to resolve additional properties for an inlay hint.
+	// The request's parameter is of type {@link InlayHint}, the response is
+	// of type {@link InlayHint} or a Thenable that resolves to such.
+	//
+	// @since 3.17.0
+	MethodInlayHintResolve = "inlayHint/resolve"
+
+	// @since 3.17.0
+	MethodWorkspaceInlayHintRefresh = "workspace/inlayHint/refresh"
+
+	// The document diagnostic request definition.
+	//
+	// @since 3.17.0
+	MethodTextDocumentDiagnostic = "textDocument/diagnostic"
+
+	// The workspace diagnostic request definition.
+	//
+	// @since 3.17.0
+	MethodWorkspaceDiagnostic = "workspace/diagnostic"
+
+	// The diagnostic refresh request definition.
+	//
+	// @since 3.17.0
+	MethodWorkspaceDiagnosticRefresh = "workspace/diagnostic/refresh"
+
+	// The `client/registerCapability` request is sent from the server to the client to register a new capability
+	// handler on the client side.
+	MethodClientRegisterCapability = "client/registerCapability"
+
+	// The `client/unregisterCapability` request is sent from the server to the client to unregister a previously registered capability
+	// handler on the client side.
+	MethodClientUnregisterCapability = "client/unregisterCapability"
+
+	// The initialize request is sent from the client to the server.
+	// It is sent once as the request after starting up the server.
+	// The requests parameter is of type {@link InitializeParams}
+	// the response if of type {@link InitializeResult} of a Thenable that
+	// resolves to such.
+	MethodInitialize = "initialize"
+
+	// A shutdown request is sent from the client to the server.
+	// It is sent once when the client decides to shutdown the
+	// server. The only notification that is sent after a shutdown request
+	// is the exit event.
+	MethodShutdown = "shutdown"
+
+	// The show message request is sent from the server to the client to show a message
+	// and a set of options actions to the user.
+	MethodWindowShowMessageRequest = "window/showMessageRequest"
+
+	// A document will save request is sent from the client to the server before
+	// the document is actually saved. The request can return an array of TextEdits
+	// which will be applied to the text document before it is saved. Please note that
+	// clients might drop results if computing the text edits took too long or if a
+	// server constantly fails on this request. This is done to keep the save fast and
+	// reliable.
+	MethodTextDocumentWillSaveWaitUntil = "textDocument/willSaveWaitUntil"
+
+	// Request to request completion at a given text document position. The request's
+	// parameter is of type {@link TextDocumentPosition} the response
+	// is of type {@link CompletionItem CompletionItem[]} or {@link CompletionList}
+	// or a Thenable that resolves to such.
+	MethodTextDocumentCompletion = "textDocument/completion"
+
+	// Request to resolve additional information for a given completion item.The request's
+	// parameter is of type {@link CompletionItem} the response
+	// is of type {@link CompletionItem} or a Thenable that resolves to such.
+	MethodCompletionItemResolve = "completionItem/resolve"
+
+	// Request to request hover information at a given text document position. The request's
+	// parameter is of type {@link TextDocumentPosition} the response is of
+	// type {@link Hover} or a Thenable that resolves to such.
+	MethodTextDocumentHover         = "textDocument/hover"
+	MethodTextDocumentSignatureHelp = "textDocument/signatureHelp"
+
+	// A request to resolve the definition location of a symbol at a given text
+	// document position. The request's parameter is of type [TextDocumentPosition]
+	// (#TextDocumentPosition) the response is of either type {@link Definition}
+	// or a typed array of {@link DefinitionLink} or a Thenable that resolves
+	// to such.
+	MethodTextDocumentDefinition = "textDocument/definition"
+
+	// A request to resolve project-wide references for the symbol denoted
+	// by the given text document position. The request's parameter is of
+	// type {@link ReferenceParams} the response is of type
+	// {@link Location Location[]} or a Thenable that resolves to such.
+	MethodTextDocumentReferences = "textDocument/references"
+
+	// Request to resolve a {@link DocumentHighlight} for a given
+	// text document position. The request's parameter is of type [TextDocumentPosition]
+	// (#TextDocumentPosition) the request response is of type [DocumentHighlight[]]
+	// (#DocumentHighlight) or a Thenable that resolves to such.
+	MethodTextDocumentDocumentHighlight = "textDocument/documentHighlight"
+
+	// A request to list all symbols found in a given text document. The request's
+	// parameter is of type {@link TextDocumentIdentifier} the
+	// response is of type {@link SymbolInformation SymbolInformation[]} or a Thenable
+	// that resolves to such.
+	MethodTextDocumentDocumentSymbol = "textDocument/documentSymbol"
+
+	// A request to provide commands for the given text document and range.
+	MethodTextDocumentCodeAction = "textDocument/codeAction"
+
+	// Request to resolve additional information for a given code action.The request's
+	// parameter is of type {@link CodeAction} the response
+	// is of type {@link CodeAction} or a Thenable that resolves to such.
+	MethodCodeActionResolve = "codeAction/resolve"
+
+	// A request to list project-wide symbols matching the query string given
+	// by the {@link WorkspaceSymbolParams}. The response is
+	// of type {@link SymbolInformation SymbolInformation[]} or a Thenable that
+	// resolves to such.
+	//
+	// @since 3.17.0 - support for WorkspaceSymbol in the returned data. Clients
+	//  need to advertise support for WorkspaceSymbols via the client capability
+	//  `workspace.symbol.resolveSupport`.
+	MethodWorkspaceSymbol = "workspace/symbol"
+
+	// A request to resolve the range inside the workspace
+	// symbol's location.
+	//
+	// @since 3.17.0
+	MethodWorkspaceSymbolResolve = "workspaceSymbol/resolve"
+
+	// A request to provide code lens for the given text document.
+	MethodTextDocumentCodeLens = "textDocument/codeLens"
+
+	// A request to resolve a command for a given code lens.
+	MethodCodeLensResolve = "codeLens/resolve"
+
+	// A request to refresh all code actions
+	//
+	// @since 3.16.0
+	MethodWorkspaceCodeLensRefresh = "workspace/codeLens/refresh"
+
+	// A request to provide document links
+	MethodTextDocumentDocumentLink = "textDocument/documentLink"
+
+	// Request to resolve additional information for a given document link. The request's
+	// parameter is of type {@link DocumentLink} the response
+	// is of type {@link DocumentLink} or a Thenable that resolves to such.
+	MethodDocumentLinkResolve = "documentLink/resolve"
+
+	// A request to to format a whole document.
+	MethodTextDocumentFormatting = "textDocument/formatting"
+
+	// A request to to format a range in a document.
+	MethodTextDocumentRangeFormatting = "textDocument/rangeFormatting"
+
+	// A request to format a document on type.
+	MethodTextDocumentOnTypeFormatting = "textDocument/onTypeFormatting"
+
+	// A request to rename a symbol.
+	MethodTextDocumentRename = "textDocument/rename"
+
+	// A request to test and perform the setup necessary for a rename.
+	//
+	// @since 3.16 - support for default behavior
+	MethodTextDocumentPrepareRename = "textDocument/prepareRename"
+
+	// A request send from the client to the server to execute a command. The request might return
+	// a workspace edit which the client will apply to the workspace.
+	MethodWorkspaceExecuteCommand = "workspace/executeCommand"
+
+	// A request sent from the server to the client to modified certain resources.
+	MethodWorkspaceApplyEdit = "workspace/applyEdit"
+
+	// The `workspace/didChangeWorkspaceFolders` notification is sent from the client to the server when the workspace
+	// folder configuration changes.
+	MethodWorkspaceDidChangeWorkspaceFolders = "workspace/didChangeWorkspaceFolders"
+
+	// The `window/workDoneProgress/cancel` notification is sent from  the client to the server to cancel a progress
+	// initiated on the server side.
+	MethodWindowWorkDoneProgressCancel = "window/workDoneProgress/cancel"
+
+	// The did create files notification is sent from the client to the server when
+	// files were created from within the client.
+	//
+	// @since 3.16.0
+	MethodWorkspaceDidCreateFiles = "workspace/didCreateFiles"
+
+	// The did rename files notification is sent from the client to the server when
+	// files were renamed from within the client.
+	//
+	// @since 3.16.0
+	MethodWorkspaceDidRenameFiles = "workspace/didRenameFiles"
+
+	// The will delete files request is sent from the client to the server before files are actually
+	// deleted as long as the deletion is triggered from within the client.
+	//
+	// @since 3.16.0
+	MethodWorkspaceDidDeleteFiles = "workspace/didDeleteFiles"
+
+	// A notification sent when a notebook opens.
+	//
+	// @since 3.17.0
+	MethodNotebookDocumentDidOpen   = "notebookDocument/didOpen"
+	MethodNotebookDocumentDidChange = "notebookDocument/didChange"
+
+	// A notification sent when a notebook document is saved.
+	//
+	// @since 3.17.0
+	MethodNotebookDocumentDidSave = "notebookDocument/didSave"
+
+	// A notification sent when a notebook closes.
+	//
+	// @since 3.17.0
+	MethodNotebookDocumentDidClose = "notebookDocument/didClose"
+
+	// The initialized notification is sent from the client to the
+	// server after the client is fully initialized and the server
+	// is allowed to send requests from the server to the client.
+	MethodInitialized = "initialized"
+
+	// The exit event is sent from the client to the server to
+	// ask the server to exit its process.
+	MethodExit = "exit"
+
+	// The configuration change notification is sent from the client to the server
+	// when the client's configuration has changed. The notification contains
+	// the changed configuration as defined by the language client.
+	MethodWorkspaceDidChangeConfiguration = "workspace/didChangeConfiguration"
+
+	// The show message notification is sent from a server to a client to ask
+	// the client to display a particular message in the user interface.
+	MethodWindowShowMessage = "window/showMessage"
+
+	// The log message notification is sent from the server to the client to ask
+	// the client to log a particular message.
+	MethodWindowLogMessage = "window/logMessage"
+
+	// The telemetry event notification is sent from the server to the client to ask
+	// the client to log telemetry data.
+	MethodTelemetryEvent = "telemetry/event"
+
+	// The document open notification is sent from the client to the server to signal
+	// newly opened text documents. The document's truth is now managed by the client
+	// and the server must not try to read the document's truth using the document's
+	// uri. Open in this sense means it is managed by the client. It doesn't necessarily
+	// mean that its content is presented in an editor. An open notification must not
+	// be sent more than once without a corresponding close notification send before.
+	// This means open and close notification must be balanced and the max open count
+	// is one.
+	MethodTextDocumentDidOpen = "textDocument/didOpen"
+
+	// The document change notification is sent from the client to the server to signal
+	// changes to a text document.
+	MethodTextDocumentDidChange = "textDocument/didChange"
+
+	// The document close notification is sent from the client to the server when
+	// the document got closed in the client. The document's truth now exists where
+	// the document's uri points to (e.g. if the document's uri is a file uri the
+	// truth now exists on disk). As with the open notification the close notification
+	// is about managing the document's content. Receiving a close notification
+	// doesn't mean that the document was open in an editor before. A close
+	// notification requires a previous open notification to be sent.
+	MethodTextDocumentDidClose = "textDocument/didClose"
+
+	// The document save notification is sent from the client to the server when
+	// the document got saved in the client.
+	MethodTextDocumentDidSave = "textDocument/didSave"
+
+	// A document will save notification is sent from the client to the server before
+	// the document is actually saved.
+	MethodTextDocumentWillSave = "textDocument/willSave"
+
+	// The watched files notification is sent from the client to the server when
+	// the client detects changes to file watched by the language client.
+	MethodWorkspaceDidChangeWatchedFiles = "workspace/didChangeWatchedFiles"
+
+	// Diagnostics notification are sent from the server to the client to signal
+	// results of validation runs.
+	MethodTextDocumentPublishDiagnostics = "textDocument/publishDiagnostics"
+	MethodSetTrace                       = "$/setTrace"
+	MethodLogTrace                       = "$/logTrace"
+	MethodCancelRequest                  = "$/cancelRequest"
+	MethodProgress                       = "$/progress"
+)
+
+// A RequestType describes a request and the Go types of its parameters,
+// result, partial result, error data and registration options.
+type RequestType[Params, Result, PartialResult, ErrorData, RegistrationOptions any] struct {
+	// Method is the method name of the request.
+	Method string
+
+	// RegistrationMethod is the method used to register the request
+	// dynamically.
+	RegistrationMethod string
+
+	// Direction is the direction in which the request is sent.
+	Direction MessageDirection
+}
+
+// DecodeParams decodes the parameters of the request.
+func (RequestType[P, R, PR, E, O]) DecodeParams(b json.RawMessage) (P, error) {
+	return decode[P](b)
+}
+
+// DecodeResult decodes the result of the request.
+func (RequestType[P, R, PR, E, O]) DecodeResult(b json.RawMessage) (R, error) {
+	return decode[R](b)
+}
+
+// DecodePartialResult decodes a partial result of the request.
+func (RequestType[P, R, PR, E, O]) DecodePartialResult(b json.RawMessage) (PR, error) {
+	return decode[PR](b)
+}
+
+// DecodeErrorData decodes the data of an error response to the request.
+func (RequestType[P, R, PR, E, O]) DecodeErrorData(b json.RawMessage) (E, error) {
+	return decode[E](b)
+}
+
+// DecodeRegistrationOptions decodes the options of a registration of the
+// request.
+func (RequestType[P, R, PR, E, O]) DecodeRegistrationOptions(b json.RawMessage) (O, error) {
+	return decode[O](b)
+}
+
+// A NotificationType describes a notification and the Go types of its
+// parameters and registration options.
+type NotificationType[Params, RegistrationOptions any] struct {
+	// Method is the method name of the notification.
+	Method string
+
+	// RegistrationMethod is the method used to register the notification
+	// dynamically.
+	RegistrationMethod string
+
+	// Direction is the direction in which the notification is sent.
+	Direction MessageDirection
+}
+
+// DecodeParams decodes the parameters of the notification.
+func (NotificationType[P, O]) DecodeParams(b json.RawMessage) (P, error) {
+	return decode[P](b)
+}
+
+// DecodeRegistrationOptions decodes the options of a registration of the
+// notification.
+func (NotificationType[P, O]) DecodeRegistrationOptions(b json.RawMessage) (O, error) {
+	return decode[O](b)
+}
+
+// decode decodes a JSON value. Missing values are decoded as zero value.
+func decode[T any](b json.RawMessage) (T, error) {
+	var v T
+	if len(b) == 0 {
+		return v, nil
+	}
+	err := json.Unmarshal(b, &v)
+	return v, err
+}
+
+// TextDocumentImplementationRequest describes the textDocument/implementation request.
+var TextDocumentImplementationRequest = RequestType[*ImplementationParams, *Or_Definition_DefinitionLinkSlice, *Or_LocationSlice_DefinitionLinkSlice, Null, *ImplementationRegistrationOptions]{
+	Method:             MethodTextDocumentImplementation,
+	RegistrationMethod: "textDocument/implementation",
+	Direction:          MessageDirectionClientToServer,
+}
+
+// TextDocumentTypeDefinitionRequest describes the textDocument/typeDefinition request.
+var TextDocumentTypeDefinitionRequest = RequestType[*TypeDefinitionParams, *Or_Definition_DefinitionLinkSlice, *Or_LocationSlice_DefinitionLinkSlice, Null, *TypeDefinitionRegistrationOptions]{
+	Method:             MethodTextDocumentTypeDefinition,
+	RegistrationMethod: "textDocument/typeDefinition",
+	Direction:          MessageDirectionClientToServer,
+}
+
+// WorkspaceWorkspaceFoldersRequest describes the workspace/workspaceFolders request.
+var WorkspaceWorkspaceFoldersRequest = RequestType[Null, []WorkspaceFolder, Null, Null, Null]{
+	Method:             MethodWorkspaceWorkspaceFolders,
+	RegistrationMethod: "workspace/workspaceFolders",
+	Direction:          MessageDirectionServerToClient,
+}
+
+// WorkspaceConfigurationRequest describes the workspace/configuration request.
+var WorkspaceConfigurationRequest = RequestType[*And_ConfigurationParams_PartialResultParams, []interface{}, Null, Null, Null]{
+	Method:             MethodWorkspaceConfiguration,
+	RegistrationMethod: "workspace/configuration",
+	Direction:          MessageDirectionServerToClient,
+}
+
+// TextDocumentDocumentColorRequest describes the textDocument/documentColor request.
+var TextDocumentDocumentColorRequest = RequestType[*DocumentColorParams, []ColorInformation, []ColorInformation, Null, *DocumentColorRegistrationOptions]{
+	Method:             MethodTextDocumentDocumentColor,
+	RegistrationMethod: "textDocument/documentColor",
+	Direction:          MessageDirectionClientToServer,
+}
+
+// TextDocumentColorPresentationRequest describes the textDocument/colorPresentation request.
+var TextDocumentColorPresentationRequest = RequestType[*ColorPresentationParams, []ColorPresentation, []ColorPresentation, Null, *And_WorkDoneProgressOptions_TextDocumentRegistrationOptions]{
+	Method:             MethodTextDocumentColorPresentation,
+	RegistrationMethod: "textDocument/colorPresentation",
+	Direction:          MessageDirectionClientToServer,
+}
+
+// TextDocumentFoldingRangeRequest describes the textDocument/foldingRange request.
+var TextDocumentFoldingRangeRequest = RequestType[*FoldingRangeParams, []FoldingRange, []FoldingRange, Null, *FoldingRangeRegistrationOptions]{
+	Method:             MethodTextDocumentFoldingRange,
+	RegistrationMethod: "textDocument/foldingRange",
+	Direction:          MessageDirectionClientToServer,
+}
+
+// TextDocumentDeclarationRequest describes the textDocument/declaration request.
+var TextDocumentDeclarationRequest = RequestType[*DeclarationParams, *Or_Declaration_DeclarationLinkSlice, *Or_LocationSlice_DeclarationLinkSlice, Null, *DeclarationRegistrationOptions]{
+	Method:             MethodTextDocumentDeclaration,
+	RegistrationMethod: "textDocument/declaration",
+	Direction:          MessageDirectionClientToServer,
+}
+
+// TextDocumentSelectionRangeRequest describes the textDocument/selectionRange request.
+var TextDocumentSelectionRangeRequest = RequestType[*SelectionRangeParams, []SelectionRange, []SelectionRange, Null, *SelectionRangeRegistrationOptions]{
+	Method:             MethodTextDocumentSelectionRange,
+	RegistrationMethod: "textDocument/selectionRange",
+	Direction:          MessageDirectionClientToServer,
+}
+
+// WindowWorkDoneProgressCreateRequest describes the window/workDoneProgress/create request.
+var WindowWorkDoneProgressCreateRequest = RequestType[*WorkDoneProgressCreateParams, Null, Null, Null, Null]{
+	Method:             MethodWindowWorkDoneProgressCreate,
+	RegistrationMethod: "window/workDoneProgress/create",
+	Direction:          MessageDirectionServerToClient,
+}
+
+// TextDocumentPrepareCallHierarchyRequest describes the textDocument/prepareCallHierarchy request.
+var TextDocumentPrepareCallHierarchyRequest = RequestType[*CallHierarchyPrepareParams, []CallHierarchyItem, Null, Null, *CallHierarchyRegistrationOptions]{
+	Method:             MethodTextDocumentPrepareCallHierarchy,
+	RegistrationMethod: "textDocument/prepareCallHierarchy",
+	Direction:          MessageDirectionClientToServer,
+}
+
+// CallHierarchyIncomingCallsRequest describes the callHierarchy/incomingCalls request.
+var CallHierarchyIncomingCallsRequest = RequestType[*CallHierarchyIncomingCallsParams, []CallHierarchyIncomingCall, []CallHierarchyIncomingCall, Null, Null]{
+	Method:             MethodCallHierarchyIncomingCalls,
+	RegistrationMethod: "callHierarchy/incomingCalls",
+	Direction:          MessageDirectionClientToServer,
+}
+
+// CallHierarchyOutgoingCallsRequest describes the callHierarchy/outgoingCalls request.
+var CallHierarchyOutgoingCallsRequest = RequestType[*CallHierarchyOutgoingCallsParams, []CallHierarchyOutgoingCall, []CallHierarchyOutgoingCall, Null, Null]{
+	Method:             MethodCallHierarchyOutgoingCalls,
+	RegistrationMethod: "callHierarchy/outgoingCalls",
+	Direction:          MessageDirectionClientToServer,
+}
+
+// TextDocumentSemanticTokensFullRequest describes the textDocument/semanticTokens/full request.
+var TextDocumentSemanticTokensFullRequest = RequestType[*SemanticTokensParams, *SemanticTokens, *SemanticTokensPartialResult, Null, *SemanticTokensRegistrationOptions]{
+	Method:             MethodTextDocumentSemanticTokensFull,
+	RegistrationMethod: "textDocument/semanticTokens",
+	Direction:          MessageDirectionClientToServer,
+}
+
+// TextDocumentSemanticTokensFullDeltaRequest describes the textDocument/semanticTokens/full/delta request.
+var TextDocumentSemanticTokensFullDeltaRequest = RequestType[*SemanticTokensDeltaParams, *Or_SemanticTokens_SemanticTokensDelta, *Or_SemanticTokensPartialResult_SemanticTokensDeltaPartialResult, Null, *SemanticTokensRegistrationOptions]{
+	Method:             MethodTextDocumentSemanticTokensFullDelta,
+	RegistrationMethod: "textDocument/semanticTokens",
+	Direction:          MessageDirectionClientToServer,
+}
+
+// TextDocumentSemanticTokensRangeRequest describes the textDocument/semanticTokens/range request.
+var TextDocumentSemanticTokensRangeRequest = RequestType[*SemanticTokensRangeParams, *SemanticTokens, *SemanticTokensPartialResult, Null, Null]{
+	Method:             MethodTextDocumentSemanticTokensRange,
+	RegistrationMethod: "textDocument/semanticTokens",
+	Direction:          MessageDirectionClientToServer,
+}
+
+// WorkspaceSemanticTokensRefreshRequest describes the workspace/semanticTokens/refresh request.
+var WorkspaceSemanticTokensRefreshRequest = RequestType[Null, Null, Null, Null, Null]{
+	Method:             MethodWorkspaceSemanticTokensRefresh,
+	RegistrationMethod: "workspace/semanticTokens/refresh",
+	Direction:          MessageDirectionServerToClient,
+}
+
+// WindowShowDocumentRequest describes the window/showDocument request.
+var WindowShowDocumentRequest = RequestType[*ShowDocumentParams, *ShowDocumentResult, Null, Null, Null]{
+	Method:             MethodWindowShowDocument,
+	RegistrationMethod: "window/showDocument",
+	Direction:          MessageDirectionServerToClient,
+}
+
+// TextDocumentLinkedEditingRangeRequest describes the textDocument/linkedEditingRange request.
+var TextDocumentLinkedEditingRangeRequest = RequestType[*LinkedEditingRangeParams, *LinkedEditingRanges, Null, Null, *LinkedEditingRangeRegistrationOptions]{
+	Method:             MethodTextDocumentLinkedEditingRange,
+	RegistrationMethod: "textDocument/linkedEditingRange",
+	Direction:          MessageDirectionClientToServer,
+}
+
+// WorkspaceWillCreateFilesRequest describes the workspace/willCreateFiles request.
+var WorkspaceWillCreateFilesRequest = RequestType[*CreateFilesParams, *WorkspaceEdit, Null, Null, *FileOperationRegistrationOptions]{
+	Method:             MethodWorkspaceWillCreateFiles,
+	RegistrationMethod: "workspace/willCreateFiles",
+	Direction:          MessageDirectionClientToServer,
+}
+
+// WorkspaceWillRenameFilesRequest describes the workspace/willRenameFiles request.
+var WorkspaceWillRenameFilesRequest = RequestType[*RenameFilesParams, *WorkspaceEdit, Null, Null, *FileOperationRegistrationOptions]{
+	Method:             MethodWorkspaceWillRenameFiles,
+	RegistrationMethod: "workspace/willRenameFiles",
+	Direction:          MessageDirectionClientToServer,
+}
+
+// WorkspaceWillDeleteFilesRequest describes the workspace/willDeleteFiles request.
+var WorkspaceWillDeleteFilesRequest = RequestType[*DeleteFilesParams, *WorkspaceEdit, Null, Null, *FileOperationRegistrationOptions]{
+	Method:             MethodWorkspaceWillDeleteFiles,
+	RegistrationMethod: "workspace/willDeleteFiles",
+	Direction:          MessageDirectionClientToServer,
+}
+
+// TextDocumentMonikerRequest describes the textDocument/moniker request.
+var TextDocumentMonikerRequest = RequestType[*MonikerParams, []Moniker, []Moniker, Null, *MonikerRegistrationOptions]{
+	Method:             MethodTextDocumentMoniker,
+	RegistrationMethod: "textDocument/moniker",
+	Direction:          MessageDirectionClientToServer,
+}
+
+// TextDocumentPrepareTypeHierarchyRequest describes the textDocument/prepareTypeHierarchy request.
+var TextDocumentPrepareTypeHierarchyRequest = RequestType[*TypeHierarchyPrepareParams, []TypeHierarchyItem, Null, Null, *TypeHierarchyRegistrationOptions]{
+	Method:             MethodTextDocumentPrepareTypeHierarchy,
+	RegistrationMethod: "textDocument/prepareTypeHierarchy",
+	Direction:          MessageDirectionClientToServer,
+}
+
+// TypeHierarchySupertypesRequest describes the typeHierarchy/supertypes request.
+var TypeHierarchySupertypesRequest = RequestType[*TypeHierarchySupertypesParams, []TypeHierarchyItem, []TypeHierarchyItem, Null, Null]{
+	Method:             MethodTypeHierarchySupertypes,
+	RegistrationMethod: "typeHierarchy/supertypes",
+	Direction:          MessageDirectionClientToServer,
+}
+
+// TypeHierarchySubtypesRequest describes the typeHierarchy/subtypes request.
+var TypeHierarchySubtypesRequest = RequestType[*TypeHierarchySubtypesParams, []TypeHierarchyItem, []TypeHierarchyItem, Null, Null]{
+	Method:             MethodTypeHierarchySubtypes,
+	RegistrationMethod: "typeHierarchy/subtypes",
+	Direction:          MessageDirectionClientToServer,
+}
+
+// TextDocumentInlineValueRequest describes the textDocument/inlineValue request.
+var TextDocumentInlineValueRequest = RequestType[*InlineValueParams, []InlineValue, []InlineValue, Null, *InlineValueRegistrationOptions]{
+	Method:             MethodTextDocumentInlineValue,
+	RegistrationMethod: "textDocument/inlineValue",
+	Direction:          MessageDirectionClientToServer,
+}
+
+// WorkspaceInlineValueRefreshRequest describes the workspace/inlineValue/refresh request.
+var WorkspaceInlineValueRefreshRequest = RequestType[Null, Null, Null, Null, Null]{
+	Method:             MethodWorkspaceInlineValueRefresh,
+	RegistrationMethod: "workspace/inlineValue/refresh",
+	Direction:          MessageDirectionServerToClient,
+}
+
+// TextDocumentInlayHintRequest describes the textDocument/inlayHint request.
+var TextDocumentInlayHintRequest = RequestType[*InlayHintParams, []InlayHint, []InlayHint, Null, *InlayHintRegistrationOptions]{
+	Method:             MethodTextDocumentInlayHint,
+	RegistrationMethod: "textDocument/inlayHint",
+	Direction:          MessageDirectionClientToServer,
+}
+
+// InlayHintResolveRequest describes the inlayHint/resolve request.
+var InlayHintResolveRequest = RequestType[*InlayHint, *InlayHint, Null, Null, Null]{
+	Method:             MethodInlayHintResolve,
+	RegistrationMethod: "inlayHint/resolve",
+	Direction:          MessageDirectionClientToServer,
+}
+
+// WorkspaceInlayHintRefreshRequest describes the workspace/inlayHint/refresh request.
+var WorkspaceInlayHintRefreshRequest = RequestType[Null, Null, Null, Null, Null]{
+	Method:             MethodWorkspaceInlayHintRefresh,
+	RegistrationMethod: "workspace/inlayHint/refresh",
+	Direction:          MessageDirectionServerToClient,
+}
+
+// TextDocumentDiagnosticRequest describes the textDocument/diagnostic request.
+var TextDocumentDiagnosticRequest = RequestType[*DocumentDiagnosticParams, *DocumentDiagnosticReport, *DocumentDiagnosticReportPartialResult, *DiagnosticServerCancellationData, *DiagnosticRegistrationOptions]{
+	Method:             MethodTextDocumentDiagnostic,
+	RegistrationMethod: "textDocument/diagnostic",
+	Direction:          MessageDirectionClientToServer,
+}
+
+// WorkspaceDiagnosticRequest describes the workspace/diagnostic request.
+var WorkspaceDiagnosticRequest = RequestType[*WorkspaceDiagnosticParams, *WorkspaceDiagnosticReport, *WorkspaceDiagnosticReportPartialResult, *DiagnosticServerCancellationData, Null]{
+	Method:             MethodWorkspaceDiagnostic,
+	RegistrationMethod: "workspace/diagnostic",
+	Direction:          MessageDirectionClientToServer,
+}
+
+// WorkspaceDiagnosticRefreshRequest describes the workspace/diagnostic/refresh request.
+var WorkspaceDiagnosticRefreshRequest = RequestType[Null, Null, Null, Null, Null]{
+	Method:             MethodWorkspaceDiagnosticRefresh,
+	RegistrationMethod: "workspace/diagnostic/refresh",
+	Direction:          MessageDirectionServerToClient,
+}
+
+// ClientRegisterCapabilityRequest describes the client/registerCapability request.
+var ClientRegisterCapabilityRequest = RequestType[*RegistrationParams, Null, Null, Null, Null]{
+	Method:             MethodClientRegisterCapability,
+	RegistrationMethod: "client/registerCapability",
+	Direction:          MessageDirectionServerToClient,
+}
+
+// ClientUnregisterCapabilityRequest describes the client/unregisterCapability request.
+var ClientUnregisterCapabilityRequest = RequestType[*UnregistrationParams, Null, Null, Null, Null]{
+	Method:             MethodClientUnregisterCapability,
+	RegistrationMethod: "client/unregisterCapability",
+	Direction:          MessageDirectionServerToClient,
+}
+
+// InitializeRequest describes the initialize request.
+var InitializeRequest = RequestType[*InitializeParams, *InitializeResult, Null, *InitializeError, Null]{
+	Method:             MethodInitialize,
+	RegistrationMethod: "initialize",
+	Direction:          MessageDirectionClientToServer,
+}
+
+// ShutdownRequest describes the shutdown request.
+var ShutdownRequest = RequestType[Null, Null, Null, Null, Null]{
+	Method:             MethodShutdown,
+	RegistrationMethod: "shutdown",
+	Direction:          MessageDirectionClientToServer,
+}
+
+// WindowShowMessageRequestRequest describes the window/showMessageRequest request.
+var WindowShowMessageRequestRequest = RequestType[*ShowMessageRequestParams, *MessageActionItem, Null, Null, Null]{
+	Method:             MethodWindowShowMessageRequest,
+	RegistrationMethod: "window/showMessageRequest",
+	Direction:          MessageDirectionServerToClient,
+}
+
+// TextDocumentWillSaveWaitUntilRequest describes the textDocument/willSaveWaitUntil request.
+var TextDocumentWillSaveWaitUntilRequest = RequestType[*WillSaveTextDocumentParams, []TextEdit, Null, Null, *TextDocumentRegistrationOptions]{
+	Method:             MethodTextDocumentWillSaveWaitUntil,
+	RegistrationMethod: "textDocument/willSaveWaitUntil",
+	Direction:          MessageDirectionClientToServer,
+}
+
+// TextDocumentCompletionRequest describes the textDocument/completion request.
+var TextDocumentCompletionRequest = RequestType[*CompletionParams, *Or_CompletionItemSlice_CompletionList, []CompletionItem, Null, *CompletionRegistrationOptions]{
+	Method:             MethodTextDocumentCompletion,
+	RegistrationMethod: "textDocument/completion",
+	Direction:          MessageDirectionClientToServer,
+}
+
+// CompletionItemResolveRequest describes the completionItem/resolve request.
+var CompletionItemResolveRequest = RequestType[*CompletionItem, *CompletionItem, Null, Null, Null]{
+	Method:             MethodCompletionItemResolve,
+	RegistrationMethod: "completionItem/resolve",
+	Direction:          MessageDirectionClientToServer,
+}
+
+// TextDocumentHoverRequest describes the textDocument/hover request.
+var TextDocumentHoverRequest = RequestType[*HoverParams, *Hover, Null, Null, *HoverRegistrationOptions]{
+	Method:             MethodTextDocumentHover,
+	RegistrationMethod: "textDocument/hover",
+	Direction:          MessageDirectionClientToServer,
+}
+
+// TextDocumentSignatureHelpRequest describes the textDocument/signatureHelp request.
+var TextDocumentSignatureHelpRequest = RequestType[*SignatureHelpParams, *SignatureHelp, Null, Null, *SignatureHelpRegistrationOptions]{
+	Method:             MethodTextDocumentSignatureHelp,
+	RegistrationMethod: "textDocument/signatureHelp",
+	Direction:          MessageDirectionClientToServer,
+}
+
+// TextDocumentDefinitionRequest describes the textDocument/definition request.
+var TextDocumentDefinitionRequest = RequestType[*DefinitionParams, *Or_Definition_DefinitionLinkSlice, *Or_LocationSlice_DefinitionLinkSlice, Null, *DefinitionRegistrationOptions]{
+	Method:             MethodTextDocumentDefinition,
+	RegistrationMethod: "textDocument/definition",
+	Direction:          MessageDirectionClientToServer,
+}
+
+// TextDocumentReferencesRequest describes the textDocument/references request.
+var TextDocumentReferencesRequest = RequestType[*ReferenceParams, []Location, []Location, Null, *ReferenceRegistrationOptions]{
+	Method:             MethodTextDocumentReferences,
+	RegistrationMethod: "textDocument/references",
+	Direction:          MessageDirectionClientToServer,
+}
+
+// TextDocumentDocumentHighlightRequest describes the textDocument/documentHighlight request.
+var TextDocumentDocumentHighlightRequest = RequestType[*DocumentHighlightParams, []DocumentHighlight, []DocumentHighlight, Null, *DocumentHighlightRegistrationOptions]{
+	Method:             MethodTextDocumentDocumentHighlight,
+	RegistrationMethod: "textDocument/documentHighlight",
+	Direction:          MessageDirectionClientToServer,
+}
+
+// TextDocumentDocumentSymbolRequest describes the textDocument/documentSymbol request.
+var TextDocumentDocumentSymbolRequest = RequestType[*DocumentSymbolParams, *Or_SymbolInformationSlice_DocumentSymbolSlice, *Or_SymbolInformationSlice_DocumentSymbolSlice, Null, *DocumentSymbolRegistrationOptions]{
+	Method:             MethodTextDocumentDocumentSymbol,
+	RegistrationMethod: "textDocument/documentSymbol",
+	Direction:          MessageDirectionClientToServer,
+}
+
+// TextDocumentCodeActionRequest describes the textDocument/codeAction request.
+var TextDocumentCodeActionRequest = RequestType[*CodeActionParams, []Or_Command_CodeAction, []Or_Command_CodeAction, Null, *CodeActionRegistrationOptions]{
+	Method:             MethodTextDocumentCodeAction,
+	RegistrationMethod: "textDocument/codeAction",
+	Direction:          MessageDirectionClientToServer,
+}
+
+// CodeActionResolveRequest describes the codeAction/resolve request.
+var CodeActionResolveRequest = RequestType[*CodeAction, *CodeAction, Null, Null, Null]{
+	Method:             MethodCodeActionResolve,
+	RegistrationMethod: "codeAction/resolve",
+	Direction:          MessageDirectionClientToServer,
+}
+
+// WorkspaceSymbolRequest describes the workspace/symbol request.
+var WorkspaceSymbolRequest = RequestType[*WorkspaceSymbolParams, *Or_SymbolInformationSlice_WorkspaceSymbolSlice, *Or_SymbolInformationSlice_WorkspaceSymbolSlice, Null, *WorkspaceSymbolRegistrationOptions]{
+	Method:             MethodWorkspaceSymbol,
+	RegistrationMethod: "workspace/symbol",
+	Direction:          MessageDirectionClientToServer,
+}
+
+// WorkspaceSymbolResolveRequest describes the workspaceSymbol/resolve request.
+var WorkspaceSymbolResolveRequest = RequestType[*WorkspaceSymbol, *WorkspaceSymbol, Null, Null, Null]{
+	Method:             MethodWorkspaceSymbolResolve,
+	RegistrationMethod: "workspaceSymbol/resolve",
+	Direction:          MessageDirectionClientToServer,
+}
+
+// TextDocumentCodeLensRequest describes the textDocument/codeLens request.
+var TextDocumentCodeLensRequest = RequestType[*CodeLensParams, []CodeLens, []CodeLens, Null, *CodeLensRegistrationOptions]{
+	Method:             MethodTextDocumentCodeLens,
+	RegistrationMethod: "textDocument/codeLens",
+	Direction:          MessageDirectionClientToServer,
+}
+
+// CodeLensResolveRequest describes the codeLens/resolve request.
+var CodeLensResolveRequest = RequestType[*CodeLens, *CodeLens, Null, Null, Null]{
+	Method:             MethodCodeLensResolve,
+	RegistrationMethod: "codeLens/resolve",
+	Direction:          MessageDirectionClientToServer,
+}
+
+// WorkspaceCodeLensRefreshRequest describes the workspace/codeLens/refresh request.
+var WorkspaceCodeLensRefreshRequest = RequestType[Null, Null, Null, Null, Null]{
+	Method:             MethodWorkspaceCodeLensRefresh,
+	RegistrationMethod: "workspace/codeLens/refresh",
+	Direction:          MessageDirectionServerToClient,
+}
+
+// TextDocumentDocumentLinkRequest describes the textDocument/documentLink request.
+var TextDocumentDocumentLinkRequest = RequestType[*DocumentLinkParams, []DocumentLink, []DocumentLink, Null, *DocumentLinkRegistrationOptions]{
+	Method:             MethodTextDocumentDocumentLink,
+	RegistrationMethod: "textDocument/documentLink",
+	Direction:          MessageDirectionClientToServer,
+}
+
+// DocumentLinkResolveRequest describes the documentLink/resolve request.
+var DocumentLinkResolveRequest = RequestType[*DocumentLink, *DocumentLink, Null, Null, Null]{
+	Method:             MethodDocumentLinkResolve,
+	RegistrationMethod: "documentLink/resolve",
+	Direction:          MessageDirectionClientToServer,
+}
+
+// TextDocumentFormattingRequest describes the textDocument/formatting request.
+var TextDocumentFormattingRequest = RequestType[*DocumentFormattingParams, []TextEdit, Null, Null, *DocumentFormattingRegistrationOptions]{
+	Method:             MethodTextDocumentFormatting,
+	RegistrationMethod: "textDocument/formatting",
+	Direction:          MessageDirectionClientToServer,
+}
+
+// TextDocumentRangeFormattingRequest describes the textDocument/rangeFormatting request.
+var TextDocumentRangeFormattingRequest = RequestType[*DocumentRangeFormattingParams, []TextEdit, Null, Null, *DocumentRangeFormattingRegistrationOptions]{
+	Method:             MethodTextDocumentRangeFormatting,
+	RegistrationMethod: "textDocument/rangeFormatting",
+	Direction:          MessageDirectionClientToServer,
+}
+
+// TextDocumentOnTypeFormattingRequest describes the textDocument/onTypeFormatting request.
+var TextDocumentOnTypeFormattingRequest = RequestType[*DocumentOnTypeFormattingParams, []TextEdit, Null, Null, *DocumentOnTypeFormattingRegistrationOptions]{
+	Method:             MethodTextDocumentOnTypeFormatting,
+	RegistrationMethod: "textDocument/onTypeFormatting",
+	Direction:          MessageDirectionClientToServer,
+}
+
+// TextDocumentRenameRequest describes the textDocument/rename request.
+var TextDocumentRenameRequest = RequestType[*RenameParams, *WorkspaceEdit, Null, Null, *RenameRegistrationOptions]{
+	Method:             MethodTextDocumentRename,
+	RegistrationMethod: "textDocument/rename",
+	Direction:          MessageDirectionClientToServer,
+}
+
+// TextDocumentPrepareRenameRequest describes the textDocument/prepareRename request.
+var TextDocumentPrepareRenameRequest = RequestType[*PrepareRenameParams, *PrepareRenameResult, Null, Null, Null]{
+	Method:             MethodTextDocumentPrepareRename,
+	RegistrationMethod: "textDocument/prepareRename",
+	Direction:          MessageDirectionClientToServer,
+}
+
+// WorkspaceExecuteCommandRequest describes the workspace/executeCommand request.
+var WorkspaceExecuteCommandRequest = RequestType[*ExecuteCommandParams, interface{}, Null, Null, *ExecuteCommandRegistrationOptions]{
+	Method:             MethodWorkspaceExecuteCommand,
+	RegistrationMethod: "workspace/executeCommand",
+	Direction:          MessageDirectionClientToServer,
+}
+
+// WorkspaceApplyEditRequest describes the workspace/applyEdit request.
+var WorkspaceApplyEditRequest = RequestType[*ApplyWorkspaceEditParams, *ApplyWorkspaceEditResult, Null, Null, Null]{
+	Method:             MethodWorkspaceApplyEdit,
+	RegistrationMethod: "workspace/applyEdit",
+	Direction:          MessageDirectionServerToClient,
+}
+
+// WorkspaceDidChangeWorkspaceFoldersNotification describes the workspace/didChangeWorkspaceFolders notification.
+var WorkspaceDidChangeWorkspaceFoldersNotification = NotificationType[*DidChangeWorkspaceFoldersParams, Null]{
+	Method:             MethodWorkspaceDidChangeWorkspaceFolders,
+	RegistrationMethod: "workspace/didChangeWorkspaceFolders",
+	Direction:          MessageDirectionClientToServer,
+}
+
+// WindowWorkDoneProgressCancelNotification describes the window/workDoneProgress/cancel notification.
+var WindowWorkDoneProgressCancelNotification = NotificationType[*WorkDoneProgressCancelParams, Null]{
+	Method:             MethodWindowWorkDoneProgressCancel,
+	RegistrationMethod: "window/workDoneProgress/cancel",
+	Direction:          MessageDirectionClientToServer,
+}
+
+// WorkspaceDidCreateFilesNotification describes the workspace/didCreateFiles notification.
+var WorkspaceDidCreateFilesNotification = NotificationType[*CreateFilesParams, *FileOperationRegistrationOptions]{
+	Method:             MethodWorkspaceDidCreateFiles,
+	RegistrationMethod: "workspace/didCreateFiles",
+	Direction:          MessageDirectionClientToServer,
+}
+
+// WorkspaceDidRenameFilesNotification describes the workspace/didRenameFiles notification.
+var WorkspaceDidRenameFilesNotification = NotificationType[*RenameFilesParams, *FileOperationRegistrationOptions]{
+	Method:             MethodWorkspaceDidRenameFiles,
+	RegistrationMethod: "workspace/didRenameFiles",
+	Direction:          MessageDirectionClientToServer,
+}
+
+// WorkspaceDidDeleteFilesNotification describes the workspace/didDeleteFiles notification.
+var WorkspaceDidDeleteFilesNotification = NotificationType[*DeleteFilesParams, *FileOperationRegistrationOptions]{
+	Method:             MethodWorkspaceDidDeleteFiles,
+	RegistrationMethod: "workspace/didDeleteFiles",
+	Direction:          MessageDirectionClientToServer,
+}
+
+// NotebookDocumentDidOpenNotification describes the notebookDocument/didOpen notification.
+var NotebookDocumentDidOpenNotification = NotificationType[*DidOpenNotebookDocumentParams, Null]{
+	Method:             MethodNotebookDocumentDidOpen,
+	RegistrationMethod: "notebookDocument/sync",
+	Direction:          MessageDirectionClientToServer,
+}
+
+// NotebookDocumentDidChangeNotification describes the notebookDocument/didChange notification.
+var NotebookDocumentDidChangeNotification = NotificationType[*DidChangeNotebookDocumentParams, Null]{
+	Method:             MethodNotebookDocumentDidChange,
+	RegistrationMethod: "notebookDocument/sync",
+	Direction:          MessageDirectionClientToServer,
+}
+
+// NotebookDocumentDidSaveNotification describes the notebookDocument/didSave notification.
+var NotebookDocumentDidSaveNotification = NotificationType[*DidSaveNotebookDocumentParams, Null]{
+	Method:             MethodNotebookDocumentDidSave,
+	RegistrationMethod: "notebookDocument/sync",
+	Direction:          MessageDirectionClientToServer,
+}
+
+// NotebookDocumentDidCloseNotification describes the notebookDocument/didClose notification.
+var NotebookDocumentDidCloseNotification = NotificationType[*DidCloseNotebookDocumentParams, Null]{
+	Method:             MethodNotebookDocumentDidClose,
+	RegistrationMethod: "notebookDocument/sync",
+	Direction:          MessageDirectionClientToServer,
+}
+
+// InitializedNotification describes the initialized notification.
+var InitializedNotification = NotificationType[*InitializedParams, Null]{
+	Method:             MethodInitialized,
+	RegistrationMethod: "initialized",
+	Direction:          MessageDirectionClientToServer,
+}
+
+// ExitNotification describes the exit notification.
+var ExitNotification = NotificationType[Null, Null]{
+	Method:             MethodExit,
+	RegistrationMethod: "exit",
+	Direction:          MessageDirectionClientToServer,
+}
+
+// WorkspaceDidChangeConfigurationNotification describes the workspace/didChangeConfiguration notification.
+var WorkspaceDidChangeConfigurationNotification = NotificationType[*DidChangeConfigurationParams, *DidChangeConfigurationRegistrationOptions]{
+	Method:             MethodWorkspaceDidChangeConfiguration,
+	RegistrationMethod: "workspace/didChangeConfiguration",
+	Direction:          MessageDirectionClientToServer,
+}
+
+// WindowShowMessageNotification describes the window/showMessage notification.
+var WindowShowMessageNotification = NotificationType[*ShowMessageParams, Null]{
+	Method:             MethodWindowShowMessage,
+	RegistrationMethod: "window/showMessage",
+	Direction:          MessageDirectionServerToClient,
+}
+
+// WindowLogMessageNotification describes the window/logMessage notification.
+var WindowLogMessageNotification = NotificationType[*LogMessageParams, Null]{
+	Method:             MethodWindowLogMessage,
+	RegistrationMethod: "window/logMessage",
+	Direction:          MessageDirectionServerToClient,
+}
+
+// TelemetryEventNotification describes the telemetry/event notification.
+var TelemetryEventNotification = NotificationType[interface{}, Null]{
+	Method:             MethodTelemetryEvent,
+	RegistrationMethod: "telemetry/event",
+	Direction:          MessageDirectionServerToClient,
+}
+
+// TextDocumentDidOpenNotification describes the textDocument/didOpen notification.
+var TextDocumentDidOpenNotification = NotificationType[*DidOpenTextDocumentParams, *TextDocumentRegistrationOptions]{
+	Method:             MethodTextDocumentDidOpen,
+	RegistrationMethod: "textDocument/didOpen",
+	Direction:          MessageDirectionClientToServer,
+}
+
+// TextDocumentDidChangeNotification describes the textDocument/didChange notification.
+var TextDocumentDidChangeNotification = NotificationType[*DidChangeTextDocumentParams, *TextDocumentChangeRegistrationOptions]{
+	Method:             MethodTextDocumentDidChange,
+	RegistrationMethod: "textDocument/didChange",
+	Direction:          MessageDirectionClientToServer,
+}
+
+// TextDocumentDidCloseNotification describes the textDocument/didClose notification.
+var TextDocumentDidCloseNotification = NotificationType[*DidCloseTextDocumentParams, *TextDocumentRegistrationOptions]{
+	Method:             MethodTextDocumentDidClose,
+	RegistrationMethod: "textDocument/didClose",
+	Direction:          MessageDirectionClientToServer,
+}
+
+// TextDocumentDidSaveNotification describes the textDocument/didSave notification.
+var TextDocumentDidSaveNotification = NotificationType[*DidSaveTextDocumentParams, *TextDocumentSaveRegistrationOptions]{
+	Method:             MethodTextDocumentDidSave,
+	RegistrationMethod: "textDocument/didSave",
+	Direction:          MessageDirectionClientToServer,
+}
+
+// TextDocumentWillSaveNotification describes the textDocument/willSave notification.
+var TextDocumentWillSaveNotification = NotificationType[*WillSaveTextDocumentParams, *TextDocumentRegistrationOptions]{
+	Method:             MethodTextDocumentWillSave,
+	RegistrationMethod: "textDocument/willSave",
+	Direction:          MessageDirectionClientToServer,
+}
+
+// WorkspaceDidChangeWatchedFilesNotification describes the workspace/didChangeWatchedFiles notification.
+var WorkspaceDidChangeWatchedFilesNotification = NotificationType[*DidChangeWatchedFilesParams, *DidChangeWatchedFilesRegistrationOptions]{
+	Method:             MethodWorkspaceDidChangeWatchedFiles,
+	RegistrationMethod: "workspace/didChangeWatchedFiles",
+	Direction:          MessageDirectionClientToServer,
+}
+
+// TextDocumentPublishDiagnosticsNotification describes the textDocument/publishDiagnostics notification.
+var TextDocumentPublishDiagnosticsNotification = NotificationType[*PublishDiagnosticsParams, Null]{
+	Method:             MethodTextDocumentPublishDiagnostics,
+	RegistrationMethod: "textDocument/publishDiagnostics",
+	Direction:          MessageDirectionServerToClient,
+}
+
+// SetTraceNotification describes the $/setTrace notification.
+var SetTraceNotification = NotificationType[*SetTraceParams, Null]{
+	Method:             MethodSetTrace,
+	RegistrationMethod: "$/setTrace",
+	Direction:          MessageDirectionClientToServer,
+}
+
+// LogTraceNotification describes the $/logTrace notification.
+var LogTraceNotification = NotificationType[*LogTraceParams, Null]{
+	Method:             MethodLogTrace,
+	RegistrationMethod: "$/logTrace",
+	Direction:          MessageDirectionServerToClient,
+}
+
+// CancelRequestNotification describes the $/cancelRequest notification.
+var CancelRequestNotification = NotificationType[*CancelParams, Null]{
+	Method:             MethodCancelRequest,
+	RegistrationMethod: "$/cancelRequest",
+	Direction:          MessageDirectionBoth,
+}
+
+// ProgressNotification describes the $/progress notification.
+var ProgressNotification = NotificationType[*ProgressParams, Null]{
+	Method:             MethodProgress,
+	RegistrationMethod: "$/progress",
+	Direction:          MessageDirectionBoth,
+}
@@ -0,0 +1,91 @@
+package lsp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// A Mux is a Handler dispatching messages to the handlers registered for
+// their method. Typed handlers are registered with HandleRequest and
+// HandleNotification.
+type Mux struct {
+	mu       sync.RWMutex
+	handlers map[string]Handler
+}
+
+// NewMux returns an empty Mux.
+func NewMux() *Mux {
+	return &Mux{handlers: make(map[string]Handler)}
+}
+
+// Register registers the handler for method. It replaces a handler
+// registered before.
+func (m *Mux) Register(method string, h Handler) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.handlers[method] = h
+}
+
+// Handles reports whether a handler is registered for method.
+func (m *Mux) Handles(method string) bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	_, ok := m.handlers[method]
+	return ok
+}
+
+// Methods returns the sorted methods with registered handlers.
+func (m *Mux) Methods() []string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	methods := make([]string, 0, len(m.handlers))
+	for method := range m.handlers {
+		methods = append(methods, method)
+	}
+	sort.Strings(methods)
+	return methods
+}
+
+// Capabilities returns the server capabilities for the registered methods.
+// See NewServerCapabilities.
+func (m *Mux) Capabilities(opts *CapabilityOptions) (*ServerCapabilities, error) {
+	return NewServerCapabilities(m.Methods(), opts)
+}
+
+// Handle implements Handler. Methods without handler fail with
+// ErrMethodNotFound.
+func (m *Mux) Handle(ctx context.Context, method string, params json.RawMessage) (json.RawMessage, error) {
+	m.mu.RLock()
+	h, ok := m.handlers[method]
+	m.mu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrMethodNotFound, method)
+	}
+	return h.Handle(ctx, method, params)
+}
+
+// HandleRequest registers f as handler of the request described by t.
+func HandleRequest[P, R, PR, E, O any](m *Mux, t RequestType[P, R, PR, E, O], f func(ctx context.Context, params P) (R, error)) {
+	m.Register(t.Method, HandlerFunc(func(ctx context.Context, method string, params json.RawMessage) (json.RawMessage, error) {
+		p, err := t.DecodeParams(params)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrInvalidParams, method, err)
+		}
+		return encodeResult(f(ctx, p))
+	}))
+}
+
+// HandleNotification registers f as handler of the notification described
+// by t.
+func HandleNotification[P, O any](m *Mux, t NotificationType[P, O], f func(ctx context.Context, params P) error) {
+	m.Register(t.Method, HandlerFunc(func(ctx context.Context, method string, params json.RawMessage) (json.RawMessage, error) {
+		p, err := t.DecodeParams(params)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrInvalidParams, method, err)
+		}
+		return nil, f(ctx, p)
+	}))
+}
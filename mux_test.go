@@ -0,0 +1,86 @@
+package lsp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestMux(t *testing.T) {
+	ctx := context.Background()
+	m := NewMux()
+	var hovered *HoverParams
+	HandleRequest(m, TextDocumentHoverRequest, func(ctx context.Context, params *HoverParams) (*Hover, error) {
+		hovered = params
+		if params.Position.Line > 0 {
+			return nil, nil
+		}
+		return &Hover{Contents: Or_MarkupContent_MarkedString_MarkedStringSlice{Value: MarkupContent{Kind: MarkupKindPlainText, Value: "x"}}}, nil
+	})
+	failed := errors.New("failed")
+	HandleRequest(m, ShutdownRequest, func(ctx context.Context, params Null) (Null, error) {
+		return Null{}, failed
+	})
+	var opened []DocumentURI
+	HandleNotification(m, TextDocumentDidOpenNotification, func(ctx context.Context, params *DidOpenTextDocumentParams) error {
+		opened = append(opened, params.TextDocument.Uri)
+		return nil
+	})
+
+	want := []string{MethodShutdown, MethodTextDocumentDidOpen, MethodTextDocumentHover}
+	if got := m.Methods(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Methods returned %v, want %v", got, want)
+	}
+	if !m.Handles(MethodTextDocumentHover) || m.Handles(MethodTextDocumentDefinition) {
+		t.Error("Handles")
+	}
+	caps, err := m.Capabilities(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b, _ := json.Marshal(caps); string(b) != `{"textDocumentSync":{"openClose":true,"change":0,"willSave":false,"willSaveWaitUntil":false},"hoverProvider":true}` {
+		t.Errorf("got capabilities %s", b)
+	}
+
+	tests := []struct {
+		method string
+		params string
+		result string
+		err    error
+	}{
+		{MethodTextDocumentHover, `{"textDocument":{"uri":"file:///a"},"position":{"line":0,"character":1}}`, `{"contents":{"kind":"plaintext","value":"x"}}`, nil},
+		{MethodTextDocumentHover, `{"textDocument":{"uri":"file:///a"},"position":{"line":1,"character":1}}`, `null`, nil},
+		{MethodTextDocumentHover, `{"position":"x"}`, ``, ErrInvalidParams},
+		{MethodShutdown, ``, ``, failed},
+		{MethodTextDocumentDidOpen, `{"textDocument":{"uri":"file:///b","languageId":"go","version":1,"text":""}}`, ``, nil},
+		{MethodTextDocumentDidOpen, `[]`, ``, ErrInvalidParams},
+		{MethodTextDocumentDefinition, `{}`, ``, ErrMethodNotFound},
+	}
+	for _, tt := range tests {
+		result, err := m.Handle(ctx, tt.method, json.RawMessage(tt.params))
+		switch {
+		case tt.err != nil && !errors.Is(err, tt.err):
+			t.Errorf("%s %s: got error %v, want %v", tt.method, tt.params, err, tt.err)
+		case tt.err == nil && err != nil:
+			t.Errorf("%s %s: %v", tt.method, tt.params, err)
+		case string(result) != tt.result:
+			t.Errorf("%s %s: got result %s, want %s", tt.method, tt.params, result, tt.result)
+		}
+	}
+	if hovered == nil || hovered.TextDocument.Uri != "file:///a" {
+		t.Errorf("hover got params %+v", hovered)
+	}
+	if !reflect.DeepEqual(opened, []DocumentURI{"file:///b"}) {
+		t.Errorf("opened %v", opened)
+	}
+
+	// Handlers are replaced.
+	HandleRequest(m, ShutdownRequest, func(ctx context.Context, params Null) (Null, error) {
+		return Null{}, nil
+	})
+	if result, err := m.Handle(ctx, MethodShutdown, nil); err != nil || string(result) != "null" {
+		t.Errorf("replaced shutdown handler returned %s, %v", result, err)
+	}
+}
@@ -0,0 +1,327 @@
+package lsp
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// A Notebook is an immutable snapshot of a notebook document opened by the
+// client. The embedded NotebookDocument holds the cells in order, including
+// their kind, metadata and execution summary.
+type Notebook struct {
+	NotebookDocument
+
+	docs map[DocumentURI]*Document // text documents of the cells
+	enc  PositionEncodingKind
+}
+
+// Cell returns the cell of the document uri and its index.
+func (n *Notebook) Cell(uri DocumentURI) (NotebookCell, int, bool) {
+	uri = uri.Normalize()
+	for i, c := range n.Cells {
+		if c.Document.Normalize() == uri {
+			return c, i, true
+		}
+	}
+	return NotebookCell{}, -1, false
+}
+
+// Document returns the text document of the cell uri.
+func (n *Notebook) Document(uri DocumentURI) (*Document, bool) {
+	doc, ok := n.docs[uri.Normalize()]
+	return doc, ok
+}
+
+// Documents returns the text documents of the cells in cell order.
+func (n *Notebook) Documents() []*Document {
+	docs := make([]*Document, 0, len(n.Cells))
+	for _, c := range n.Cells {
+		if doc, ok := n.docs[c.Document.Normalize()]; ok {
+			docs = append(docs, doc)
+		}
+	}
+	return docs
+}
+
+// Concat returns the concatenated text of the code cells with the given
+// language, or of all code cells if languageID is empty. Cells are
+// separated by a line break, so each cell starts on a new line.
+func (n *Notebook) Concat(languageID string) *NotebookText {
+	var b strings.Builder
+	t := &NotebookText{}
+	line := 0
+	for _, c := range n.Cells {
+		doc, ok := n.docs[c.Document.Normalize()]
+		if !ok || c.Kind != NotebookCellKindCode || (languageID != "" && doc.LanguageID != languageID) {
+			continue
+		}
+		t.cells = append(t.cells, cellSpan{doc: doc, line: line})
+		text := doc.Text()
+		b.WriteString(text)
+		if strings.HasSuffix(text, "\r") {
+			// The separator would merge with the "\r" into one line
+			// break, so keep the empty last line of the cell.
+			b.WriteByte('\n')
+		}
+		b.WriteByte('\n')
+		line += doc.LineCount()
+	}
+	t.LineIndex = NewLineIndex(b.String(), n.enc)
+	return t
+}
+
+// A NotebookText is the concatenated text of notebook cells. It maps
+// positions in the concatenated text to positions in the cells and back, so
+// language features can analyze the cells of a notebook as one document.
+type NotebookText struct {
+	*LineIndex
+
+	cells []cellSpan
+}
+
+// cellSpan is a cell of a NotebookText starting at line.
+type cellSpan struct {
+	doc  *Document
+	line int
+}
+
+// Cells returns the text documents of the concatenated cells.
+func (t *NotebookText) Cells() []*Document {
+	docs := make([]*Document, len(t.cells))
+	for i, c := range t.cells {
+		docs[i] = c.doc
+	}
+	return docs
+}
+
+// CellPosition returns the cell and the position in the cell of the
+// position p of the concatenated text. The empty line after the last cell
+// belongs to no cell.
+func (t *NotebookText) CellPosition(p Position) (DocumentURI, Position, bool) {
+	i := sort.Search(len(t.cells), func(i int) bool { return t.cells[i].line > int(p.Line) }) - 1
+	if i < 0 {
+		return "", Position{}, false
+	}
+	c := t.cells[i]
+	if int(p.Line) >= c.line+c.doc.LineCount() {
+		return "", Position{}, false
+	}
+	return c.doc.URI, Position{Line: p.Line - uint32(c.line), Character: p.Character}, true
+}
+
+// Position returns the position in the concatenated text of the position p
+// of the cell uri.
+func (t *NotebookText) Position(uri DocumentURI, p Position) (Position, bool) {
+	uri = uri.Normalize()
+	for _, c := range t.cells {
+		if c.doc.URI.Normalize() == uri {
+			return Position{Line: p.Line + uint32(c.line), Character: p.Character}, true
+		}
+	}
+	return Position{}, false
+}
+
+// A NotebookStore keeps the notebook documents opened by the client in sync
+// with the client. It handles the notebook document synchronization
+// notifications, tracking the cells, their order, metadata, execution
+// summaries and text documents. Notebooks and cells are keyed by their
+// normalized URI.
+type NotebookStore struct {
+	// Encoding is the position encoding negotiated with the client. The
+	// empty encoding is UTF-16.
+	Encoding PositionEncodingKind
+
+	mu        sync.RWMutex
+	notebooks map[URI]*Notebook
+	cells     map[DocumentURI]URI // notebooks of the cells
+}
+
+// NewNotebookStore returns an empty store.
+func NewNotebookStore() *NotebookStore {
+	return &NotebookStore{
+		notebooks: make(map[URI]*Notebook),
+		cells:     make(map[DocumentURI]URI),
+	}
+}
+
+// Register registers the handlers of the notebook document synchronization
+// notifications with m.
+func (s *NotebookStore) Register(m *Mux) {
+	HandleNotification(m, NotebookDocumentDidOpenNotification, s.DidOpen)
+	HandleNotification(m, NotebookDocumentDidChangeNotification, s.DidChange)
+	HandleNotification(m, NotebookDocumentDidSaveNotification, s.DidSave)
+	HandleNotification(m, NotebookDocumentDidCloseNotification, s.DidClose)
+}
+
+// Get returns the current snapshot of the notebook uri.
+func (s *NotebookStore) Get(uri URI) (*Notebook, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	n, ok := s.notebooks[uri.Normalize()]
+	return n, ok
+}
+
+// Notebooks returns snapshots of all open notebooks sorted by URI.
+func (s *NotebookStore) Notebooks() []*Notebook {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	ns := make([]*Notebook, 0, len(s.notebooks))
+	for _, n := range s.notebooks {
+		ns = append(ns, n)
+	}
+	sort.Slice(ns, func(i, j int) bool { return ns[i].Uri < ns[j].Uri })
+	return ns
+}
+
+// NotebookOf returns the notebook owning the cell uri.
+func (s *NotebookStore) NotebookOf(cell DocumentURI) (*Notebook, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	uri, ok := s.cells[cell.Normalize()]
+	if !ok {
+		return nil, false
+	}
+	return s.notebooks[uri], true
+}
+
+// DidOpen handles notebookDocument/didOpen. Opening a notebook again
+// replaces it.
+func (s *NotebookStore) DidOpen(ctx context.Context, params *DidOpenNotebookDocumentParams) error {
+	n := &Notebook{
+		NotebookDocument: params.NotebookDocument,
+		docs:             make(map[DocumentURI]*Document),
+		enc:              s.Encoding,
+	}
+	n.Cells = append([]NotebookCell(nil), n.Cells...)
+	for _, item := range params.CellTextDocuments {
+		n.docs[item.Uri.Normalize()] = NewDocument(item.Uri, item.LanguageId, item.Version, item.Text, n.enc)
+	}
+
+	uri := n.Uri.Normalize()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if old, ok := s.notebooks[uri]; ok {
+		s.forget(old)
+	}
+	s.notebooks[uri] = n
+	for cell := range n.docs {
+		s.cells[cell] = uri
+	}
+	return nil
+}
+
+// DidChange handles notebookDocument/didChange. Changes of the cell
+// structure are applied first, then changes of cell data and finally
+// changes of the cell texts. Changes with a version not newer than the
+// notebook are rejected with ErrOutdatedVersion.
+func (s *NotebookStore) DidChange(ctx context.Context, params *DidChangeNotebookDocumentParams) error {
+	uri := params.NotebookDocument.Uri.Normalize()
+	version := params.NotebookDocument.Version
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	old, ok := s.notebooks[uri]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrUnknownDocument, uri)
+	}
+	if version <= old.Version {
+		return fmt.Errorf("%w: %s: version %d is not newer than %d", ErrOutdatedVersion, uri, version, old.Version)
+	}
+
+	n := &Notebook{
+		NotebookDocument: old.NotebookDocument,
+		docs:             make(map[DocumentURI]*Document, len(old.docs)),
+		enc:              old.enc,
+	}
+	n.Version = version
+	for cell, doc := range old.docs {
+		n.docs[cell] = doc
+	}
+	change := params.Change
+	if change.Metadata != nil {
+		n.Metadata = change.Metadata
+	}
+	if cells := change.Cells; cells != nil {
+		if st := cells.Structure; st != nil {
+			start, end := int(st.Array.Start), int(st.Array.Start+st.Array.DeleteCount)
+			if start > len(n.Cells) || end > len(n.Cells) {
+				return fmt.Errorf("%s: cell change [%d:%d] out of range of %d cells", uri, start, end, len(n.Cells))
+			}
+			var c []NotebookCell
+			c = append(c, n.Cells[:start]...)
+			c = append(c, st.Array.Cells...)
+			n.Cells = append(c, n.Cells[end:]...)
+			for _, id := range st.DidClose {
+				delete(n.docs, id.Uri.Normalize())
+			}
+			for _, item := range st.DidOpen {
+				n.docs[item.Uri.Normalize()] = NewDocument(item.Uri, item.LanguageId, item.Version, item.Text, n.enc)
+			}
+		} else {
+			n.Cells = append([]NotebookCell(nil), n.Cells...)
+		}
+		for _, data := range cells.Data {
+			_, i, ok := n.Cell(data.Document)
+			if !ok {
+				return fmt.Errorf("%w: %s: cell %s", ErrUnknownDocument, uri, data.Document)
+			}
+			n.Cells[i] = data
+		}
+		for _, tc := range cells.TextContent {
+			cell := tc.Document.Uri.Normalize()
+			doc, ok := n.docs[cell]
+			if !ok {
+				return fmt.Errorf("%w: %s: cell %s", ErrUnknownDocument, uri, tc.Document.Uri)
+			}
+			if tc.Document.Version <= doc.Version {
+				return fmt.Errorf("%w: %s: version %d is not newer than %d", ErrOutdatedVersion, tc.Document.Uri, tc.Document.Version, doc.Version)
+			}
+			doc, err := doc.apply(tc.Document.Version, tc.Changes)
+			if err != nil {
+				return err
+			}
+			n.docs[cell] = doc
+		}
+	}
+
+	s.forget(old)
+	s.notebooks[uri] = n
+	for cell := range n.docs {
+		s.cells[cell] = uri
+	}
+	return nil
+}
+
+// DidSave handles notebookDocument/didSave.
+func (s *NotebookStore) DidSave(ctx context.Context, params *DidSaveNotebookDocumentParams) error {
+	if _, ok := s.Get(params.NotebookDocument.Uri); !ok {
+		return fmt.Errorf("%w: %s", ErrUnknownDocument, params.NotebookDocument.Uri)
+	}
+	return nil
+}
+
+// DidClose handles notebookDocument/didClose. It closes the notebook and
+// the text documents of all its cells.
+func (s *NotebookStore) DidClose(ctx context.Context, params *DidCloseNotebookDocumentParams) error {
+	uri := params.NotebookDocument.Uri.Normalize()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	n, ok := s.notebooks[uri]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrUnknownDocument, uri)
+	}
+	s.forget(n)
+	delete(s.notebooks, uri)
+	return nil
+}
+
+// forget removes the cells of n from the cell index.
+func (s *NotebookStore) forget(n *Notebook) {
+	for cell := range n.docs {
+		delete(s.cells, cell)
+	}
+}
@@ -0,0 +1,289 @@
+package lsp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func codeCell(uri DocumentURI) NotebookCell {
+	return NotebookCell{Kind: NotebookCellKindCode, Document: uri}
+}
+
+// cellText returns the change of the text of cell to text.
+func cellText(cell DocumentURI, version int32, text string) NotebookDocumentChangeEventCellsTextContent {
+	return NotebookDocumentChangeEventCellsTextContent{
+		Document: VersionedTextDocumentIdentifier{TextDocumentIdentifier: TextDocumentIdentifier{Uri: cell}, Version: version},
+		Changes:  []TextDocumentContentChangeEvent{{Value: TextDocumentContentChangeEventText{Text: text}}},
+	}
+}
+
+// cellURIs returns the document URIs of the cells of n.
+func cellURIs(n *Notebook) []DocumentURI {
+	var uris []DocumentURI
+	for _, c := range n.Cells {
+		uris = append(uris, c.Document)
+	}
+	return uris
+}
+
+func TestNotebookStore(t *testing.T) {
+	s := NewNotebookStore()
+	ctx := context.Background()
+	const uri = "file:///n.ipynb"
+	err := s.DidOpen(ctx, &DidOpenNotebookDocumentParams{
+		NotebookDocument: NotebookDocument{
+			Uri:          uri,
+			NotebookType: "jupyter-notebook",
+			Version:      1,
+			Cells:        []NotebookCell{codeCell("cell:1"), {Kind: NotebookCellKindMarkup, Document: "cell:2"}, codeCell("cell:3")},
+		},
+		CellTextDocuments: []TextDocumentItem{
+			{Uri: "cell:1", LanguageId: "python", Version: 1, Text: "a = 1"},
+			{Uri: "cell:2", LanguageId: "markdown", Version: 1, Text: "# T"},
+			{Uri: "cell:3", LanguageId: "python", Version: 1, Text: "print(a)"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	opened, _ := s.Get(uri)
+
+	steps := []struct {
+		name   string
+		change NotebookDocumentChangeEvent
+		fails  bool          // whether the change is rejected
+		err    error         // wrapped by the error of a rejected change
+		cells  []DocumentURI // afterwards
+		texts  map[DocumentURI]string
+	}{
+		{
+			name: "insert",
+			change: NotebookDocumentChangeEvent{Cells: &NotebookDocumentChangeEventCells{
+				Structure: &NotebookDocumentChangeEventCellsStructure{
+					Array:   NotebookCellArrayChange{Start: 3, Cells: []NotebookCell{codeCell("cell:4")}},
+					DidOpen: []TextDocumentItem{{Uri: "cell:4", LanguageId: "python", Version: 1, Text: "b = 2"}},
+				},
+			}},
+			cells: []DocumentURI{"cell:1", "cell:2", "cell:3", "cell:4"},
+			texts: map[DocumentURI]string{"cell:1": "a = 1", "cell:2": "# T", "cell:3": "print(a)", "cell:4": "b = 2"},
+		},
+		{
+			// A move replaces the cells without closing their documents.
+			name: "move",
+			change: NotebookDocumentChangeEvent{Cells: &NotebookDocumentChangeEventCells{
+				Structure: &NotebookDocumentChangeEventCellsStructure{
+					Array: NotebookCellArrayChange{Start: 2, DeleteCount: 2, Cells: []NotebookCell{codeCell("cell:4"), codeCell("cell:3")}},
+				},
+			}},
+			cells: []DocumentURI{"cell:1", "cell:2", "cell:4", "cell:3"},
+			texts: map[DocumentURI]string{"cell:1": "a = 1", "cell:2": "# T", "cell:3": "print(a)", "cell:4": "b = 2"},
+		},
+		{
+			name: "delete",
+			change: NotebookDocumentChangeEvent{Cells: &NotebookDocumentChangeEventCells{
+				Structure: &NotebookDocumentChangeEventCellsStructure{
+					Array:    NotebookCellArrayChange{Start: 1, DeleteCount: 1},
+					DidClose: []TextDocumentIdentifier{{Uri: "cell:2"}},
+				},
+			}},
+			cells: []DocumentURI{"cell:1", "cell:4", "cell:3"},
+			texts: map[DocumentURI]string{"cell:1": "a = 1", "cell:3": "print(a)", "cell:4": "b = 2"},
+		},
+		{
+			name: "data and text",
+			change: NotebookDocumentChangeEvent{Cells: &NotebookDocumentChangeEventCells{
+				Data:        []NotebookCell{{Kind: NotebookCellKindCode, Document: "cell:1", ExecutionSummary: &ExecutionSummary{ExecutionOrder: 3, Success: ptr(true)}}},
+				TextContent: []NotebookDocumentChangeEventCellsTextContent{cellText("cell:1", 2, "a = 10")},
+			}},
+			cells: []DocumentURI{"cell:1", "cell:4", "cell:3"},
+			texts: map[DocumentURI]string{"cell:1": "a = 10", "cell:3": "print(a)", "cell:4": "b = 2"},
+		},
+		{
+			name: "outdated cell version",
+			change: NotebookDocumentChangeEvent{Cells: &NotebookDocumentChangeEventCells{
+				TextContent: []NotebookDocumentChangeEventCellsTextContent{cellText("cell:3", 2, "x"), cellText("cell:1", 2, "x")},
+			}},
+			err:   ErrOutdatedVersion,
+			fails: true,
+			cells: []DocumentURI{"cell:1", "cell:4", "cell:3"},
+			texts: map[DocumentURI]string{"cell:1": "a = 10", "cell:3": "print(a)", "cell:4": "b = 2"},
+		},
+		{
+			name: "unknown cell",
+			change: NotebookDocumentChangeEvent{Cells: &NotebookDocumentChangeEventCells{
+				Data: []NotebookCell{codeCell("cell:2")},
+			}},
+			err:   ErrUnknownDocument,
+			fails: true,
+			cells: []DocumentURI{"cell:1", "cell:4", "cell:3"},
+			texts: map[DocumentURI]string{"cell:1": "a = 10", "cell:3": "print(a)", "cell:4": "b = 2"},
+		},
+		{
+			name: "out of range",
+			change: NotebookDocumentChangeEvent{Cells: &NotebookDocumentChangeEventCells{
+				Structure: &NotebookDocumentChangeEventCellsStructure{
+					Array: NotebookCellArrayChange{Start: 2, DeleteCount: 2},
+				},
+			}},
+			fails: true,
+			cells: []DocumentURI{"cell:1", "cell:4", "cell:3"},
+			texts: map[DocumentURI]string{"cell:1": "a = 10", "cell:3": "print(a)", "cell:4": "b = 2"},
+		},
+	}
+	version := int32(1)
+	for _, st := range steps {
+		version++
+		err := s.DidChange(ctx, &DidChangeNotebookDocumentParams{
+			NotebookDocument: VersionedNotebookDocumentIdentifier{Uri: uri, Version: version},
+			Change:           st.change,
+		})
+		if (err != nil) != st.fails || st.err != nil && !errors.Is(err, st.err) {
+			t.Errorf("%s: got error %v, want %v", st.name, err, st.err)
+		}
+		if st.fails {
+			version--
+		}
+
+		n, _ := s.Get(uri)
+		if n.Version != version {
+			t.Errorf("%s: got version %d, want %d", st.name, n.Version, version)
+		}
+		if got := cellURIs(n); !reflect.DeepEqual(got, st.cells) {
+			t.Errorf("%s: got cells %v, want %v", st.name, got, st.cells)
+		}
+		for _, cell := range []DocumentURI{"cell:1", "cell:2", "cell:3", "cell:4"} {
+			doc, ok := n.Document(cell)
+			text, want := st.texts[cell]
+			if ok != want || ok && doc.Text() != text {
+				t.Errorf("%s: %s: got %v, want text %q", st.name, cell, doc, text)
+			}
+			if owner, ok := s.NotebookOf(cell); ok != want || ok && owner != n {
+				t.Errorf("%s: NotebookOf(%s) = %v, %v", st.name, cell, owner, ok)
+			}
+		}
+	}
+
+	n, _ := s.Get(uri)
+	if c, i, ok := n.Cell("cell:1"); !ok || i != 0 || c.ExecutionSummary == nil || c.ExecutionSummary.ExecutionOrder != 3 {
+		t.Errorf("Cell(cell:1) = %+v, %d, %v", c, i, ok)
+	}
+	if got := cellURIs(opened); !reflect.DeepEqual(got, []DocumentURI{"cell:1", "cell:2", "cell:3"}) {
+		t.Errorf("snapshot changed to cells %v", got)
+	}
+	if doc, _ := opened.Document("cell:1"); doc.Text() != "a = 1" {
+		t.Errorf("snapshot changed to text %q", doc.Text())
+	}
+
+	err = s.DidChange(ctx, &DidChangeNotebookDocumentParams{NotebookDocument: VersionedNotebookDocumentIdentifier{Uri: uri, Version: version}})
+	if !errors.Is(err, ErrOutdatedVersion) {
+		t.Errorf("outdated notebook version: got %v", err)
+	}
+	err = s.DidChange(ctx, &DidChangeNotebookDocumentParams{NotebookDocument: VersionedNotebookDocumentIdentifier{Uri: "file:///x.ipynb", Version: 9}})
+	if !errors.Is(err, ErrUnknownDocument) {
+		t.Errorf("unknown notebook: got %v", err)
+	}
+	if err := s.DidSave(ctx, &DidSaveNotebookDocumentParams{NotebookDocument: NotebookDocumentIdentifier{Uri: "file:///x.ipynb"}}); !errors.Is(err, ErrUnknownDocument) {
+		t.Errorf("save of unknown notebook: got %v", err)
+	}
+
+	if err := s.DidClose(ctx, &DidCloseNotebookDocumentParams{NotebookDocument: NotebookDocumentIdentifier{Uri: "file:///n.ipynb/"}}); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := s.NotebookOf("cell:1"); ok || len(s.Notebooks()) != 0 {
+		t.Error("notebook not closed")
+	}
+}
+
+func TestNotebookConcat(t *testing.T) {
+	s := NewNotebookStore()
+	err := s.DidOpen(context.Background(), &DidOpenNotebookDocumentParams{
+		NotebookDocument: NotebookDocument{
+			Uri:     "file:///n.ipynb",
+			Version: 1,
+			Cells: []NotebookCell{
+				codeCell("cell:1"),
+				{Kind: NotebookCellKindMarkup, Document: "cell:2"},
+				codeCell("cell:3"),
+				codeCell("cell:4"),
+				codeCell("cell:5"),
+				codeCell("cell:6"),
+			},
+		},
+		CellTextDocuments: []TextDocumentItem{
+			{Uri: "cell:1", LanguageId: "python", Version: 1, Text: "a = 1\n"},
+			{Uri: "cell:2", LanguageId: "markdown", Version: 1, Text: "# T"},
+			{Uri: "cell:3", LanguageId: "sql", Version: 1, Text: "select"},
+			{Uri: "cell:4", LanguageId: "python", Version: 1, Text: ""},
+			{Uri: "cell:5", LanguageId: "python", Version: 1, Text: "b = 2\nprint(b)"},
+			{Uri: "cell:6", LanguageId: "python", Version: 1, Text: "c\r"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	n, _ := s.NotebookOf("cell:5")
+
+	tests := []struct {
+		language string
+		text     string
+		lines    []string // cell:line of each line of the text
+	}{
+		{
+			language: "python",
+			text:     "a = 1\n\n\nb = 2\nprint(b)\nc\r\n\n",
+			lines:    []string{"cell:1:0", "cell:1:1", "cell:4:0", "cell:5:0", "cell:5:1", "cell:6:0", "cell:6:1", ""},
+		},
+		{
+			language: "",
+			text:     "a = 1\n\nselect\n\nb = 2\nprint(b)\nc\r\n\n",
+			lines:    []string{"cell:1:0", "cell:1:1", "cell:3:0", "cell:4:0", "cell:5:0", "cell:5:1", "cell:6:0", "cell:6:1", ""},
+		},
+		{
+			language: "markdown",
+			text:     "",
+			lines:    []string{""},
+		},
+	}
+	for _, tt := range tests {
+		c := n.Concat(tt.language)
+		if c.Text() != tt.text {
+			t.Errorf("%q: got text %q, want %q", tt.language, c.Text(), tt.text)
+		}
+		if c.LineCount() != len(tt.lines) {
+			t.Errorf("%q: got %d lines, want %d", tt.language, c.LineCount(), len(tt.lines))
+			continue
+		}
+		for i, want := range tt.lines {
+			p := Position{Line: uint32(i), Character: 2}
+			var got string
+			cell, cp, ok := c.CellPosition(p)
+			if ok {
+				got = fmt.Sprintf("%s:%d", cell, cp.Line)
+				if cp.Character != p.Character {
+					t.Errorf("%q: CellPosition(%v) moved the character to %d", tt.language, p, cp.Character)
+				}
+				if back, ok := c.Position(cell, cp); !ok || back != p {
+					t.Errorf("%q: Position(%s, %v) = %v, %v, want %v", tt.language, cell, cp, back, ok, p)
+				}
+			}
+			if got != want {
+				t.Errorf("%q: line %d belongs to %q, want %q", tt.language, i, got, want)
+			}
+		}
+	}
+
+	c := n.Concat("python")
+	var cells []DocumentURI
+	for _, doc := range c.Cells() {
+		cells = append(cells, doc.URI)
+	}
+	if want := []DocumentURI{"cell:1", "cell:4", "cell:5", "cell:6"}; !reflect.DeepEqual(cells, want) {
+		t.Errorf("got cells %v, want %v", cells, want)
+	}
+	if _, ok := c.Position("cell:3", Position{}); ok {
+		t.Error("Position of a cell not in the text")
+	}
+}
@@ -0,0 +1,214 @@
+package lsp
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// A ProgressTracker creates work done progress and tracks it until it ends,
+// so it can be canceled by the client.
+//
+// Servers forward window/workDoneProgress/cancel notifications to the
+// tracker, for example by embedding it.
+type ProgressTracker struct {
+	client Client
+
+	mu      sync.Mutex
+	seq     int
+	running map[interface{}]context.CancelFunc
+}
+
+// NewProgressTracker returns a tracker reporting progress to client.
+func NewProgressTracker(client Client) *ProgressTracker {
+	return &ProgressTracker{
+		client:  client,
+		running: make(map[interface{}]context.CancelFunc),
+	}
+}
+
+// Start creates a new token with window/workDoneProgress/create and begins
+// a server initiated progress with it.
+func (t *ProgressTracker) Start(ctx context.Context, title string, cancellable bool) (*Progress, error) {
+	t.mu.Lock()
+	t.seq++
+	token := ProgressToken{Value: fmt.Sprintf("progress-%d", t.seq)}
+	t.mu.Unlock()
+
+	if err := t.client.WorkDoneProgressCreate(ctx, &WorkDoneProgressCreateParams{Token: token}); err != nil {
+		return nil, err
+	}
+	return t.Begin(ctx, &token, title, cancellable)
+}
+
+// Begin begins a progress for the token passed by the client in the
+// WorkDoneProgressParams of a request. If token is nil, the client does not
+// expect progress and the returned Progress reports nothing.
+//
+// The context of the progress is derived from ctx. It is canceled when the
+// client cancels the progress or the progress ends.
+func (t *ProgressTracker) Begin(ctx context.Context, token *ProgressToken, title string, cancellable bool) (*Progress, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	p := &Progress{
+		tracker: t,
+		ctx:     ctx,
+		cancel:  cancel,
+	}
+	if token == nil {
+		return p, nil
+	}
+
+	p.token = *token
+	t.mu.Lock()
+	t.running[token.Value] = cancel
+	t.mu.Unlock()
+
+	err := p.notify(&WorkDoneProgressBegin{
+		Kind:        "begin",
+		Title:       title,
+		Cancellable: &cancellable,
+	})
+	if err != nil {
+		p.stop()
+		return nil, err
+	}
+	return p, nil
+}
+
+// WorkDoneProgressCancel cancels the context of the progress identified by
+// params.Token. Unknown tokens are ignored.
+func (t *ProgressTracker) WorkDoneProgressCancel(ctx context.Context, params *WorkDoneProgressCancelParams) error {
+	t.mu.Lock()
+	cancel, ok := t.running[params.Token.Value]
+	t.mu.Unlock()
+	if ok {
+		cancel()
+	}
+	return nil
+}
+
+// A Progress reports the progress of a long running operation to the
+// client. It must be ended with End.
+type Progress struct {
+	tracker *ProgressTracker
+	token   ProgressToken
+	ctx     context.Context
+	cancel  context.CancelFunc
+}
+
+// Context returns the context of the progress. It is done when the client
+// canceled the progress or the progress ended.
+func (p *Progress) Context() context.Context {
+	return p.ctx
+}
+
+// Report reports the progress with an optional message and an optional
+// percentage between 0 and 100. A nil percentage leaves the percentage
+// shown by the client unchanged.
+func (p *Progress) Report(message string, percentage *uint32) error {
+	return p.notify(&WorkDoneProgressReport{
+		Kind:       "report",
+		Message:    optional(message),
+		Percentage: percentage,
+	})
+}
+
+// End ends the progress with an optional message.
+func (p *Progress) End(message string) error {
+	defer p.stop()
+	return p.notify(&WorkDoneProgressEnd{
+		Kind:    "end",
+		Message: optional(message),
+	})
+}
+
+// stop forgets the progress and cancels its context.
+func (p *Progress) stop() {
+	if p.token.Value != nil {
+		p.tracker.mu.Lock()
+		delete(p.tracker.running, p.token.Value)
+		p.tracker.mu.Unlock()
+	}
+	p.cancel()
+}
+
+// notify sends a $/progress notification, unless the progress has no
+// token.
+func (p *Progress) notify(value interface{}) error {
+	if p.token.Value == nil {
+		return nil
+	}
+	// Use a background context: notifications are sent even if the
+	// progress was canceled.
+	return p.tracker.client.Progress(context.Background(), &ProgressParams{Token: p.token, Value: value})
+}
+
+// DefaultPartialResultInterval is the minimal time between two partial
+// results sent by PartialResults.
+const DefaultPartialResultInterval = 100 * time.Millisecond
+
+// PartialResults streams the items of the result of a request to the
+// client using the partial result token of the request. To reduce traffic,
+// items are collected and sent at most once per interval.
+type PartialResults[T any] struct {
+	client   Client
+	token    *ProgressToken
+	interval time.Duration
+
+	mu    sync.Mutex
+	items []T
+	last  time.Time
+}
+
+// NewPartialResults returns a stream of partial results for token. If token
+// is nil, all items are collected and returned by Close. If interval is
+// zero, DefaultPartialResultInterval is used.
+func NewPartialResults[T any](client Client, token *ProgressToken, interval time.Duration) *PartialResults[T] {
+	if interval == 0 {
+		interval = DefaultPartialResultInterval
+	}
+	return &PartialResults[T]{
+		client:   client,
+		token:    token,
+		interval: interval,
+		last:     time.Now(),
+	}
+}
+
+// Add adds items to the result. Collected items are sent if the last
+// partial result was sent more than an interval ago.
+func (r *PartialResults[T]) Add(ctx context.Context, items ...T) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.items = append(r.items, items...)
+	if r.token == nil || time.Since(r.last) < r.interval {
+		return nil
+	}
+	return r.flush(ctx)
+}
+
+// Close sends the remaining items. It returns the items to be used as
+// result of the request: all items, if there is no partial result token,
+// otherwise none, since the final result must be empty when partial results
+// were reported.
+func (r *PartialResults[T]) Close(ctx context.Context) ([]T, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.token == nil {
+		items := r.items
+		r.items = nil
+		return items, nil
+	}
+	return []T{}, r.flush(ctx)
+}
+
+func (r *PartialResults[T]) flush(ctx context.Context) error {
+	r.last = time.Now()
+	if len(r.items) == 0 {
+		return nil
+	}
+	items := r.items
+	r.items = nil
+	return r.client.Progress(ctx, &ProgressParams{Token: *r.token, Value: items})
+}
@@ -0,0 +1,123 @@
+package lsp
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+// progressClient is a client recording the progress it is sent as JSON.
+type progressClient struct {
+	Client
+	mu   sync.Mutex
+	msgs []string
+}
+
+func (c *progressClient) record(v interface{}) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	c.mu.Lock()
+	c.msgs = append(c.msgs, string(b))
+	c.mu.Unlock()
+	return nil
+}
+
+func (c *progressClient) WorkDoneProgressCreate(ctx context.Context, params *WorkDoneProgressCreateParams) error {
+	return c.record(params)
+}
+
+func (c *progressClient) Progress(ctx context.Context, params *ProgressParams) error {
+	return c.record(params)
+}
+
+func TestProgress(t *testing.T) {
+	c := &progressClient{}
+	tr := NewProgressTracker(c)
+	p, err := tr.Start(context.Background(), "Indexing", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	zero, half := uint32(0), uint32(50)
+	p.Report("", &zero)
+	p.Report("half", &half)
+	p.Report("more", nil)
+
+	tr.WorkDoneProgressCancel(context.Background(), &WorkDoneProgressCancelParams{Token: ProgressToken{Value: "unknown"}})
+	if p.Context().Err() != nil {
+		t.Fatal("canceled by unknown token")
+	}
+	tr.WorkDoneProgressCancel(context.Background(), &WorkDoneProgressCancelParams{Token: ProgressToken{Value: "progress-1"}})
+	if p.Context().Err() == nil {
+		t.Fatal("not canceled by its token")
+	}
+	p.End("done")
+
+	want := []string{
+		`{"token":"progress-1"}`,
+		`{"token":"progress-1","value":{"kind":"begin","title":"Indexing","cancellable":true}}`,
+		`{"token":"progress-1","value":{"kind":"report","percentage":0}}`,
+		`{"token":"progress-1","value":{"kind":"report","message":"half","percentage":50}}`,
+		`{"token":"progress-1","value":{"kind":"report","message":"more"}}`,
+		`{"token":"progress-1","value":{"kind":"end","message":"done"}}`,
+	}
+	if !reflect.DeepEqual(c.msgs, want) {
+		t.Errorf("got\n%q\nwant\n%q", c.msgs, want)
+	}
+	if len(tr.running) != 0 {
+		t.Errorf("%d progresses still running", len(tr.running))
+	}
+}
+
+func TestProgressWithoutToken(t *testing.T) {
+	c := &progressClient{}
+	p, err := NewProgressTracker(c).Begin(context.Background(), nil, "Indexing", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p.Report("", nil)
+	p.End("")
+	if len(c.msgs) != 0 {
+		t.Errorf("sent %q", c.msgs)
+	}
+	if p.Context().Err() == nil {
+		t.Error("context not canceled by End")
+	}
+}
+
+func TestPartialResults(t *testing.T) {
+	ctx := context.Background()
+	c := &progressClient{}
+	token := ProgressToken{Value: int32(7)}
+	r := NewPartialResults[Location](c, &token, 20*time.Millisecond)
+	r.Add(ctx, Location{Uri: "file:///a"})
+	time.Sleep(25 * time.Millisecond)
+	r.Add(ctx, Location{Uri: "file:///b"})
+	r.Add(ctx, Location{Uri: "file:///c"})
+	res, err := r.Close(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res == nil || len(res) != 0 {
+		t.Errorf("got result %v, want empty", res)
+	}
+	r0 := `{"start":{"line":0,"character":0},"end":{"line":0,"character":0}}`
+	want := []string{
+		`{"token":7,"value":[{"uri":"file:///a","range":` + r0 + `},{"uri":"file:///b","range":` + r0 + `}]}`,
+		`{"token":7,"value":[{"uri":"file:///c","range":` + r0 + `}]}`,
+	}
+	if !reflect.DeepEqual(c.msgs, want) {
+		t.Errorf("got\n%q\nwant\n%q", c.msgs, want)
+	}
+
+	c = &progressClient{}
+	r = NewPartialResults[Location](c, nil, 0)
+	r.Add(ctx, Location{Uri: "file:///a"}, Location{Uri: "file:///b"})
+	if res, _ := r.Close(ctx); len(res) != 2 || len(c.msgs) != 0 {
+		t.Errorf("without token: got result %v, sent %q", res, c.msgs)
+	}
+}
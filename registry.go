@@ -0,0 +1,166 @@
+package lsp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// ErrStaticRegistration is returned by Registry.Register for methods the
+// client does not support to register dynamically. Such features must be
+// announced in the ServerCapabilities instead.
+var ErrStaticRegistration = errors.New("client does not support dynamic registration")
+
+// A Registry registers server features dynamically with
+// client/registerCapability and tracks the registrations by ID, so features
+// can be enabled and disabled at runtime.
+//
+// Dynamic registration is only used for methods for which the client
+// announced dynamicRegistration in its capabilities. Other features are
+// registered statically: StaticMethods returns the methods to include in the
+// ServerCapabilities.
+//
+// Registrations must not be sent before the client sent the initialized
+// notification.
+type Registry struct {
+	client   Client
+	features *ClientFeatures
+
+	mu   sync.Mutex
+	seq  int
+	regs map[string]Registration
+}
+
+// NewRegistry returns a registry sending registrations to client, whose
+// capabilities are described by features.
+func NewRegistry(client Client, features *ClientFeatures) *Registry {
+	return &Registry{
+		client:   client,
+		features: features,
+		regs:     make(map[string]Registration),
+	}
+}
+
+// Dynamic reports whether method can be registered dynamically.
+func (r *Registry) Dynamic(method string) bool {
+	return r.features.DynamicRegistration(method)
+}
+
+// StaticMethods returns the methods which cannot be registered dynamically
+// and must therefore be announced statically.
+func (r *Registry) StaticMethods(methods []string) []string {
+	var static []string
+	for _, m := range methods {
+		if !r.Dynamic(m) {
+			static = append(static, m)
+		}
+	}
+	return static
+}
+
+// Register registers method with the given registration options and
+// returns the ID of the registration. It returns ErrStaticRegistration if
+// the client does not support dynamic registration of method.
+func (r *Registry) Register(ctx context.Context, method string, options interface{}) (string, error) {
+	if !r.Dynamic(method) {
+		return "", fmt.Errorf("%w: %s", ErrStaticRegistration, method)
+	}
+
+	r.mu.Lock()
+	r.seq++
+	reg := Registration{
+		Id:              fmt.Sprintf("%s#%d", method, r.seq),
+		Method:          method,
+		RegisterOptions: options,
+	}
+	r.mu.Unlock()
+
+	if err := r.client.RegisterCapability(ctx, &RegistrationParams{Registrations: []Registration{reg}}); err != nil {
+		return "", err
+	}
+
+	r.mu.Lock()
+	r.regs[reg.Id] = reg
+	r.mu.Unlock()
+	return reg.Id, nil
+}
+
+// Unregister removes the registration with the given ID. Unknown IDs are
+// ignored.
+func (r *Registry) Unregister(ctx context.Context, id string) error {
+	r.mu.Lock()
+	reg, ok := r.regs[id]
+	r.mu.Unlock()
+	if !ok {
+		return nil
+	}
+	return r.unregister(ctx, []Registration{reg})
+}
+
+// UnregisterMethod removes all registrations of method.
+func (r *Registry) UnregisterMethod(ctx context.Context, method string) error {
+	var regs []Registration
+	for _, reg := range r.Registrations() {
+		if reg.Method == method {
+			regs = append(regs, reg)
+		}
+	}
+	if len(regs) == 0 {
+		return nil
+	}
+	return r.unregister(ctx, regs)
+}
+
+func (r *Registry) unregister(ctx context.Context, regs []Registration) error {
+	params := &UnregistrationParams{}
+	for _, reg := range regs {
+		params.Unregisterations = append(params.Unregisterations, Unregistration{Id: reg.Id, Method: reg.Method})
+	}
+	if err := r.client.UnregisterCapability(ctx, params); err != nil {
+		return err
+	}
+	r.mu.Lock()
+	for _, reg := range regs {
+		delete(r.regs, reg.Id)
+	}
+	r.mu.Unlock()
+	return nil
+}
+
+// Registrations returns the current registrations sorted by ID.
+func (r *Registry) Registrations() []Registration {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	regs := make([]Registration, 0, len(r.regs))
+	for _, reg := range r.regs {
+		regs = append(regs, reg)
+	}
+	sort.Slice(regs, func(i, j int) bool { return regs[i].Id < regs[j].Id })
+	return regs
+}
+
+// Registered reports whether method has a dynamic registration.
+func (r *Registry) Registered(method string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, reg := range r.regs {
+		if reg.Method == method {
+			return true
+		}
+	}
+	return false
+}
+
+// RegisterRequest registers the request described by t with typed
+// registration options.
+func RegisterRequest[P, R, PR, E, O any](ctx context.Context, r *Registry, t RequestType[P, R, PR, E, O], options O) (string, error) {
+	return r.Register(ctx, t.RegistrationMethod, options)
+}
+
+// RegisterNotification registers the notification described by t with
+// typed registration options.
+func RegisterNotification[P, O any](ctx context.Context, r *Registry, t NotificationType[P, O], options O) (string, error) {
+	return r.Register(ctx, t.RegistrationMethod, options)
+}
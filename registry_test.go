@@ -0,0 +1,160 @@
+package lsp
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// registryClient is a client recording registrations and
+// unregistrations.
+type registryClient struct {
+	Client
+	registered   []Registration
+	unregistered []Unregistration
+	err          error
+}
+
+func (c *registryClient) RegisterCapability(ctx context.Context, params *RegistrationParams) error {
+	if c.err != nil {
+		return c.err
+	}
+	c.registered = append(c.registered, params.Registrations...)
+	return nil
+}
+
+func (c *registryClient) UnregisterCapability(ctx context.Context, params *UnregistrationParams) error {
+	if c.err != nil {
+		return c.err
+	}
+	c.unregistered = append(c.unregistered, params.Unregisterations...)
+	return nil
+}
+
+func newTestRegistry(t *testing.T) (*Registry, *registryClient) {
+	t.Helper()
+	features, err := NewClientFeatures(&ClientCapabilities{
+		TextDocument: &TextDocumentClientCapabilities{
+			Hover:      &HoverClientCapabilities{DynamicRegistration: ptr(true)},
+			Completion: &CompletionClientCapabilities{DynamicRegistration: ptr(false)},
+		},
+		NotebookDocument: &NotebookDocumentClientCapabilities{
+			Synchronization: NotebookDocumentSyncClientCapabilities{DynamicRegistration: ptr(true)},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := &registryClient{}
+	return NewRegistry(c, features), c
+}
+
+func TestRegistryDynamic(t *testing.T) {
+	r, _ := newTestRegistry(t)
+	tests := []struct {
+		method string
+		want   bool
+	}{
+		{MethodTextDocumentHover, true},
+		{MethodTextDocumentCompletion, false},
+		{MethodTextDocumentDefinition, false},
+		{"notebookDocument/sync", true},
+		{MethodNotebookDocumentDidOpen, true},
+		{MethodTextDocumentDidOpen, false},
+	}
+	for _, tt := range tests {
+		if got := r.Dynamic(tt.method); got != tt.want {
+			t.Errorf("Dynamic(%s) = %v, want %v", tt.method, got, tt.want)
+		}
+	}
+
+	methods := []string{MethodTextDocumentHover, MethodTextDocumentCompletion, "notebookDocument/sync"}
+	if got, want := r.StaticMethods(methods), []string{MethodTextDocumentCompletion}; !reflect.DeepEqual(got, want) {
+		t.Errorf("StaticMethods(%v) = %v, want %v", methods, got, want)
+	}
+}
+
+func TestRegistryRegister(t *testing.T) {
+	r, c := newTestRegistry(t)
+	ctx := context.Background()
+
+	hover1, err := RegisterRequest(ctx, r, TextDocumentHoverRequest, &HoverRegistrationOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	hover2, err := r.Register(ctx, MethodTextDocumentHover, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sync, err := r.Register(ctx, NotebookDocumentDidOpenNotification.RegistrationMethod, &NotebookDocumentSyncRegistrationOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hover1 == hover2 || hover1 == "" || hover2 == "" || sync == "" {
+		t.Fatalf("got registration IDs %q, %q and %q", hover1, hover2, sync)
+	}
+	if len(c.registered) != 3 || c.registered[0].Id != hover1 || c.registered[2].Method != "notebookDocument/sync" {
+		t.Errorf("registered %+v", c.registered)
+	}
+	if len(r.Registrations()) != 3 || !r.Registered(MethodTextDocumentHover) {
+		t.Errorf("got registrations %+v", r.Registrations())
+	}
+
+	if _, err := r.Register(ctx, MethodTextDocumentCompletion, nil); !errors.Is(err, ErrStaticRegistration) {
+		t.Errorf("register completion: got %v, want ErrStaticRegistration", err)
+	}
+	if len(c.registered) != 3 || r.Registered(MethodTextDocumentCompletion) {
+		t.Errorf("static method was registered: %+v", c.registered)
+	}
+
+	c.err = errors.New("failed")
+	if _, err := r.Register(ctx, MethodTextDocumentHover, nil); err != c.err {
+		t.Errorf("failed registration: got %v", err)
+	}
+	if len(r.Registrations()) != 3 {
+		t.Errorf("failed registration was recorded: %+v", r.Registrations())
+	}
+}
+
+func TestRegistryUnregister(t *testing.T) {
+	r, c := newTestRegistry(t)
+	ctx := context.Background()
+	hover1, _ := r.Register(ctx, MethodTextDocumentHover, nil)
+	hover2, _ := r.Register(ctx, MethodTextDocumentHover, nil)
+	hover3, _ := r.Register(ctx, MethodTextDocumentHover, nil)
+	sync, _ := r.Register(ctx, "notebookDocument/sync", nil)
+
+	if err := r.Unregister(ctx, hover1); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.Unregister(ctx, "unknown"); err != nil {
+		t.Fatal(err)
+	}
+	want := []Unregistration{{Id: hover1, Method: MethodTextDocumentHover}}
+	if !reflect.DeepEqual(c.unregistered, want) {
+		t.Errorf("unregistered %+v, want %+v", c.unregistered, want)
+	}
+
+	if err := r.UnregisterMethod(ctx, MethodTextDocumentHover); err != nil {
+		t.Fatal(err)
+	}
+	want = append(want, Unregistration{Id: hover2, Method: MethodTextDocumentHover}, Unregistration{Id: hover3, Method: MethodTextDocumentHover})
+	if !reflect.DeepEqual(c.unregistered, want) {
+		t.Errorf("unregistered %+v, want %+v", c.unregistered, want)
+	}
+	if r.Registered(MethodTextDocumentHover) || !r.Registered("notebookDocument/sync") {
+		t.Errorf("got registrations %+v", r.Registrations())
+	}
+
+	// Nothing is sent for methods without registrations.
+	if err := r.UnregisterMethod(ctx, MethodTextDocumentHover); err != nil || len(c.unregistered) != 3 {
+		t.Errorf("unregistered %+v: %v", c.unregistered, err)
+	}
+
+	// Registrations are kept, if the client fails.
+	c.err = errors.New("failed")
+	if err := r.Unregister(ctx, sync); err != c.err || !r.Registered("notebookDocument/sync") {
+		t.Errorf("failed unregistration: %v, registrations %+v", err, r.Registrations())
+	}
+}
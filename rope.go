@@ -0,0 +1,304 @@
+package lsp
+
+import "strings"
+
+// Leaves of a rope hold at most maxLeaf bytes. Text is split into leaves of
+// half that size, so small edits can be merged into the leaves.
+const maxLeaf = 1024
+
+// A rope is an immutable, height balanced binary tree of text chunks. Edits
+// copy the path to the changed leaves only, so they take O(log n) time and
+// leave older ropes intact. A nil rope is empty.
+//
+// Every node summarizes its text for line lookups. A line starts after
+// "\n", "\r\n" and a lone "\r". Whether a "\r" at the end of a node starts a
+// line depends on the next node, hence the first and last bytes are
+// recorded, too.
+type rope struct {
+	left, right *rope  // nil for leaves
+	leaf        string // text of leaves
+
+	n      int  // length in bytes
+	starts int  // line starts in (0, n] assuming the text ends after n
+	first  byte // first byte of the text
+	last   byte // last byte of the text
+	height int
+}
+
+// newRope returns a balanced rope for s.
+func newRope(s string) *rope {
+	if s == "" {
+		return nil
+	}
+	var leaves []*rope
+	for len(s) > maxLeaf/2 {
+		leaves = append(leaves, newLeaf(s[:maxLeaf/2]))
+		s = s[maxLeaf/2:]
+	}
+	leaves = append(leaves, newLeaf(s))
+	for len(leaves) > 1 {
+		var level []*rope
+		for i := 0; i < len(leaves); i += 2 {
+			if i+1 == len(leaves) {
+				level = append(level, leaves[i])
+				break
+			}
+			level = append(level, newNode(leaves[i], leaves[i+1]))
+		}
+		leaves = level
+	}
+	return leaves[0]
+}
+
+func newLeaf(s string) *rope {
+	if s == "" {
+		return nil
+	}
+	return &rope{
+		leaf:   s,
+		n:      len(s),
+		starts: countStarts(s, len(s), 0),
+		first:  s[0],
+		last:   s[len(s)-1],
+	}
+}
+
+func newNode(l, r *rope) *rope {
+	h := l.height
+	if r.height > h {
+		h = r.height
+	}
+	return &rope{
+		left:   l,
+		right:  r,
+		n:      l.n + r.n,
+		starts: l.starts - crlf(l.last, r.first) + r.starts,
+		first:  l.first,
+		last:   r.last,
+		height: h + 1,
+	}
+}
+
+// crlf returns 1 if a line break "\r\n" spans the bytes a and b.
+func crlf(a, b byte) int {
+	if a == '\r' && b == '\n' {
+		return 1
+	}
+	return 0
+}
+
+// countStarts returns the number of line starts in (0, offset] of s, which
+// is followed by the byte next. A zero next means the end of the text.
+func countStarts(s string, offset int, next byte) int {
+	n := 0
+	for i := 0; i < offset; i++ {
+		switch s[i] {
+		case '\n':
+			n++
+		case '\r':
+			c := next
+			if i+1 < len(s) {
+				c = s[i+1]
+			}
+			if c != '\n' {
+				n++
+			}
+		}
+	}
+	return n
+}
+
+func (t *rope) len() int {
+	if t == nil {
+		return 0
+	}
+	return t.n
+}
+
+func (t *rope) depth() int {
+	if t == nil {
+		return -1
+	}
+	return t.height
+}
+
+// String returns the text of t.
+func (t *rope) String() string {
+	var b strings.Builder
+	b.Grow(t.len())
+	t.write(&b, 0, t.len())
+	return b.String()
+}
+
+// slice returns the text between the offsets start and end.
+func (t *rope) slice(start, end int) string {
+	if t != nil && t.left == nil {
+		return t.leaf[start:end]
+	}
+	var b strings.Builder
+	b.Grow(end - start)
+	t.write(&b, start, end)
+	return b.String()
+}
+
+func (t *rope) write(b *strings.Builder, start, end int) {
+	if t == nil || start >= end {
+		return
+	}
+	if t.left == nil {
+		b.WriteString(t.leaf[start:end])
+		return
+	}
+	if start < t.left.n {
+		t.left.write(b, start, min(end, t.left.n))
+	}
+	if end > t.left.n {
+		t.right.write(b, max(start-t.left.n, 0), end-t.left.n)
+	}
+}
+
+// byteAt returns the byte at offset i.
+func (t *rope) byteAt(i int) byte {
+	for t.left != nil {
+		if i < t.left.n {
+			t = t.left
+		} else {
+			i -= t.left.n
+			t = t.right
+		}
+	}
+	return t.leaf[i]
+}
+
+// lines returns the number of lines of t.
+func (t *rope) lines() int {
+	if t == nil {
+		return 1
+	}
+	return t.starts + 1
+}
+
+// lineStart returns the offset of the k-th line start in t, which is
+// followed by the byte next. Line 0 starts at offset 0.
+func (t *rope) lineStart(k int, next byte) int {
+	if k == 0 {
+		return 0
+	}
+	offset := 0
+	for t.left != nil {
+		n := t.left.starts - crlf(t.left.last, t.right.first)
+		if k <= n {
+			next = t.right.first
+			t = t.left
+		} else {
+			k -= n
+			offset += t.left.n
+			t = t.right
+		}
+	}
+	s := t.leaf
+	for i := 0; i < len(s); i++ {
+		if countStarts(s[i:], 1, next) == 1 {
+			if k--; k == 0 {
+				return offset + i + 1
+			}
+		}
+	}
+	return offset + len(s)
+}
+
+// line returns the number of line starts in (0, offset] of t, that is the
+// line containing offset.
+func (t *rope) line(offset int) int {
+	if t == nil {
+		return 0
+	}
+	var next byte
+	n := 0
+	for t.left != nil {
+		if offset <= t.left.n {
+			next = t.right.first
+			t = t.left
+		} else {
+			n += t.left.starts - crlf(t.left.last, t.right.first)
+			offset -= t.left.n
+			t = t.right
+		}
+	}
+	return n + countStarts(t.leaf, offset, next)
+}
+
+// join returns the concatenation of l and r.
+func join(l, r *rope) *rope {
+	switch {
+	case l == nil:
+		return r
+	case r == nil:
+		return l
+	case l.left == nil && r.left == nil && l.n+r.n <= maxLeaf:
+		return newLeaf(l.leaf + r.leaf)
+	case l.height > r.height+1:
+		return balance(l.left, join(l.right, r))
+	case r.height > l.height+1:
+		return balance(join(l, r.left), r.right)
+	}
+	return newNode(l, r)
+}
+
+// balance returns a node of l and r, whose heights differ by two at most,
+// restoring the balance by rotation.
+func balance(l, r *rope) *rope {
+	switch {
+	case l.depth() > r.depth()+1:
+		if l.left.depth() >= l.right.depth() {
+			return newNode(l.left, newNode(l.right, r))
+		}
+		return newNode(newNode(l.left, l.right.left), newNode(l.right.right, r))
+	case r.depth() > l.depth()+1:
+		if r.right.depth() >= r.left.depth() {
+			return newNode(newNode(l, r.left), r.right)
+		}
+		return newNode(newNode(l, r.left.left), newNode(r.left.right, r.right))
+	}
+	return newNode(l, r)
+}
+
+// split returns the text before and after offset.
+func (t *rope) split(offset int) (*rope, *rope) {
+	switch {
+	case t == nil:
+		return nil, nil
+	case offset <= 0:
+		return nil, t
+	case offset >= t.n:
+		return t, nil
+	case t.left == nil:
+		return newLeaf(t.leaf[:offset]), newLeaf(t.leaf[offset:])
+	case offset <= t.left.n:
+		l, r := t.left.split(offset)
+		return l, join(r, t.right)
+	}
+	l, r := t.right.split(offset - t.left.n)
+	return join(t.left, l), r
+}
+
+// replace returns a rope with the text between start and end replaced by s.
+func (t *rope) replace(start, end int, s string) *rope {
+	l, rest := t.split(start)
+	_, r := rest.split(end - start)
+	return join(join(l, newRope(s)), r)
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
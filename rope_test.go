@@ -0,0 +1,152 @@
+package lsp
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+// naiveText is the former representation of documents: a string with the
+// offsets of its lines, which are recomputed after every edit.
+type naiveText struct {
+	text  string
+	lines []int
+}
+
+func newNaiveText(text string) *naiveText {
+	lines := []int{0}
+	for i := 0; i < len(text); i++ {
+		switch text[i] {
+		case '\r':
+			if i+1 < len(text) && text[i+1] == '\n' {
+				i++
+			}
+			lines = append(lines, i+1)
+		case '\n':
+			lines = append(lines, i+1)
+		}
+	}
+	return &naiveText{text: text, lines: lines}
+}
+
+func (t *naiveText) offset(p Position) int {
+	if int(p.Line) >= len(t.lines) {
+		return len(t.text)
+	}
+	start, end := t.lines[p.Line], len(t.text)
+	if int(p.Line)+1 < len(t.lines) {
+		end = t.lines[p.Line+1]
+	}
+	for end > start && (t.text[end-1] == '\n' || t.text[end-1] == '\r') {
+		end--
+	}
+	return start + charOffset(t.text[start:end], p.Character, PositionEncodingKindUTF16)
+}
+
+func (t *naiveText) apply(r Range, s string) *naiveText {
+	start, end := t.offset(r.Start), t.offset(r.End)
+	return newNaiveText(t.text[:start] + s + t.text[end:])
+}
+
+func TestRope(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	pieces := []string{"", "a", "é", "😀", "\n", "\r", "\r\n", "\n\r", "line\r\nline", strings.Repeat("x", 700)}
+
+	x := NewLineIndex("", PositionEncodingKindUTF16)
+	want := newNaiveText("")
+	for i := 0; i < 5000; i++ {
+		start, end := rnd.Intn(len(want.text)+1), rnd.Intn(len(want.text)+1)
+		if start > end {
+			start, end = end, start
+		}
+		s := pieces[rnd.Intn(len(pieces))]
+		x = x.Replace(start, end, s)
+		want = newNaiveText(want.text[:start] + s + want.text[end:])
+
+		if got := x.Text(); got != want.text {
+			t.Fatalf("edit %d: text = %q, want %q", i, got, want.text)
+		}
+		if x.LineCount() != len(want.lines) {
+			t.Fatalf("edit %d: %d lines, want %d", i, x.LineCount(), len(want.lines))
+		}
+		for l, off := range want.lines {
+			if got := x.lineStart(l); got != off {
+				t.Fatalf("edit %d: line %d starts at %d, want %d", i, l, got, off)
+			}
+		}
+		for off := 0; off <= len(want.text); off += 1 + rnd.Intn(50) {
+			l := len(want.lines) - 1
+			for want.lines[l] > off {
+				l--
+			}
+			if got := x.line(off); got != l {
+				t.Fatalf("edit %d: offset %d in line %d, want %d", i, off, got, l)
+			}
+		}
+	}
+}
+
+// generatedText returns about size bytes of generated Go code.
+func generatedText(size int) string {
+	var b strings.Builder
+	for i := 0; b.Len() < size; i++ {
+		fmt.Fprintf(&b, "// Field%d is generated.\n\tField%d map[string]interface{} `json:\"field%d,omitempty\"`\n", i, i, i)
+	}
+	return b.String()
+}
+
+// changeStream returns a stream of didChange ranges, as sent by a client
+// while a user types and deletes at several places in a text with the
+// given number of lines.
+func changeStream(lines int, n int) []TextDocumentContentChangeEventRange {
+	rnd := rand.New(rand.NewSource(1))
+	changes := make([]TextDocumentContentChangeEventRange, 0, n)
+	var cursor Position
+	for len(changes) < n {
+		if len(changes)%100 == 0 {
+			cursor = Position{Line: uint32(rnd.Intn(lines)), Character: 4}
+		}
+		switch k := rnd.Intn(20); {
+		case k == 0:
+			changes = append(changes, TextDocumentContentChangeEventRange{Range: Range{Start: cursor, End: cursor}, Text: "\n\t"})
+			cursor = Position{Line: cursor.Line + 1, Character: 1}
+		case k < 3 && cursor.Character > 0:
+			start := Position{Line: cursor.Line, Character: cursor.Character - 1}
+			changes = append(changes, TextDocumentContentChangeEventRange{Range: Range{Start: start, End: cursor}})
+			cursor = start
+		default:
+			changes = append(changes, TextDocumentContentChangeEventRange{Range: Range{Start: cursor, End: cursor}, Text: "x"})
+			cursor.Character++
+		}
+	}
+	return changes
+}
+
+func BenchmarkEdits(b *testing.B) {
+	for _, size := range []int{64 << 10, 1 << 20, 8 << 20} {
+		text := generatedText(size)
+		changes := changeStream(strings.Count(text, "\n"), 1000)
+
+		b.Run(fmt.Sprintf("rope/%dKB", size>>10), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				doc := NewDocument("file:///a.go", "go", 1, text, PositionEncodingKindUTF16)
+				for j, c := range changes {
+					var err error
+					doc, err = doc.apply(int32(j+2), []TextDocumentContentChangeEvent{{Value: c}})
+					if err != nil {
+						b.Fatal(err)
+					}
+				}
+			}
+		})
+		b.Run(fmt.Sprintf("string/%dKB", size>>10), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				doc := newNaiveText(text)
+				for _, c := range changes {
+					doc = doc.apply(c.Range, c.Text)
+				}
+			}
+		})
+	}
+}
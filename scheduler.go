@@ -0,0 +1,216 @@
+package lsp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime"
+	"sync"
+	"time"
+)
+
+// DefaultAnalysisDelay is the time the Scheduler waits for further changes
+// of a document before analyzing it.
+const DefaultAnalysisDelay = 250 * time.Millisecond
+
+// A Scheduler analyzes documents in the background, so the handlers of the
+// text document synchronization notifications return immediately.
+//
+// Bursts of changes of a document are debounced: the document is analyzed
+// once no change arrived for the delay of the scheduler. A newer version of
+// a document cancels the pending or running analysis of the older version.
+// At most a fixed number of documents are analyzed concurrently.
+//
+// Analyses update the Diagnostics, which publishes changed diagnostics if
+// the client does not pull them. Otherwise the client is asked to refresh
+// its diagnostics, once all scheduled analyses completed. Errors of
+// analyses are reported to the client with window/logMessage.
+type Scheduler struct {
+	diags *Diagnostics
+	delay time.Duration
+	slots chan struct{} // of the worker pool
+
+	mu       sync.Mutex
+	ctx      context.Context
+	stop     context.CancelFunc
+	jobs     map[DocumentURI]*job // by normalized URI
+	analyzed bool                 // since the last refresh
+	wg       sync.WaitGroup
+}
+
+// job is a scheduled analysis of a document.
+type job struct {
+	timer  *time.Timer
+	cancel context.CancelFunc
+}
+
+// NewScheduler returns a scheduler updating d. If delay is zero,
+// DefaultAnalysisDelay is used. If workers is zero, up to GOMAXPROCS
+// documents are analyzed concurrently.
+func NewScheduler(d *Diagnostics, delay time.Duration, workers int) *Scheduler {
+	if delay == 0 {
+		delay = DefaultAnalysisDelay
+	}
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	ctx, stop := context.WithCancel(context.Background())
+	return &Scheduler{
+		diags: d,
+		delay: delay,
+		slots: make(chan struct{}, workers),
+		ctx:   ctx,
+		stop:  stop,
+		jobs:  make(map[DocumentURI]*job),
+	}
+}
+
+// Register registers the handlers of the text document synchronization
+// notifications with m, replacing those of the document store. They update
+// the store and schedule the analysis of opened and changed documents.
+func (s *Scheduler) Register(m *Mux) {
+	store := s.diags.store
+	HandleNotification(m, TextDocumentDidOpenNotification, s.DidOpen)
+	HandleNotification(m, TextDocumentDidChangeNotification, s.DidChange)
+	HandleNotification(m, TextDocumentDidSaveNotification, s.DidSave)
+	HandleNotification(m, TextDocumentWillSaveNotification, store.WillSave)
+	HandleNotification(m, TextDocumentDidCloseNotification, s.DidClose)
+}
+
+// DidOpen handles textDocument/didOpen. The document is analyzed without
+// delay.
+func (s *Scheduler) DidOpen(ctx context.Context, params *DidOpenTextDocumentParams) error {
+	if err := s.diags.store.DidOpen(ctx, params); err != nil {
+		return err
+	}
+	s.schedule(params.TextDocument.Uri, 0)
+	return nil
+}
+
+// DidChange handles textDocument/didChange. The document is analyzed after
+// the delay of the scheduler.
+func (s *Scheduler) DidChange(ctx context.Context, params *DidChangeTextDocumentParams) error {
+	if err := s.diags.store.DidChange(ctx, params); err != nil {
+		return err
+	}
+	s.Schedule(params.TextDocument.Uri)
+	return nil
+}
+
+// DidSave handles textDocument/didSave. The document is analyzed without
+// delay. Analyses of unchanged versions reuse their results.
+func (s *Scheduler) DidSave(ctx context.Context, params *DidSaveTextDocumentParams) error {
+	if err := s.diags.store.DidSave(ctx, params); err != nil {
+		return err
+	}
+	s.schedule(params.TextDocument.Uri, 0)
+	return nil
+}
+
+// DidClose handles textDocument/didClose. It cancels the analysis of the
+// document and clears its diagnostics.
+func (s *Scheduler) DidClose(ctx context.Context, params *DidCloseTextDocumentParams) error {
+	if err := s.diags.store.DidClose(ctx, params); err != nil {
+		return err
+	}
+	s.Cancel(params.TextDocument.Uri)
+	return s.diags.Clear(ctx, params.TextDocument.Uri)
+}
+
+// Schedule schedules the analysis of the document uri after the delay of
+// the scheduler. It replaces a pending or running analysis of the
+// document.
+func (s *Scheduler) Schedule(uri DocumentURI) {
+	s.schedule(uri, s.delay)
+}
+
+func (s *Scheduler) schedule(uri DocumentURI, delay time.Duration) {
+	key := uri.Normalize()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.ctx.Err() != nil {
+		return
+	}
+	s.cancel(key)
+	ctx, cancel := context.WithCancel(s.ctx)
+	j := &job{cancel: cancel}
+	s.jobs[key] = j
+	s.wg.Add(1)
+	j.timer = time.AfterFunc(delay, func() { s.run(ctx, key, j, uri) })
+}
+
+// Cancel cancels the pending or running analysis of the document uri.
+func (s *Scheduler) Cancel(uri DocumentURI) {
+	s.mu.Lock()
+	s.cancel(uri.Normalize())
+	s.mu.Unlock()
+}
+
+// cancel cancels the job of the normalized URI key. s.mu must be held.
+func (s *Scheduler) cancel(key DocumentURI) {
+	j, ok := s.jobs[key]
+	if !ok {
+		return
+	}
+	if j.timer.Stop() {
+		s.wg.Done()
+	}
+	j.cancel()
+	delete(s.jobs, key)
+}
+
+// Close cancels all analyses and waits for running analyses to return.
+// Documents are not scheduled after Close.
+func (s *Scheduler) Close() {
+	s.mu.Lock()
+	s.stop()
+	for key := range s.jobs {
+		s.cancel(key)
+	}
+	s.mu.Unlock()
+	s.wg.Wait()
+}
+
+// run runs the job j once a worker is available.
+func (s *Scheduler) run(ctx context.Context, key DocumentURI, j *job, uri DocumentURI) {
+	defer s.wg.Done()
+	defer j.cancel()
+
+	var err error
+	select {
+	case s.slots <- struct{}{}:
+		err = s.diags.Update(ctx, uri)
+		<-s.slots
+	case <-ctx.Done():
+	}
+	if ctx.Err() != nil || errors.Is(err, ErrUnknownDocument) {
+		// Replaced, canceled or closed.
+		s.finish(key, j, false)
+		return
+	}
+	if err != nil {
+		s.diags.client.LogMessage(s.ctx, &LogMessageParams{
+			Type:    MessageTypeError,
+			Message: fmt.Sprintf("analysis of %s failed: %v", uri, err),
+		})
+	}
+	s.finish(key, j, err == nil)
+}
+
+// finish removes j from the scheduled jobs. Once all jobs are done and
+// documents were analyzed, the client is asked to refresh its diagnostics.
+func (s *Scheduler) finish(key DocumentURI, j *job, analyzed bool) {
+	s.mu.Lock()
+	if s.jobs[key] == j {
+		delete(s.jobs, key)
+	}
+	s.analyzed = s.analyzed || analyzed
+	refresh := s.analyzed && len(s.jobs) == 0 && s.ctx.Err() == nil
+	if refresh {
+		s.analyzed = false
+	}
+	s.mu.Unlock()
+	if refresh {
+		s.diags.Refresh(s.ctx)
+	}
+}
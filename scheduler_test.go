@@ -0,0 +1,328 @@
+package lsp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// schedulerDelay is the short delay of the schedulers of the tests.
+const schedulerDelay = 20 * time.Millisecond
+
+// waitFor waits until cond holds.
+func waitFor(t *testing.T, what string, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatalf("timeout waiting for %s", what)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+// versionAnalyzer records the versions it analyzed and those whose
+// analysis was canceled. If block is set, analyses take until block is
+// closed or they are canceled.
+type versionAnalyzer struct {
+	block chan struct{}
+
+	mu       sync.Mutex
+	started  []int32
+	analyzed []int32
+	canceled []int32
+	running  int
+	max      int // of running
+}
+
+func (a *versionAnalyzer) analyze(ctx context.Context, doc *Document) ([]Diagnostic, error) {
+	a.mu.Lock()
+	a.started = append(a.started, doc.Version)
+	a.running++
+	if a.running > a.max {
+		a.max = a.running
+	}
+	a.mu.Unlock()
+	defer func() {
+		a.mu.Lock()
+		a.running--
+		a.mu.Unlock()
+	}()
+
+	if a.block != nil {
+		select {
+		case <-a.block:
+		case <-ctx.Done():
+			a.mu.Lock()
+			a.canceled = append(a.canceled, doc.Version)
+			a.mu.Unlock()
+			return nil, ctx.Err()
+		}
+	}
+	a.mu.Lock()
+	a.analyzed = append(a.analyzed, doc.Version)
+	a.mu.Unlock()
+	return []Diagnostic{{Message: fmt.Sprint(doc.Version)}}, nil
+}
+
+// state returns copies of the recorded versions.
+func (a *versionAnalyzer) state() (started, analyzed, canceled []int32) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return append([]int32(nil), a.started...), append([]int32(nil), a.analyzed...), append([]int32(nil), a.canceled...)
+}
+
+// newTestScheduler returns a scheduler running a on the documents of a new
+// store. If pull is set, the client pulls diagnostics and supports
+// refreshing them.
+func newTestScheduler(t *testing.T, a Analyzer, pull bool, workers int) (*Scheduler, *diagnosticsClient) {
+	caps := &ClientCapabilities{}
+	if pull {
+		caps.TextDocument = &TextDocumentClientCapabilities{Diagnostic: &DiagnosticClientCapabilities{}}
+		caps.Workspace = &WorkspaceClientCapabilities{Diagnostics: &DiagnosticWorkspaceClientCapabilities{RefreshSupport: ptr(true)}}
+	}
+	features, err := NewClientFeatures(caps)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := &diagnosticsClient{}
+	d := NewDiagnostics(c, features, NewDocumentStore())
+	d.AddAnalyzer(nil, a)
+	s := NewScheduler(d, schedulerDelay, workers)
+	t.Cleanup(s.Close)
+	return s, c
+}
+
+func openDocument(t *testing.T, s *Scheduler, uri DocumentURI) {
+	t.Helper()
+	err := s.DidOpen(context.Background(), &DidOpenTextDocumentParams{TextDocument: TextDocumentItem{Uri: uri, LanguageId: "go", Version: 1}})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func changeDocument(t *testing.T, s *Scheduler, uri DocumentURI, version int32) {
+	t.Helper()
+	err := s.DidChange(context.Background(), &DidChangeTextDocumentParams{
+		TextDocument:   VersionedTextDocumentIdentifier{TextDocumentIdentifier: TextDocumentIdentifier{Uri: uri}, Version: version},
+		ContentChanges: []TextDocumentContentChangeEvent{{Value: TextDocumentContentChangeEventText{Text: fmt.Sprint(version)}}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+// publishedVersions returns the versions of the diagnostics published
+// for uri.
+func (c *diagnosticsClient) publishedVersions(uri DocumentURI) []int32 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var versions []int32
+	for _, p := range c.published {
+		if p.Uri == uri {
+			versions = append(versions, value(p.Version))
+		}
+	}
+	return versions
+}
+
+func (c *diagnosticsClient) logs() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]string(nil), c.logged...)
+}
+
+func (c *diagnosticsClient) refreshes() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.refreshed
+}
+
+func TestSchedulerDebounce(t *testing.T) {
+	a := &versionAnalyzer{}
+	s, c := newTestScheduler(t, a.analyze, false, 2)
+
+	// Opened documents are analyzed without delay.
+	openDocument(t, s, "file:///a")
+	waitFor(t, "analysis of the opened document", func() bool { return len(c.publishedVersions("file:///a")) == 1 })
+
+	// A burst of changes is analyzed once.
+	for v := int32(2); v <= 10; v++ {
+		changeDocument(t, s, "file:///a", v)
+	}
+	waitFor(t, "analysis of the last change", func() bool { return len(c.publishedVersions("file:///a")) == 2 })
+	time.Sleep(3 * schedulerDelay)
+	if _, analyzed, _ := a.state(); !reflect.DeepEqual(analyzed, []int32{1, 10}) {
+		t.Errorf("analyzed versions %v, want [1 10]", analyzed)
+	}
+	if got := c.publishedVersions("file:///a"); !reflect.DeepEqual(got, []int32{1, 10}) {
+		t.Errorf("published versions %v, want [1 10]", got)
+	}
+
+	// Closing a document cancels its pending analysis and clears its
+	// diagnostics.
+	changeDocument(t, s, "file:///a", 11)
+	if err := s.DidClose(context.Background(), &DidCloseTextDocumentParams{TextDocument: TextDocumentIdentifier{Uri: "file:///a"}}); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(3 * schedulerDelay)
+	if _, analyzed, _ := a.state(); len(analyzed) != 2 {
+		t.Errorf("closed document analyzed: %v", analyzed)
+	}
+	if got := c.publishedVersions("file:///a"); !reflect.DeepEqual(got, []int32{1, 10, 0}) {
+		t.Errorf("published versions %v, want [1 10 0]", got)
+	}
+}
+
+func TestSchedulerCancel(t *testing.T) {
+	a := &versionAnalyzer{block: make(chan struct{})}
+	s, c := newTestScheduler(t, a.analyze, false, 2)
+
+	openDocument(t, s, "file:///a")
+	waitFor(t, "start of the first analysis", func() bool {
+		started, _, _ := a.state()
+		return len(started) == 1
+	})
+
+	// A newer version cancels the running analysis.
+	changeDocument(t, s, "file:///a", 2)
+	waitFor(t, "cancellation of the first analysis", func() bool {
+		_, _, canceled := a.state()
+		return len(canceled) == 1
+	})
+	waitFor(t, "start of the second analysis", func() bool {
+		started, _, _ := a.state()
+		return len(started) == 2
+	})
+	close(a.block)
+	waitFor(t, "publication of the second analysis", func() bool { return len(c.publishedVersions("file:///a")) == 1 })
+
+	started, analyzed, canceled := a.state()
+	if !reflect.DeepEqual(started, []int32{1, 2}) || !reflect.DeepEqual(analyzed, []int32{2}) || !reflect.DeepEqual(canceled, []int32{1}) {
+		t.Errorf("started %v, analyzed %v, canceled %v", started, analyzed, canceled)
+	}
+	if got := c.publishedVersions("file:///a"); !reflect.DeepEqual(got, []int32{2}) {
+		t.Errorf("published versions %v, want [2]", got)
+	}
+	if logs := c.logs(); len(logs) != 0 {
+		t.Errorf("canceled analysis logged %q", logs)
+	}
+}
+
+func TestSchedulerWorkers(t *testing.T) {
+	const workers = 2
+	a := &versionAnalyzer{block: make(chan struct{})}
+	s, c := newTestScheduler(t, a.analyze, false, workers)
+
+	for i := 0; i < 6; i++ {
+		openDocument(t, s, DocumentURI(fmt.Sprintf("file:///%d", i)))
+	}
+	waitFor(t, "all workers to be busy", func() bool {
+		started, _, _ := a.state()
+		return len(started) == workers
+	})
+	time.Sleep(3 * schedulerDelay)
+	if started, _, _ := a.state(); len(started) != workers {
+		t.Errorf("%d analyses started with %d workers", len(started), workers)
+	}
+	close(a.block)
+	waitFor(t, "all analyses", func() bool {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		return len(c.published) == 6
+	})
+	a.mu.Lock()
+	if a.max > workers {
+		t.Errorf("%d analyses ran concurrently with %d workers", a.max, workers)
+	}
+	a.mu.Unlock()
+}
+
+func TestSchedulerRefresh(t *testing.T) {
+	a := &versionAnalyzer{block: make(chan struct{})}
+	s, c := newTestScheduler(t, a.analyze, true, 2)
+
+	// One refresh once all scheduled analyses completed.
+	for i := 0; i < 3; i++ {
+		openDocument(t, s, DocumentURI(fmt.Sprintf("file:///%d", i)))
+	}
+	close(a.block)
+	waitFor(t, "refresh", func() bool { return c.refreshes() == 1 })
+	time.Sleep(3 * schedulerDelay)
+	if n := c.refreshes(); n != 1 {
+		t.Errorf("%d refreshes after opening, want 1", n)
+	}
+	if _, analyzed, _ := a.state(); len(analyzed) != 3 {
+		t.Errorf("analyzed %v, want 3 documents", analyzed)
+	}
+	if len(c.published) != 0 {
+		t.Errorf("pulled diagnostics were published: %+v", c.published)
+	}
+
+	changeDocument(t, s, "file:///0", 2)
+	waitFor(t, "refresh after change", func() bool { return c.refreshes() == 2 })
+
+	// Saving an unchanged document does not analyze it again, but still
+	// refreshes.
+	err := s.DidSave(context.Background(), &DidSaveTextDocumentParams{TextDocument: TextDocumentIdentifier{Uri: "file:///0"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	waitFor(t, "refresh after save", func() bool { return c.refreshes() == 3 })
+	if _, analyzed, _ := a.state(); len(analyzed) != 4 {
+		t.Errorf("analyzed %v, want 4 analyses", analyzed)
+	}
+}
+
+func TestSchedulerErrors(t *testing.T) {
+	s, c := newTestScheduler(t, func(ctx context.Context, doc *Document) ([]Diagnostic, error) {
+		return nil, errors.New("broken")
+	}, true, 1)
+
+	openDocument(t, s, "file:///a")
+	waitFor(t, "logged error", func() bool { return len(c.logs()) == 1 })
+	if msg := c.logs()[0]; !strings.Contains(msg, "file:///a") || !strings.Contains(msg, "broken") {
+		t.Errorf("logged %q", msg)
+	}
+	time.Sleep(3 * schedulerDelay)
+	if n := c.refreshes(); n != 0 {
+		t.Errorf("%d refreshes after failed analysis", n)
+	}
+}
+
+func TestSchedulerClose(t *testing.T) {
+	a := &versionAnalyzer{block: make(chan struct{})}
+	s, c := newTestScheduler(t, a.analyze, false, 1)
+
+	openDocument(t, s, "file:///a")
+	openDocument(t, s, "file:///b")
+	changeDocument(t, s, "file:///a", 2)
+	waitFor(t, "start of an analysis", func() bool {
+		started, _, _ := a.state()
+		return len(started) == 1
+	})
+	s.Close()
+
+	started, analyzed, canceled := a.state()
+	a.mu.Lock()
+	running := a.running
+	a.mu.Unlock()
+	if running != 0 || len(analyzed) != 0 || !reflect.DeepEqual(canceled, started) {
+		t.Errorf("after Close: %d running, started %v, analyzed %v, canceled %v", running, started, analyzed, canceled)
+	}
+
+	// Documents are not scheduled after Close.
+	s.Schedule("file:///b")
+	time.Sleep(3 * schedulerDelay)
+	if got, _, _ := a.state(); len(got) != len(started) {
+		t.Errorf("analysis started after Close: %v", got)
+	}
+	if len(c.published) != 0 {
+		t.Errorf("published %+v", c.published)
+	}
+}
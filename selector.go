@@ -0,0 +1,229 @@
+package lsp
+
+import (
+	"net/url"
+	"path/filepath"
+)
+
+// filter is the common form of text document and notebook document
+// filters. Empty fields match everything.
+type filter struct {
+	language     string
+	notebookType string
+	scheme       string
+	pattern      string
+	notebook     *filter // of notebook cell filters
+}
+
+// target is a document matched by filters.
+type target struct {
+	uri          DocumentURI
+	language     string
+	notebookType string
+	notebook     *target // of notebook cells
+}
+
+// newTarget returns the target of the document uri with the given
+// language, which is a cell of nb, if nb is not nil.
+func newTarget(uri DocumentURI, languageID string, nb *Notebook) target {
+	t := target{uri: uri, language: languageID}
+	if nb != nil {
+		t.notebook = &target{uri: DocumentURI(nb.Uri), notebookType: nb.NotebookType}
+	}
+	return t
+}
+
+// Match reports whether the selector selects the text document uri with
+// the given language. Notebook cell filters never match. See Score.
+func (s DocumentSelector) Match(uri DocumentURI, languageID string) bool {
+	return s.Score(uri, languageID, nil) > 0
+}
+
+// MatchCell reports whether the selector selects the cell uri with the
+// given language of the notebook nb. See Score.
+func (s DocumentSelector) MatchCell(uri DocumentURI, languageID string, nb *Notebook) bool {
+	return s.Score(uri, languageID, nb) > 0
+}
+
+// Score returns how well the selector matches the document uri with the
+// given language, which is a cell of the notebook nb, if nb is not nil. A
+// score of 0 means no match, higher scores mean better matches. Like VS
+// Code, servers can route a request to the handler with the best matching
+// selector.
+//
+// Exact matches of the language, scheme or notebook type score 10, the
+// wildcard * scores 5. A pattern is matched against the path of the URI.
+// All fields of a filter must match and the score of a filter is the
+// highest score of its fields. The score of the selector is the highest
+// score of its filters.
+func (s DocumentSelector) Score(uri DocumentURI, languageID string, nb *Notebook) int {
+	t := newTarget(uri, languageID, nb)
+	best := 0
+	for _, f := range s {
+		if n := f.score(t); n > best {
+			best = n
+		}
+	}
+	return best
+}
+
+// Score returns how well the filter matches a document. See
+// DocumentSelector.Score.
+func (f DocumentFilter) Score(uri DocumentURI, languageID string, nb *Notebook) int {
+	return f.score(newTarget(uri, languageID, nb))
+}
+
+func (f DocumentFilter) score(t target) int {
+	var ff filter
+	switch v := f.Value.(type) {
+	case string:
+		// Deprecated form of a language filter.
+		ff.language = v
+	case TextDocumentFilter:
+		ff = textFilter(v)
+	case *TextDocumentFilter:
+		ff = textFilter(*v)
+	case NotebookCellTextDocumentFilter:
+		ff = cellFilter(v)
+	case *NotebookCellTextDocumentFilter:
+		ff = cellFilter(*v)
+	}
+	return ff.score(t)
+}
+
+// Match reports whether the filter matches the text document uri with the
+// given language.
+func (f TextDocumentFilter) Match(uri DocumentURI, languageID string) bool {
+	return f.Score(uri, languageID) > 0
+}
+
+// Score returns how well the filter matches the text document uri with the
+// given language. See DocumentSelector.Score.
+func (f TextDocumentFilter) Score(uri DocumentURI, languageID string) int {
+	ff := textFilter(f)
+	return ff.score(target{uri: uri, language: languageID})
+}
+
+// textFilter returns the common form of f.
+func textFilter(f TextDocumentFilter) filter {
+	switch v := f.Value.(type) {
+	case TextDocumentFilterLanguage:
+		return filter{language: v.Language, scheme: value(v.Scheme), pattern: value(v.Pattern)}
+	case *TextDocumentFilterLanguage:
+		return filter{language: v.Language, scheme: value(v.Scheme), pattern: value(v.Pattern)}
+	case TextDocumentFilterScheme:
+		return filter{language: value(v.Language), scheme: v.Scheme, pattern: value(v.Pattern)}
+	case *TextDocumentFilterScheme:
+		return filter{language: value(v.Language), scheme: v.Scheme, pattern: value(v.Pattern)}
+	case TextDocumentFilterPattern:
+		return filter{language: value(v.Language), scheme: value(v.Scheme), pattern: v.Pattern}
+	case *TextDocumentFilterPattern:
+		return filter{language: value(v.Language), scheme: value(v.Scheme), pattern: v.Pattern}
+	}
+	return filter{}
+}
+
+// Match reports whether the filter matches the notebook uri of the given
+// notebook type.
+func (f NotebookDocumentFilter) Match(uri URI, notebookType string) bool {
+	ff, _ := notebookFilter(f)
+	return ff.score(target{uri: DocumentURI(uri), notebookType: notebookType}) > 0
+}
+
+// notebookFilter returns the common form of f.
+func notebookFilter(f NotebookDocumentFilter) (filter, bool) {
+	switch v := f.Value.(type) {
+	case NotebookDocumentFilterNotebookType:
+		return filter{notebookType: v.NotebookType, scheme: value(v.Scheme), pattern: value(v.Pattern)}, true
+	case *NotebookDocumentFilterNotebookType:
+		return filter{notebookType: v.NotebookType, scheme: value(v.Scheme), pattern: value(v.Pattern)}, true
+	case NotebookDocumentFilterScheme:
+		return filter{notebookType: value(v.NotebookType), scheme: v.Scheme, pattern: value(v.Pattern)}, true
+	case *NotebookDocumentFilterScheme:
+		return filter{notebookType: value(v.NotebookType), scheme: v.Scheme, pattern: value(v.Pattern)}, true
+	case NotebookDocumentFilterPattern:
+		return filter{notebookType: value(v.NotebookType), scheme: value(v.Scheme), pattern: v.Pattern}, true
+	case *NotebookDocumentFilterPattern:
+		return filter{notebookType: value(v.NotebookType), scheme: value(v.Scheme), pattern: v.Pattern}, true
+	}
+	return filter{}, false
+}
+
+// cellFilter returns the common form of f.
+func cellFilter(f NotebookCellTextDocumentFilter) filter {
+	var nf filter
+	ok := false
+	switch v := f.Notebook.Value.(type) {
+	case string:
+		nf, ok = filter{notebookType: v}, true
+	case NotebookDocumentFilter:
+		nf, ok = notebookFilter(v)
+	case *NotebookDocumentFilter:
+		nf, ok = notebookFilter(*v)
+	}
+	if !ok {
+		// Matches nothing.
+		return filter{}
+	}
+	return filter{language: value(f.Language), notebook: &nf}
+}
+
+// score returns the score of t. Filters without fields match nothing.
+func (f filter) score(t target) int {
+	ret := 0
+	if f.notebook != nil {
+		if t.notebook == nil {
+			return 0
+		}
+		if ret = f.notebook.score(*t.notebook); ret == 0 {
+			return 0
+		}
+	}
+	for _, m := range []struct{ want, got string }{
+		{f.language, t.language},
+		{f.notebookType, t.notebookType},
+		{f.scheme, uriScheme(t.uri)},
+	} {
+		switch m.want {
+		case "":
+		case m.got:
+			ret = 10
+		case "*":
+			ret = max(ret, 5)
+		default:
+			return 0
+		}
+	}
+	if f.pattern != "" {
+		if !matchGlob(f.pattern, matchPath(t.uri)) {
+			return 0
+		}
+		ret = 10
+	}
+	return ret
+}
+
+// uriScheme returns the scheme of uri.
+func uriScheme(uri DocumentURI) string {
+	u, err := url.Parse(string(uri))
+	if err != nil {
+		return ""
+	}
+	return u.Scheme
+}
+
+// matchPath returns the path of uri matched by glob patterns. Paths of
+// file URIs are file system paths with forward slashes.
+func matchPath(uri DocumentURI) string {
+	if p, err := uri.Path(); err == nil {
+		return filepath.ToSlash(p)
+	}
+	u, err := url.Parse(string(uri))
+	if err != nil {
+		return string(uri)
+	}
+	if u.Opaque != "" {
+		return u.Opaque
+	}
+	return u.Path
+}
@@ -0,0 +1,117 @@
+package lsp
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestDocumentSelectorScore(t *testing.T) {
+	jupyter := &Notebook{NotebookDocument: NotebookDocument{Uri: "file:///a/n.ipynb", NotebookType: "jupyter-notebook"}}
+	other := &Notebook{NotebookDocument: NotebookDocument{Uri: "file:///a/n.ipynb", NotebookType: "other"}}
+	const cell = "vscode-notebook-cell:/a/n.ipynb#W0"
+
+	tests := []struct {
+		selector string
+		uri      DocumentURI
+		language string
+		nb       *Notebook
+		want     int
+	}{
+		{`[{"language":"go"}]`, "file:///a/b.go", "go", nil, 10},
+		{`[{"language":"go"}]`, "file:///a/b.go", "gomod", nil, 0},
+		{`[{"language":"*"}]`, "file:///a/b.go", "go", nil, 5},
+		{`[{"language":"*"},{"language":"go"}]`, "file:///a/b.go", "go", nil, 10},
+		{`[{"language":"*","scheme":"file"}]`, "file:///a/b.go", "go", nil, 10},
+		{`[{"language":"*","scheme":"untitled"}]`, "file:///a/b.go", "go", nil, 0},
+		{`[{"scheme":"*"}]`, "untitled:Untitled-1", "go", nil, 5},
+		{`[{"scheme":"untitled"}]`, "untitled:Untitled-1", "go", nil, 10},
+		{`[{"scheme":"file","pattern":"**/go.mod"}]`, "file:///a/go.mod", "gomod", nil, 10},
+		{`[{"scheme":"file","pattern":"**/go.mod"}]`, "untitled:go.mod", "gomod", nil, 0},
+		{`[{"pattern":"**/*.{go,mod}"}]`, "file:///a/go.mod", "gomod", nil, 10},
+		{`[{"pattern":"/a/*.go"}]`, "file:///a/b/c.go", "go", nil, 0},
+		{`[{"pattern":"**/b.go"}]`, "https://example.com/a/b.go", "go", nil, 10},
+		{`[]`, "file:///a/b.go", "go", nil, 0},
+
+		// Text document filters match cells, notebook cell filters only
+		// match cells.
+		{`[{"language":"python"}]`, cell, "python", jupyter, 10},
+		{`[{"notebook":"jupyter-notebook","language":"python"}]`, cell, "python", jupyter, 10},
+		{`[{"notebook":"jupyter-notebook","language":"python"}]`, cell, "python", nil, 0},
+		{`[{"notebook":"jupyter-notebook","language":"python"}]`, cell, "python", other, 0},
+		{`[{"notebook":"jupyter-notebook","language":"python"}]`, cell, "r", jupyter, 0},
+		{`[{"notebook":"jupyter-notebook"}]`, cell, "r", jupyter, 10},
+		{`[{"notebook":"*","language":"*"}]`, cell, "r", other, 5},
+		{`[{"notebook":"*","language":"python"}]`, cell, "python", other, 10},
+		{`[{"notebook":{"pattern":"**/*.ipynb"}}]`, cell, "python", other, 10},
+		{`[{"notebook":{"notebookType":"other","scheme":"file"},"language":"*"}]`, cell, "python", other, 10},
+		{`[{"notebook":{"scheme":"untitled"}}]`, cell, "python", other, 0},
+	}
+	for _, tt := range tests {
+		var s DocumentSelector
+		if err := json.Unmarshal([]byte(tt.selector), &s); err != nil {
+			t.Fatalf("%s: %v", tt.selector, err)
+		}
+		if got := s.Score(tt.uri, tt.language, tt.nb); got != tt.want {
+			t.Errorf("%s: Score(%s, %s, %v) = %d, want %d", tt.selector, tt.uri, tt.language, tt.nb != nil, got, tt.want)
+		}
+		if tt.nb == nil {
+			if got := s.Match(tt.uri, tt.language); got != (tt.want > 0) {
+				t.Errorf("%s: Match(%s, %s) = %v", tt.selector, tt.uri, tt.language, got)
+			}
+		} else if got := s.MatchCell(tt.uri, tt.language, tt.nb); got != (tt.want > 0) {
+			t.Errorf("%s: MatchCell(%s, %s) = %v", tt.selector, tt.uri, tt.language, got)
+		}
+	}
+}
+
+func TestDocumentFilterScore(t *testing.T) {
+	tests := []struct {
+		filter   DocumentFilter
+		language string
+		want     int
+	}{
+		// The deprecated string form of a language filter.
+		{DocumentFilter{Value: "go"}, "go", 10},
+		{DocumentFilter{Value: "go"}, "c", 0},
+		{DocumentFilter{Value: "*"}, "c", 5},
+		{DocumentFilter{Value: ""}, "go", 0},
+
+		{DocumentFilter{Value: &TextDocumentFilter{Value: &TextDocumentFilterLanguage{Language: "go"}}}, "go", 10},
+		{DocumentFilter{Value: TextDocumentFilter{Value: TextDocumentFilterScheme{Scheme: "file", Language: ptr("c")}}}, "go", 0},
+		{DocumentFilter{Value: TextDocumentFilter{Value: TextDocumentFilterPattern{Pattern: "**/*.go"}}}, "c", 10},
+		{DocumentFilter{Value: NotebookCellTextDocumentFilter{Notebook: Or_String_NotebookDocumentFilter{Value: "*"}}}, "go", 0},
+		{DocumentFilter{}, "go", 0},
+	}
+	for _, tt := range tests {
+		if got := tt.filter.Score("file:///a/b.go", tt.language, nil); got != tt.want {
+			t.Errorf("%+v: Score(%s) = %d, want %d", tt.filter.Value, tt.language, got, tt.want)
+		}
+	}
+
+	f := TextDocumentFilter{Value: TextDocumentFilterLanguage{Language: "go", Pattern: ptr("**/*_test.go")}}
+	if !f.Match("file:///a/b_test.go", "go") || f.Match("file:///a/b.go", "go") {
+		t.Error("text document filter with language and pattern")
+	}
+}
+
+func TestNotebookDocumentFilterMatch(t *testing.T) {
+	tests := []struct {
+		filter       NotebookDocumentFilter
+		uri          URI
+		notebookType string
+		want         bool
+	}{
+		{NotebookDocumentFilter{Value: NotebookDocumentFilterPattern{Pattern: "**/*.ipynb"}}, "file:///a/n.ipynb", "jupyter", true},
+		{NotebookDocumentFilter{Value: NotebookDocumentFilterPattern{Pattern: "**/*.ipynb"}}, "file:///a/n.txt", "jupyter", false},
+		{NotebookDocumentFilter{Value: &NotebookDocumentFilterNotebookType{NotebookType: "jupyter"}}, "untitled:1", "jupyter", true},
+		{NotebookDocumentFilter{Value: NotebookDocumentFilterNotebookType{NotebookType: "jupyter"}}, "untitled:1", "other", false},
+		{NotebookDocumentFilter{Value: NotebookDocumentFilterScheme{Scheme: "untitled", NotebookType: ptr("*")}}, "untitled:1", "other", true},
+		{NotebookDocumentFilter{Value: NotebookDocumentFilterScheme{Scheme: "untitled"}}, "file:///a/n.ipynb", "jupyter", false},
+		{NotebookDocumentFilter{}, "file:///a/n.ipynb", "jupyter", false},
+	}
+	for _, tt := range tests {
+		if got := tt.filter.Match(tt.uri, tt.notebookType); got != tt.want {
+			t.Errorf("%+v: Match(%s, %s) = %v, want %v", tt.filter.Value, tt.uri, tt.notebookType, got, tt.want)
+		}
+	}
+}
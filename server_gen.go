@@ -0,0 +1,822 @@
+package lsp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrMethodNotFound is returned by DispatchServer for methods not
+	// handled by the Server interface.
+	ErrMethodNotFound = errors.New("method not found")
+
+	// ErrInvalidParams is returned by DispatchServer if the parameters
+	// of a message cannot be decoded.
+	ErrInvalidParams = errors.New("invalid params")
+)
+
+// Server is the interface implemented by language servers. It has a method
+// for every request and notification sent from the client to the server.
+type Server interface {
+	// A request to resolve the implementation locations of a symbol at a given text
+	// document position. The request's parameter is of type [TextDocumentPositionParams]
+	// (#TextDocumentPositionParams) the response is of type {@link Definition} or a
+	// Thenable that resolves to such.
+	Implementation(ctx context.Context, params *ImplementationParams) (*Or_Definition_DefinitionLinkSlice, error)
+
+	// A request to resolve the type definition locations of a symbol at a given text
+	// document position. The request's parameter is of type [TextDocumentPositionParams]
+	// (#TextDocumentPositionParams) the response is of type {@link Definition} or a
+	// Thenable that resolves to such.
+	TypeDefinition(ctx context.Context, params *TypeDefinitionParams) (*Or_Definition_DefinitionLinkSlice, error)
+
+	// A request to list all color symbols found in a given text document. The request's
+	// parameter is of type {@link DocumentColorParams} the
+	// response is of type {@link ColorInformation ColorInformation[]} or a Thenable
+	// that resolves to such.
+	DocumentColor(ctx context.Context, params *DocumentColorParams) ([]ColorInformation, error)
+
+	// A request to list all presentation for a color. The request's
+	// parameter is of type {@link ColorPresentationParams} the
+	// response is of type {@link ColorInformation ColorInformation[]} or a Thenable
+	// that resolves to such.
+	ColorPresentation(ctx context.Context, params *ColorPresentationParams) ([]ColorPresentation, error)
+
+	// A request to provide folding ranges in a document. The request's
+	// parameter is of type {@link FoldingRangeParams}, the
+	// response is of type {@link FoldingRangeList} or a Thenable
+	// that resolves to such.
+	FoldingRange(ctx context.Context, params *FoldingRangeParams) ([]FoldingRange, error)
+
+	// A request to resolve the type definition locations of a symbol at a given text
+	// document position. The request's parameter is of type [TextDocumentPositionParams]
+	// (#TextDocumentPositionParams) the response is of type {@link Declaration}
+	// or a typed array of {@link DeclarationLink} or a Thenable that resolves
+	// to such.
+	Declaration(ctx context.Context, params *DeclarationParams) (*Or_Declaration_DeclarationLinkSlice, error)
+
+	// A request to provide selection ranges in a document. The request's
+	// parameter is of type {@link SelectionRangeParams}, the
+	// response is of type {@link SelectionRange SelectionRange[]} or a Thenable
+	// that resolves to such.
+	SelectionRange(ctx context.Context, params *SelectionRangeParams) ([]SelectionRange, error)
+
+	// A request to result a `CallHierarchyItem` in a document at a given position.
+	// Can be used as an input to an incoming or outgoing call hierarchy.
+	//
+	// @since 3.16.0
+	PrepareCallHierarchy(ctx context.Context, params *CallHierarchyPrepareParams) ([]CallHierarchyItem, error)
+
+	// A request to resolve the incoming calls for a given `CallHierarchyItem`.
+	//
+	// @since 3.16.0
+	IncomingCalls(ctx context.Context, params *CallHierarchyIncomingCallsParams) ([]CallHierarchyIncomingCall, error)
+
+	// A request to resolve the outgoing calls for a given `CallHierarchyItem`.
+	//
+	// @since 3.16.0
+	OutgoingCalls(ctx context.Context, params *CallHierarchyOutgoingCallsParams) ([]CallHierarchyOutgoingCall, error)
+
+	// @since 3.16.0
+	SemanticTokensFull(ctx context.Context, params *SemanticTokensParams) (*SemanticTokens, error)
+
+	// @since 3.16.0
+	SemanticTokensFullDelta(ctx context.Context, params *SemanticTokensDeltaParams) (*Or_SemanticTokens_SemanticTokensDelta, error)
+
+	// @since 3.16.0
+	SemanticTokensRange(ctx context.Context, params *SemanticTokensRangeParams) (*SemanticTokens, error)
+
+	// A request to provide ranges that can be edited together.
+	//
+	// @since 3.16.0
+	LinkedEditingRange(ctx context.Context, params *LinkedEditingRangeParams) (*LinkedEditingRanges, error)
+
+	// The will create files request is sent from the client to the server before files are actually
+	// created as long as the creation is triggered from within the client.
+	//
+	// @since 3.16.0
+	WillCreateFiles(ctx context.Context, params *CreateFilesParams) (*WorkspaceEdit, error)
+
+	// The will rename files request is sent from the client to the server before files are actually
+	// renamed as long as the rename is triggered from within the client.
+	//
+	// @since 3.16.0
+	WillRenameFiles(ctx context.Context, params *RenameFilesParams) (*WorkspaceEdit, error)
+
+	// The did delete files notification is sent from the client to the server when
+	// files were deleted from within the client.
+	//
+	// @since 3.16.0
+	WillDeleteFiles(ctx context.Context, params *DeleteFilesParams) (*WorkspaceEdit, error)
+
+	// A request to get the moniker of a symbol at a given text document position.
+	// The request parameter is of type {@link TextDocumentPositionParams}.
+	// The response is of type {@link Moniker Moniker[]} or `null`.
+	Moniker(ctx context.Context, params *MonikerParams) ([]Moniker, error)
+
+	// A request to result a `TypeHierarchyItem` in a document at a given position.
+	// Can be used as an input to a subtypes or supertypes type hierarchy.
+	//
+	// @since 3.17.0
+	PrepareTypeHierarchy(ctx context.Context, params *TypeHierarchyPrepareParams) ([]TypeHierarchyItem, error)
+
+	// A request to resolve the supertypes for a given `TypeHierarchyItem`.
+	//
+	// @since 3.17.0
+	Supertypes(ctx context.Context, params *TypeHierarchySupertypesParams) ([]TypeHierarchyItem, error)
+
+	// A request to resolve the subtypes for a given `TypeHierarchyItem`.
+	//
+	// @since 3.17.0
+	Subtypes(ctx context.Context, params *TypeHierarchySubtypesParams) ([]TypeHierarchyItem, error)
+
+	// A request to provide inline values in a document. The request's parameter is of
+	// type {@link InlineValueParams}, the response is of type
+	// {@link InlineValue InlineValue[]} or a Thenable that resolves to such.
+	//
+	// @since 3.17.0
+	InlineValue(ctx context.Context, params *InlineValueParams) ([]InlineValue, error)
+
+	// A request to provide inlay hints in a document. The request's parameter is of
+	// type {@link InlayHintsParams}, the response is of type
+	// {@link InlayHint InlayHint[]} or a Thenable that resolves to such.
+	//
+	// @since 3.17.0
+	InlayHint(ctx context.Context, params *InlayHintParams) ([]InlayHint, error)
+
+	// A request to resolve additional properties for an inlay hint.
+	// The request's parameter is of type {@link InlayHint}, the response is
+	// of type {@link InlayHint} or a Thenable that resolves to such.
+	//
+	// @since 3.17.0
+	InlayHintResolve(ctx context.Context, params *InlayHint) (*InlayHint, error)
+
+	// The document diagnostic request definition.
+	//
+	// @since 3.17.0
+	TextDocumentDiagnostic(ctx context.Context, params *DocumentDiagnosticParams) (*DocumentDiagnosticReport, error)
+
+	// The workspace diagnostic request definition.
+	//
+	// @since 3.17.0
+	WorkspaceDiagnostic(ctx context.Context, params *WorkspaceDiagnosticParams) (*WorkspaceDiagnosticReport, error)
+
+	// The initialize request is sent from the client to the server.
+	// It is sent once as the request after starting up the server.
+	// The requests parameter is of type {@link InitializeParams}
+	// the response if of type {@link InitializeResult} of a Thenable that
+	// resolves to such.
+	Initialize(ctx context.Context, params *InitializeParams) (*InitializeResult, error)
+
+	// A shutdown request is sent from the client to the server.
+	// It is sent once when the client decides to shutdown the
+	// server. The only notification that is sent after a shutdown request
+	// is the exit event.
+	Shutdown(ctx context.Context) error
+
+	// A document will save request is sent from the client to the server before
+	// the document is actually saved. The request can return an array of TextEdits
+	// which will be applied to the text document before it is saved. Please note that
+	// clients might drop results if computing the text edits took too long or if a
+	// server constantly fails on this request. This is done to keep the save fast and
+	// reliable.
+	WillSaveWaitUntil(ctx context.Context, params *WillSaveTextDocumentParams) ([]TextEdit, error)
+
+	// Request to request completion at a given text document position. The request's
+	// parameter is of type {@link TextDocumentPosition} the response
+	// is of type {@link CompletionItem CompletionItem[]} or {@link CompletionList}
+	// or a Thenable that resolves to such.
+	Completion(ctx context.Context, params *CompletionParams) (*Or_CompletionItemSlice_CompletionList, error)
+
+	// Request to resolve additional information for a given completion item.The request's
+	// parameter is of type {@link CompletionItem} the response
+	// is of type {@link CompletionItem} or a Thenable that resolves to such.
+	CompletionItemResolve(ctx context.Context, params *CompletionItem) (*CompletionItem, error)
+
+	// Request to request hover information at a given text document position. The request's
+	// parameter is of type {@link TextDocumentPosition} the response is of
+	// type {@link Hover} or a Thenable that resolves to such.
+	Hover(ctx context.Context, params *HoverParams) (*Hover, error)
+	SignatureHelp(ctx context.Context, params *SignatureHelpParams) (*SignatureHelp, error)
+
+	// A request to resolve the definition location of a symbol at a given text
+	// document position. The request's parameter is of type [TextDocumentPosition]
+	// (#TextDocumentPosition) the response is of either type {@link Definition}
+	// or a typed array of {@link DefinitionLink} or a Thenable that resolves
+	// to such.
+	Definition(ctx context.Context, params *DefinitionParams) (*Or_Definition_DefinitionLinkSlice, error)
+
+	// A request to resolve project-wide references for the symbol denoted
+	// by the given text document position. The request's parameter is of
+	// type {@link ReferenceParams} the response is of type
+	// {@link Location Location[]} or a Thenable that resolves to such.
+	References(ctx context.Context, params *ReferenceParams) ([]Location, error)
+
+	// Request to resolve a {@link DocumentHighlight} for a given
+	// text document position. The request's parameter is of type [TextDocumentPosition]
+	// (#TextDocumentPosition) the request response is of type [DocumentHighlight[]]
+	// (#DocumentHighlight) or a Thenable that resolves to such.
+	DocumentHighlight(ctx context.Context, params *DocumentHighlightParams) ([]DocumentHighlight, error)
+
+	// A request to list all symbols found in a given text document. The request's
+	// parameter is of type {@link TextDocumentIdentifier} the
+	// response is of type {@link SymbolInformation SymbolInformation[]} or a Thenable
+	// that resolves to such.
+	DocumentSymbol(ctx context.Context, params *DocumentSymbolParams) (*Or_SymbolInformationSlice_DocumentSymbolSlice, error)
+
+	// A request to provide commands for the given text document and range.
+	CodeAction(ctx context.Context, params *CodeActionParams) ([]Or_Command_CodeAction, error)
+
+	// Request to resolve additional information for a given code action.The request's
+	// parameter is of type {@link CodeAction} the response
+	// is of type {@link CodeAction} or a Thenable that resolves to such.
+	CodeActionResolve(ctx context.Context, params *CodeAction) (*CodeAction, error)
+
+	// A request to list project-wide symbols matching the query string given
+	// by the {@link WorkspaceSymbolParams}. The response is
+	// of type {@link SymbolInformation SymbolInformation[]} or a Thenable that
+	// resolves to such.
+	//
+	// @since 3.17.0 - support for WorkspaceSymbol in the returned data. Clients
+	//  need to advertise support for WorkspaceSymbols via the client capability
+	//  `workspace.symbol.resolveSupport`.
+	Symbol(ctx context.Context, params *WorkspaceSymbolParams) (*Or_SymbolInformationSlice_WorkspaceSymbolSlice, error)
+
+	// A request to resolve the range inside the workspace
+	// symbol's location.
+	//
+	// @since 3.17.0
+	WorkspaceSymbolResolve(ctx context.Context, params *WorkspaceSymbol) (*WorkspaceSymbol, error)
+
+	// A request to provide code lens for the given text document.
+	CodeLens(ctx context.Context, params *CodeLensParams) ([]CodeLens, error)
+
+	// A request to resolve a command for a given code lens.
+	CodeLensResolve(ctx context.Context, params *CodeLens) (*CodeLens, error)
+
+	// A request to provide document links
+	DocumentLink(ctx context.Context, params *DocumentLinkParams) ([]DocumentLink, error)
+
+	// Request to resolve additional information for a given document link. The request's
+	// parameter is of type {@link DocumentLink} the response
+	// is of type {@link DocumentLink} or a Thenable that resolves to such.
+	DocumentLinkResolve(ctx context.Context, params *DocumentLink) (*DocumentLink, error)
+
+	// A request to to format a whole document.
+	Formatting(ctx context.Context, params *DocumentFormattingParams) ([]TextEdit, error)
+
+	// A request to to format a range in a document.
+	RangeFormatting(ctx context.Context, params *DocumentRangeFormattingParams) ([]TextEdit, error)
+
+	// A request to format a document on type.
+	OnTypeFormatting(ctx context.Context, params *DocumentOnTypeFormattingParams) ([]TextEdit, error)
+
+	// A request to rename a symbol.
+	Rename(ctx context.Context, params *RenameParams) (*WorkspaceEdit, error)
+
+	// A request to test and perform the setup necessary for a rename.
+	//
+	// @since 3.16 - support for default behavior
+	PrepareRename(ctx context.Context, params *PrepareRenameParams) (*PrepareRenameResult, error)
+
+	// A request send from the client to the server to execute a command. The request might return
+	// a workspace edit which the client will apply to the workspace.
+	ExecuteCommand(ctx context.Context, params *ExecuteCommandParams) (interface{}, error)
+
+	// The `workspace/didChangeWorkspaceFolders` notification is sent from the client to the server when the workspace
+	// folder configuration changes.
+	DidChangeWorkspaceFolders(ctx context.Context, params *DidChangeWorkspaceFoldersParams) error
+
+	// The `window/workDoneProgress/cancel` notification is sent from  the client to the server to cancel a progress
+	// initiated on the server side.
+	WorkDoneProgressCancel(ctx context.Context, params *WorkDoneProgressCancelParams) error
+
+	// The did create files notification is sent from the client to the server when
+	// files were created from within the client.
+	//
+	// @since 3.16.0
+	DidCreateFiles(ctx context.Context, params *CreateFilesParams) error
+
+	// The did rename files notification is sent from the client to the server when
+	// files were renamed from within the client.
+	//
+	// @since 3.16.0
+	DidRenameFiles(ctx context.Context, params *RenameFilesParams) error
+
+	// The will delete files request is sent from the client to the server before files are actually
+	// deleted as long as the deletion is triggered from within the client.
+	//
+	// @since 3.16.0
+	DidDeleteFiles(ctx context.Context, params *DeleteFilesParams) error
+
+	// A notification sent when a notebook opens.
+	//
+	// @since 3.17.0
+	NotebookDocumentDidOpen(ctx context.Context, params *DidOpenNotebookDocumentParams) error
+	NotebookDocumentDidChange(ctx context.Context, params *DidChangeNotebookDocumentParams) error
+
+	// A notification sent when a notebook document is saved.
+	//
+	// @since 3.17.0
+	NotebookDocumentDidSave(ctx context.Context, params *DidSaveNotebookDocumentParams) error
+
+	// A notification sent when a notebook closes.
+	//
+	// @since 3.17.0
+	NotebookDocumentDidClose(ctx context.Context, params *DidCloseNotebookDocumentParams) error
+
+	// The initialized notification is sent from the client to the
+	// server after the client is fully initialized and the server
+	// is allowed to send requests from the server to the client.
+	Initialized(ctx context.Context, params *InitializedParams) error
+
+	// The exit event is sent from the client to the server to
+	// ask the server to exit its process.
+	Exit(ctx context.Context) error
+
+	// The configuration change notification is sent from the client to the server
+	// when the client's configuration has changed. The notification contains
+	// the changed configuration as defined by the language client.
+	DidChangeConfiguration(ctx context.Context, params *DidChangeConfigurationParams) error
+
+	// The document open notification is sent from the client to the server to signal
+	// newly opened text documents. The document's truth is now managed by the client
+	// and the server must not try to read the document's truth using the document's
+	// uri. Open in this sense means it is managed by the client. It doesn't necessarily
+	// mean that its content is presented in an editor. An open notification must not
+	// be sent more than once without a corresponding close notification send before.
+	// This means open and close notification must be balanced and the max open count
+	// is one.
+	TextDocumentDidOpen(ctx context.Context, params *DidOpenTextDocumentParams) error
+
+	// The document change notification is sent from the client to the server to signal
+	// changes to a text document.
+	TextDocumentDidChange(ctx context.Context, params *DidChangeTextDocumentParams) error
+
+	// The document close notification is sent from the client to the server when
+	// the document got closed in the client. The document's truth now exists where
+	// the document's uri points to (e.g. if the document's uri is a file uri the
+	// truth now exists on disk). As with the open notification the close notification
+	// is about managing the document's content. Receiving a close notification
+	// doesn't mean that the document was open in an editor before. A close
+	// notification requires a previous open notification to be sent.
+	TextDocumentDidClose(ctx context.Context, params *DidCloseTextDocumentParams) error
+
+	// The document save notification is sent from the client to the server when
+	// the document got saved in the client.
+	TextDocumentDidSave(ctx context.Context, params *DidSaveTextDocumentParams) error
+
+	// A document will save notification is sent from the client to the server before
+	// the document is actually saved.
+	WillSave(ctx context.Context, params *WillSaveTextDocumentParams) error
+
+	// The watched files notification is sent from the client to the server when
+	// the client detects changes to file watched by the language client.
+	DidChangeWatchedFiles(ctx context.Context, params *DidChangeWatchedFilesParams) error
+	SetTrace(ctx context.Context, params *SetTraceParams) error
+	CancelRequest(ctx context.Context, params *CancelParams) error
+	Progress(ctx context.Context, params *ProgressParams) error
+}
+
+// DispatchServer decodes the parameters of the request or notification
+// method, invokes the corresponding method of server and returns its
+// encoded result. Notifications have no result.
+func DispatchServer(ctx context.Context, server Server, method string, params json.RawMessage) (json.RawMessage, error) {
+	switch method {
+	case MethodTextDocumentImplementation:
+		p, err := TextDocumentImplementationRequest.DecodeParams(params)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrInvalidParams, method, err)
+		}
+		return encodeResult(server.Implementation(ctx, p))
+	case MethodTextDocumentTypeDefinition:
+		p, err := TextDocumentTypeDefinitionRequest.DecodeParams(params)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrInvalidParams, method, err)
+		}
+		return encodeResult(server.TypeDefinition(ctx, p))
+	case MethodTextDocumentDocumentColor:
+		p, err := TextDocumentDocumentColorRequest.DecodeParams(params)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrInvalidParams, method, err)
+		}
+		return encodeResult(server.DocumentColor(ctx, p))
+	case MethodTextDocumentColorPresentation:
+		p, err := TextDocumentColorPresentationRequest.DecodeParams(params)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrInvalidParams, method, err)
+		}
+		return encodeResult(server.ColorPresentation(ctx, p))
+	case MethodTextDocumentFoldingRange:
+		p, err := TextDocumentFoldingRangeRequest.DecodeParams(params)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrInvalidParams, method, err)
+		}
+		return encodeResult(server.FoldingRange(ctx, p))
+	case MethodTextDocumentDeclaration:
+		p, err := TextDocumentDeclarationRequest.DecodeParams(params)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrInvalidParams, method, err)
+		}
+		return encodeResult(server.Declaration(ctx, p))
+	case MethodTextDocumentSelectionRange:
+		p, err := TextDocumentSelectionRangeRequest.DecodeParams(params)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrInvalidParams, method, err)
+		}
+		return encodeResult(server.SelectionRange(ctx, p))
+	case MethodTextDocumentPrepareCallHierarchy:
+		p, err := TextDocumentPrepareCallHierarchyRequest.DecodeParams(params)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrInvalidParams, method, err)
+		}
+		return encodeResult(server.PrepareCallHierarchy(ctx, p))
+	case MethodCallHierarchyIncomingCalls:
+		p, err := CallHierarchyIncomingCallsRequest.DecodeParams(params)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrInvalidParams, method, err)
+		}
+		return encodeResult(server.IncomingCalls(ctx, p))
+	case MethodCallHierarchyOutgoingCalls:
+		p, err := CallHierarchyOutgoingCallsRequest.DecodeParams(params)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrInvalidParams, method, err)
+		}
+		return encodeResult(server.OutgoingCalls(ctx, p))
+	case MethodTextDocumentSemanticTokensFull:
+		p, err := TextDocumentSemanticTokensFullRequest.DecodeParams(params)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrInvalidParams, method, err)
+		}
+		return encodeResult(server.SemanticTokensFull(ctx, p))
+	case MethodTextDocumentSemanticTokensFullDelta:
+		p, err := TextDocumentSemanticTokensFullDeltaRequest.DecodeParams(params)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrInvalidParams, method, err)
+		}
+		return encodeResult(server.SemanticTokensFullDelta(ctx, p))
+	case MethodTextDocumentSemanticTokensRange:
+		p, err := TextDocumentSemanticTokensRangeRequest.DecodeParams(params)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrInvalidParams, method, err)
+		}
+		return encodeResult(server.SemanticTokensRange(ctx, p))
+	case MethodTextDocumentLinkedEditingRange:
+		p, err := TextDocumentLinkedEditingRangeRequest.DecodeParams(params)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrInvalidParams, method, err)
+		}
+		return encodeResult(server.LinkedEditingRange(ctx, p))
+	case MethodWorkspaceWillCreateFiles:
+		p, err := WorkspaceWillCreateFilesRequest.DecodeParams(params)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrInvalidParams, method, err)
+		}
+		return encodeResult(server.WillCreateFiles(ctx, p))
+	case MethodWorkspaceWillRenameFiles:
+		p, err := WorkspaceWillRenameFilesRequest.DecodeParams(params)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrInvalidParams, method, err)
+		}
+		return encodeResult(server.WillRenameFiles(ctx, p))
+	case MethodWorkspaceWillDeleteFiles:
+		p, err := WorkspaceWillDeleteFilesRequest.DecodeParams(params)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrInvalidParams, method, err)
+		}
+		return encodeResult(server.WillDeleteFiles(ctx, p))
+	case MethodTextDocumentMoniker:
+		p, err := TextDocumentMonikerRequest.DecodeParams(params)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrInvalidParams, method, err)
+		}
+		return encodeResult(server.Moniker(ctx, p))
+	case MethodTextDocumentPrepareTypeHierarchy:
+		p, err := TextDocumentPrepareTypeHierarchyRequest.DecodeParams(params)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrInvalidParams, method, err)
+		}
+		return encodeResult(server.PrepareTypeHierarchy(ctx, p))
+	case MethodTypeHierarchySupertypes:
+		p, err := TypeHierarchySupertypesRequest.DecodeParams(params)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrInvalidParams, method, err)
+		}
+		return encodeResult(server.Supertypes(ctx, p))
+	case MethodTypeHierarchySubtypes:
+		p, err := TypeHierarchySubtypesRequest.DecodeParams(params)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrInvalidParams, method, err)
+		}
+		return encodeResult(server.Subtypes(ctx, p))
+	case MethodTextDocumentInlineValue:
+		p, err := TextDocumentInlineValueRequest.DecodeParams(params)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrInvalidParams, method, err)
+		}
+		return encodeResult(server.InlineValue(ctx, p))
+	case MethodTextDocumentInlayHint:
+		p, err := TextDocumentInlayHintRequest.DecodeParams(params)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrInvalidParams, method, err)
+		}
+		return encodeResult(server.InlayHint(ctx, p))
+	case MethodInlayHintResolve:
+		p, err := InlayHintResolveRequest.DecodeParams(params)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrInvalidParams, method, err)
+		}
+		return encodeResult(server.InlayHintResolve(ctx, p))
+	case MethodTextDocumentDiagnostic:
+		p, err := TextDocumentDiagnosticRequest.DecodeParams(params)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrInvalidParams, method, err)
+		}
+		return encodeResult(server.TextDocumentDiagnostic(ctx, p))
+	case MethodWorkspaceDiagnostic:
+		p, err := WorkspaceDiagnosticRequest.DecodeParams(params)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrInvalidParams, method, err)
+		}
+		return encodeResult(server.WorkspaceDiagnostic(ctx, p))
+	case MethodInitialize:
+		p, err := InitializeRequest.DecodeParams(params)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrInvalidParams, method, err)
+		}
+		return encodeResult(server.Initialize(ctx, p))
+	case MethodShutdown:
+		return encodeResult(nil, server.Shutdown(ctx))
+	case MethodTextDocumentWillSaveWaitUntil:
+		p, err := TextDocumentWillSaveWaitUntilRequest.DecodeParams(params)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrInvalidParams, method, err)
+		}
+		return encodeResult(server.WillSaveWaitUntil(ctx, p))
+	case MethodTextDocumentCompletion:
+		p, err := TextDocumentCompletionRequest.DecodeParams(params)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrInvalidParams, method, err)
+		}
+		return encodeResult(server.Completion(ctx, p))
+	case MethodCompletionItemResolve:
+		p, err := CompletionItemResolveRequest.DecodeParams(params)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrInvalidParams, method, err)
+		}
+		return encodeResult(server.CompletionItemResolve(ctx, p))
+	case MethodTextDocumentHover:
+		p, err := TextDocumentHoverRequest.DecodeParams(params)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrInvalidParams, method, err)
+		}
+		return encodeResult(server.Hover(ctx, p))
+	case MethodTextDocumentSignatureHelp:
+		p, err := TextDocumentSignatureHelpRequest.DecodeParams(params)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrInvalidParams, method, err)
+		}
+		return encodeResult(server.SignatureHelp(ctx, p))
+	case MethodTextDocumentDefinition:
+		p, err := TextDocumentDefinitionRequest.DecodeParams(params)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrInvalidParams, method, err)
+		}
+		return encodeResult(server.Definition(ctx, p))
+	case MethodTextDocumentReferences:
+		p, err := TextDocumentReferencesRequest.DecodeParams(params)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrInvalidParams, method, err)
+		}
+		return encodeResult(server.References(ctx, p))
+	case MethodTextDocumentDocumentHighlight:
+		p, err := TextDocumentDocumentHighlightRequest.DecodeParams(params)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrInvalidParams, method, err)
+		}
+		return encodeResult(server.DocumentHighlight(ctx, p))
+	case MethodTextDocumentDocumentSymbol:
+		p, err := TextDocumentDocumentSymbolRequest.DecodeParams(params)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrInvalidParams, method, err)
+		}
+		return encodeResult(server.DocumentSymbol(ctx, p))
+	case MethodTextDocumentCodeAction:
+		p, err := TextDocumentCodeActionRequest.DecodeParams(params)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrInvalidParams, method, err)
+		}
+		return encodeResult(server.CodeAction(ctx, p))
+	case MethodCodeActionResolve:
+		p, err := CodeActionResolveRequest.DecodeParams(params)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrInvalidParams, method, err)
+		}
+		return encodeResult(server.CodeActionResolve(ctx, p))
+	case MethodWorkspaceSymbol:
+		p, err := WorkspaceSymbolRequest.DecodeParams(params)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrInvalidParams, method, err)
+		}
+		return encodeResult(server.Symbol(ctx, p))
+	case MethodWorkspaceSymbolResolve:
+		p, err := WorkspaceSymbolResolveRequest.DecodeParams(params)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrInvalidParams, method, err)
+		}
+		return encodeResult(server.WorkspaceSymbolResolve(ctx, p))
+	case MethodTextDocumentCodeLens:
+		p, err := TextDocumentCodeLensRequest.DecodeParams(params)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrInvalidParams, method, err)
+		}
+		return encodeResult(server.CodeLens(ctx, p))
+	case MethodCodeLensResolve:
+		p, err := CodeLensResolveRequest.DecodeParams(params)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrInvalidParams, method, err)
+		}
+		return encodeResult(server.CodeLensResolve(ctx, p))
+	case MethodTextDocumentDocumentLink:
+		p, err := TextDocumentDocumentLinkRequest.DecodeParams(params)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrInvalidParams, method, err)
+		}
+		return encodeResult(server.DocumentLink(ctx, p))
+	case MethodDocumentLinkResolve:
+		p, err := DocumentLinkResolveRequest.DecodeParams(params)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrInvalidParams, method, err)
+		}
+		return encodeResult(server.DocumentLinkResolve(ctx, p))
+	case MethodTextDocumentFormatting:
+		p, err := TextDocumentFormattingRequest.DecodeParams(params)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrInvalidParams, method, err)
+		}
+		return encodeResult(server.Formatting(ctx, p))
+	case MethodTextDocumentRangeFormatting:
+		p, err := TextDocumentRangeFormattingRequest.DecodeParams(params)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrInvalidParams, method, err)
+		}
+		return encodeResult(server.RangeFormatting(ctx, p))
+	case MethodTextDocumentOnTypeFormatting:
+		p, err := TextDocumentOnTypeFormattingRequest.DecodeParams(params)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrInvalidParams, method, err)
+		}
+		return encodeResult(server.OnTypeFormatting(ctx, p))
+	case MethodTextDocumentRename:
+		p, err := TextDocumentRenameRequest.DecodeParams(params)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrInvalidParams, method, err)
+		}
+		return encodeResult(server.Rename(ctx, p))
+	case MethodTextDocumentPrepareRename:
+		p, err := TextDocumentPrepareRenameRequest.DecodeParams(params)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrInvalidParams, method, err)
+		}
+		return encodeResult(server.PrepareRename(ctx, p))
+	case MethodWorkspaceExecuteCommand:
+		p, err := WorkspaceExecuteCommandRequest.DecodeParams(params)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrInvalidParams, method, err)
+		}
+		return encodeResult(server.ExecuteCommand(ctx, p))
+	case MethodWorkspaceDidChangeWorkspaceFolders:
+		p, err := WorkspaceDidChangeWorkspaceFoldersNotification.DecodeParams(params)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrInvalidParams, method, err)
+		}
+		return nil, server.DidChangeWorkspaceFolders(ctx, p)
+	case MethodWindowWorkDoneProgressCancel:
+		p, err := WindowWorkDoneProgressCancelNotification.DecodeParams(params)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrInvalidParams, method, err)
+		}
+		return nil, server.WorkDoneProgressCancel(ctx, p)
+	case MethodWorkspaceDidCreateFiles:
+		p, err := WorkspaceDidCreateFilesNotification.DecodeParams(params)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrInvalidParams, method, err)
+		}
+		return nil, server.DidCreateFiles(ctx, p)
+	case MethodWorkspaceDidRenameFiles:
+		p, err := WorkspaceDidRenameFilesNotification.DecodeParams(params)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrInvalidParams, method, err)
+		}
+		return nil, server.DidRenameFiles(ctx, p)
+	case MethodWorkspaceDidDeleteFiles:
+		p, err := WorkspaceDidDeleteFilesNotification.DecodeParams(params)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrInvalidParams, method, err)
+		}
+		return nil, server.DidDeleteFiles(ctx, p)
+	case MethodNotebookDocumentDidOpen:
+		p, err := NotebookDocumentDidOpenNotification.DecodeParams(params)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrInvalidParams, method, err)
+		}
+		return nil, server.NotebookDocumentDidOpen(ctx, p)
+	case MethodNotebookDocumentDidChange:
+		p, err := NotebookDocumentDidChangeNotification.DecodeParams(params)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrInvalidParams, method, err)
+		}
+		return nil, server.NotebookDocumentDidChange(ctx, p)
+	case MethodNotebookDocumentDidSave:
+		p, err := NotebookDocumentDidSaveNotification.DecodeParams(params)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrInvalidParams, method, err)
+		}
+		return nil, server.NotebookDocumentDidSave(ctx, p)
+	case MethodNotebookDocumentDidClose:
+		p, err := NotebookDocumentDidCloseNotification.DecodeParams(params)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrInvalidParams, method, err)
+		}
+		return nil, server.NotebookDocumentDidClose(ctx, p)
+	case MethodInitialized:
+		p, err := InitializedNotification.DecodeParams(params)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrInvalidParams, method, err)
+		}
+		return nil, server.Initialized(ctx, p)
+	case MethodExit:
+		return nil, server.Exit(ctx)
+	case MethodWorkspaceDidChangeConfiguration:
+		p, err := WorkspaceDidChangeConfigurationNotification.DecodeParams(params)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrInvalidParams, method, err)
+		}
+		return nil, server.DidChangeConfiguration(ctx, p)
+	case MethodTextDocumentDidOpen:
+		p, err := TextDocumentDidOpenNotification.DecodeParams(params)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrInvalidParams, method, err)
+		}
+		return nil, server.TextDocumentDidOpen(ctx, p)
+	case MethodTextDocumentDidChange:
+		p, err := TextDocumentDidChangeNotification.DecodeParams(params)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrInvalidParams, method, err)
+		}
+		return nil, server.TextDocumentDidChange(ctx, p)
+	case MethodTextDocumentDidClose:
+		p, err := TextDocumentDidCloseNotification.DecodeParams(params)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrInvalidParams, method, err)
+		}
+		return nil, server.TextDocumentDidClose(ctx, p)
+	case MethodTextDocumentDidSave:
+		p, err := TextDocumentDidSaveNotification.DecodeParams(params)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrInvalidParams, method, err)
+		}
+		return nil, server.TextDocumentDidSave(ctx, p)
+	case MethodTextDocumentWillSave:
+		p, err := TextDocumentWillSaveNotification.DecodeParams(params)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrInvalidParams, method, err)
+		}
+		return nil, server.WillSave(ctx, p)
+	case MethodWorkspaceDidChangeWatchedFiles:
+		p, err := WorkspaceDidChangeWatchedFilesNotification.DecodeParams(params)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrInvalidParams, method, err)
+		}
+		return nil, server.DidChangeWatchedFiles(ctx, p)
+	case MethodSetTrace:
+		p, err := SetTraceNotification.DecodeParams(params)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrInvalidParams, method, err)
+		}
+		return nil, server.SetTrace(ctx, p)
+	case MethodCancelRequest:
+		p, err := CancelRequestNotification.DecodeParams(params)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrInvalidParams, method, err)
+		}
+		return nil, server.CancelRequest(ctx, p)
+	case MethodProgress:
+		p, err := ProgressNotification.DecodeParams(params)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrInvalidParams, method, err)
+		}
+		return nil, server.Progress(ctx, p)
+	}
+	return nil, fmt.Errorf("%w: %s", ErrMethodNotFound, method)
+}
+
+// encodeResult encodes the result v of a request, unless the request failed.
+func encodeResult(v interface{}, err error) (json.RawMessage, error) {
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(v)
+}
@@ -0,0 +1,74 @@
+package lsp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// hoverServer is a server answering hover requests and recording opened
+// documents.
+type hoverServer struct {
+	Server
+	opened   chan DocumentURI
+	shutdown bool
+}
+
+func (s *hoverServer) Hover(ctx context.Context, params *HoverParams) (*Hover, error) {
+	if params.Position.Line > 0 {
+		return nil, nil
+	}
+	return &Hover{Contents: Or_MarkupContent_MarkedString_MarkedStringSlice{Value: MarkupContent{Kind: MarkupKindPlainText, Value: "x"}}}, nil
+}
+
+func (s *hoverServer) Shutdown(ctx context.Context) error {
+	s.shutdown = true
+	return nil
+}
+
+func (s *hoverServer) TextDocumentDidOpen(ctx context.Context, params *DidOpenTextDocumentParams) error {
+	s.opened <- params.TextDocument.Uri
+	return nil
+}
+
+func TestDispatchServer(t *testing.T) {
+	server := &hoverServer{opened: make(chan DocumentURI, 1)}
+	_, c := connect(t, ServerHandler(server), echo)
+	ctx := context.Background()
+
+	var hover Hover
+	if err := c.Call(ctx, MethodTextDocumentHover, &HoverParams{}, &hover); err != nil {
+		t.Fatal(err)
+	}
+	want := Hover{Contents: Or_MarkupContent_MarkedString_MarkedStringSlice{Value: MarkupContent{Kind: MarkupKindPlainText, Value: "x"}}}
+	if !reflect.DeepEqual(hover, want) {
+		t.Errorf("hover: got %+v, want %+v", hover, want)
+	}
+
+	var raw json.RawMessage
+	params := &HoverParams{}
+	params.Position.Line = 1
+	if err := c.Call(ctx, MethodTextDocumentHover, params, &raw); err != nil || string(raw) != "null" {
+		t.Errorf("hover without result: got %s, %v", raw, err)
+	}
+	if err := c.Call(ctx, MethodShutdown, nil, &raw); err != nil || string(raw) != "null" || !server.shutdown {
+		t.Errorf("shutdown: got %s, %v", raw, err)
+	}
+
+	var e *Error
+	if err := c.Call(ctx, MethodTextDocumentHover, map[string]string{"position": "x"}, nil); !errors.As(err, &e) || e.Code != int32(ErrorCodesInvalidParams) {
+		t.Errorf("hover with bad params: got %v", err)
+	}
+	if err := c.Call(ctx, "unknown/method", nil, nil); !errors.As(err, &e) || e.Code != int32(ErrorCodesMethodNotFound) {
+		t.Errorf("unknown method: got %v", err)
+	}
+
+	if err := c.Notify(ctx, MethodTextDocumentDidOpen, &DidOpenTextDocumentParams{TextDocument: TextDocumentItem{Uri: "file:///a"}}); err != nil {
+		t.Fatal(err)
+	}
+	if uri := <-server.opened; uri != "file:///a" {
+		t.Errorf("didOpen: got %s", uri)
+	}
+}
@@ -0,0 +1,178 @@
+package lsp
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// DefaultMaxMessageSize is the default limit of the content length of
+// messages read by a HeaderStream.
+const DefaultMaxMessageSize = 64 << 20
+
+// ErrMessageTooLarge is returned by HeaderStream.Read for messages exceeding
+// the maximum message size. The content of the message is skipped, so the
+// stream can still be read.
+var ErrMessageTooLarge = errors.New("message too large")
+
+// A HeaderStream is a Stream framing messages with the headers of the LSP
+// base protocol:
+//
+//	Content-Length: ...\r\n
+//	\r\n
+//	{
+//		"jsonrpc": "2.0",
+//		...
+//	}
+//
+// Header names are case insensitive and may appear in any order. Unknown
+// headers are ignored. The Content-Type header is optional; if present, its
+// charset must be utf-8.
+type HeaderStream struct {
+	// MaxMessageSize limits the content length of read messages. If zero,
+	// DefaultMaxMessageSize is used.
+	MaxMessageSize int
+
+	r  *bufio.Reader
+	w  io.Writer
+	c  io.Closer
+	mu sync.Mutex
+}
+
+// NewHeaderStream returns a HeaderStream reading and writing messages over
+// rwc. Closing the stream closes rwc.
+func NewHeaderStream(rwc io.ReadWriteCloser) *HeaderStream {
+	return &HeaderStream{
+		r: bufio.NewReader(rwc),
+		w: rwc,
+		c: rwc,
+	}
+}
+
+// Read implements Stream. It returns io.EOF if the stream ends before the
+// first header of a message and io.ErrUnexpectedEOF if it ends within a
+// message.
+func (s *HeaderStream) Read() ([]byte, error) {
+	length, err := s.readHeader()
+	if err != nil {
+		return nil, err
+	}
+
+	max := s.MaxMessageSize
+	if max <= 0 {
+		max = DefaultMaxMessageSize
+	}
+	if length > max {
+		if _, err := io.CopyN(io.Discard, s.r, int64(length)); err != nil {
+			return nil, fmt.Errorf("reading content: %w", unexpected(err))
+		}
+		return nil, fmt.Errorf("%w: content length %d exceeds limit of %d bytes", ErrMessageTooLarge, length, max)
+	}
+
+	b := make([]byte, length)
+	if _, err := io.ReadFull(s.r, b); err != nil {
+		return nil, fmt.Errorf("reading content: %w", unexpected(err))
+	}
+	return b, nil
+}
+
+// readHeader reads the header part of a message and returns its content
+// length.
+func (s *HeaderStream) readHeader() (int, error) {
+	length := -1
+	for headers := 0; ; headers++ {
+		line, err := s.r.ReadSlice('\n')
+		switch {
+		case err == io.EOF && headers == 0 && len(line) == 0:
+			return 0, io.EOF
+		case err == bufio.ErrBufferFull:
+			return 0, fmt.Errorf("header line exceeds %d bytes", s.r.Size())
+		case err != nil:
+			return 0, fmt.Errorf("reading header: %w", unexpected(err))
+		}
+
+		line = bytes.TrimRight(line, "\r\n")
+		if len(line) == 0 {
+			if headers == 0 {
+				// Tolerate empty lines between messages.
+				headers--
+				continue
+			}
+			break
+		}
+
+		name, value, ok := strings.Cut(string(line), ":")
+		if !ok {
+			return 0, fmt.Errorf("invalid header line %q: missing colon", line)
+		}
+		name = strings.TrimSpace(name)
+		value = strings.TrimSpace(value)
+		switch {
+		case strings.EqualFold(name, "Content-Length"):
+			n, err := strconv.Atoi(value)
+			if err != nil || n < 0 {
+				return 0, fmt.Errorf("invalid Content-Length %q", value)
+			}
+			if length >= 0 && n != length {
+				return 0, fmt.Errorf("conflicting Content-Length headers %d and %d", length, n)
+			}
+			length = n
+		case strings.EqualFold(name, "Content-Type"):
+			if err := checkContentType(value); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if length < 0 {
+		return 0, fmt.Errorf("missing Content-Length header")
+	}
+	return length, nil
+}
+
+// checkContentType reports an error if the Content-Type value v does not
+// specify UTF-8 encoding. The charset utf8 is accepted for backwards
+// compatibility.
+func checkContentType(v string) error {
+	params := strings.Split(v, ";")
+	for _, p := range params[1:] {
+		name, value, _ := strings.Cut(p, "=")
+		if !strings.EqualFold(strings.TrimSpace(name), "charset") {
+			continue
+		}
+		value = strings.Trim(strings.TrimSpace(value), `"`)
+		if !strings.EqualFold(value, "utf-8") && !strings.EqualFold(value, "utf8") {
+			return fmt.Errorf("unsupported charset %q in Content-Type", value)
+		}
+	}
+	return nil
+}
+
+// unexpected turns io.EOF into io.ErrUnexpectedEOF.
+func unexpected(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
+
+// Write implements Stream. It is safe for concurrent use.
+func (s *HeaderStream) Write(msg []byte) error {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "Content-Length: %d\r\n\r\n", len(msg))
+	b.Write(msg)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, err := s.w.Write(b.Bytes())
+	return err
+}
+
+// Close implements Stream.
+func (s *HeaderStream) Close() error {
+	return s.c.Close()
+}
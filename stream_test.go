@@ -0,0 +1,123 @@
+package lsp
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+)
+
+// buffer is a ReadWriteCloser reading from r and writing to w.
+type buffer struct {
+	r      io.Reader
+	w      bytes.Buffer
+	closed bool
+}
+
+func (b *buffer) Read(p []byte) (int, error)  { return b.r.Read(p) }
+func (b *buffer) Write(p []byte) (int, error) { return b.w.Write(p) }
+func (b *buffer) Close() error                { b.closed = true; return nil }
+
+func TestHeaderStreamRead(t *testing.T) {
+	tests := []struct {
+		input string
+		want  []string // messages read before the error
+		err   string   // of the last read, io.EOF if empty
+	}{
+		{"", nil, ""},
+		{"Content-Length: 2\r\n\r\n{}", []string{"{}"}, ""},
+		{"Content-Length: 2\r\n\r\n{}Content-Length: 3\r\n\r\n[1]", []string{"{}", "[1]"}, ""},
+		{"\r\n\r\nContent-Length: 2\r\n\r\n{}\r\n", []string{"{}"}, ""},
+
+		// Headers are case insensitive, may appear in any order and
+		// unknown headers are ignored.
+		{"content-length: 2\r\n\r\n{}", []string{"{}"}, ""},
+		{"CONTENT-LENGTH:2\r\n\r\n{}", []string{"{}"}, ""},
+		{"Content-Type: application/vscode-jsonrpc; charset=utf-8\r\nX-Other: 1\r\nContent-Length: 2\r\n\r\n{}", []string{"{}"}, ""},
+		{"Content-Length: 2\r\ncontent-type: application/vscode-jsonrpc; Charset=\"UTF8\"\r\n\r\n{}", []string{"{}"}, ""},
+		{"Content-Length: 2\nContent-Type: application/vscode-jsonrpc\n\n{}", []string{"{}"}, ""},
+		{"Content-Length: 2\r\nContent-Length: 2\r\n\r\n{}", []string{"{}"}, ""},
+
+		// Invalid headers.
+		{"Content-Type: application/vscode-jsonrpc\r\n\r\n{}", nil, "missing Content-Length header"},
+		{"Content-Length: 2\r\nContent-Length: 3\r\n\r\n{}", nil, "conflicting Content-Length headers 2 and 3"},
+		{"Content-Length: x\r\n\r\n{}", nil, `invalid Content-Length "x"`},
+		{"Content-Length: -1\r\n\r\n{}", nil, `invalid Content-Length "-1"`},
+		{"Content-Length 2\r\n\r\n{}", nil, "missing colon"},
+		{"Content-Length: 2\r\nContent-Type: application/vscode-jsonrpc; charset=utf-16\r\n\r\n{}", nil, `unsupported charset "utf-16"`},
+		{"Content-Length: 2\r\nContent-Type: text/plain; charset=latin1\r\n\r\n{}", nil, `unsupported charset "latin1"`},
+
+		// The stream ends within a message.
+		{"Content-Length: 2", nil, io.ErrUnexpectedEOF.Error()},
+		{"Content-Length: 2\r\n", nil, io.ErrUnexpectedEOF.Error()},
+		{"Content-Length: 2\r\n\r\n{", nil, io.ErrUnexpectedEOF.Error()},
+		{"Content-Length: 2\r\n\r\n{}Content-Length: 2\r\n\r\n", []string{"{}"}, io.ErrUnexpectedEOF.Error()},
+	}
+	for _, tt := range tests {
+		s := NewHeaderStream(&buffer{r: strings.NewReader(tt.input)})
+		var got []string
+		var err error
+		for {
+			var b []byte
+			if b, err = s.Read(); err != nil {
+				break
+			}
+			got = append(got, string(b))
+		}
+		if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+			t.Errorf("%q: read %q, want %q", tt.input, got, tt.want)
+		}
+		switch {
+		case tt.err == "" && err != io.EOF:
+			t.Errorf("%q: got error %v, want io.EOF", tt.input, err)
+		case tt.err == io.ErrUnexpectedEOF.Error() && !errors.Is(err, io.ErrUnexpectedEOF):
+			t.Errorf("%q: got error %v, want io.ErrUnexpectedEOF", tt.input, err)
+		case tt.err != "" && !strings.Contains(err.Error(), tt.err):
+			t.Errorf("%q: got error %v, want %s", tt.input, err, tt.err)
+		}
+	}
+}
+
+func TestHeaderStreamMaxMessageSize(t *testing.T) {
+	input := "Content-Length: 5\r\n\r\n[1,2]Content-Length: 4\r\n\r\n[12]Content-Length: 6\r\n\r\n[1"
+	s := NewHeaderStream(&buffer{r: strings.NewReader(input)})
+	s.MaxMessageSize = 4
+
+	if _, err := s.Read(); !errors.Is(err, ErrMessageTooLarge) {
+		t.Fatalf("got error %v, want ErrMessageTooLarge", err)
+	}
+	// The stream is still usable.
+	if b, err := s.Read(); err != nil || string(b) != "[12]" {
+		t.Fatalf("got %q, %v after skipped message", b, err)
+	}
+	if _, err := s.Read(); !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Fatalf("got error %v for truncated large message, want io.ErrUnexpectedEOF", err)
+	}
+}
+
+func TestHeaderStreamWrite(t *testing.T) {
+	rwc := &buffer{}
+	s := NewHeaderStream(rwc)
+	for _, msg := range []string{`{"jsonrpc":"2.0"}`, `[]`} {
+		if err := s.Write([]byte(msg)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want := "Content-Length: 17\r\n\r\n{\"jsonrpc\":\"2.0\"}Content-Length: 2\r\n\r\n[]"
+	if got := rwc.w.String(); got != want {
+		t.Errorf("wrote %q, want %q", got, want)
+	}
+
+	// What is written can be read back.
+	r := NewHeaderStream(&buffer{r: &rwc.w})
+	for _, want := range []string{`{"jsonrpc":"2.0"}`, `[]`} {
+		if b, err := r.Read(); err != nil || string(b) != want {
+			t.Errorf("read %q, %v, want %q", b, err, want)
+		}
+	}
+
+	if err := s.Close(); err != nil || !rwc.closed {
+		t.Errorf("Close: %v, closed %v", err, rwc.closed)
+	}
+}
@@ -0,0 +1,208 @@
+package lsp
+
+import (
+	"fmt"
+	"net/url"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// ParseURI parses and normalizes s. See URI.Normalize.
+func ParseURI(s string) (URI, error) {
+	n, err := normalizeURI(s)
+	return URI(n), err
+}
+
+// ParseDocumentURI parses and normalizes s. See URI.Normalize.
+func ParseDocumentURI(s string) (DocumentURI, error) {
+	n, err := normalizeURI(s)
+	return DocumentURI(n), err
+}
+
+// FileURI returns the file URI of a local file system path. Relative paths
+// are made absolute first. Windows paths like C:\dir\file become
+// file:///C:/dir/file, UNC paths like \\host\share\file become
+// file://host/share/file.
+func FileURI(path string) (DocumentURI, error) {
+	path, err := filepath.Abs(path)
+	if err != nil {
+		return "", err
+	}
+	p := filepath.ToSlash(path)
+	u := url.URL{Scheme: "file", Path: p}
+	switch {
+	case strings.HasPrefix(p, "//"):
+		host, rest, _ := strings.Cut(p[2:], "/")
+		u.Host, u.Path = host, "/"+rest
+	case !strings.HasPrefix(p, "/"):
+		u.Path = "/" + p
+	}
+	return ParseDocumentURI(u.String())
+}
+
+// String returns u as string.
+func (u URI) String() string {
+	return string(u)
+}
+
+// Normalize returns the canonical form of u, so that equivalent URIs
+// compare equal:
+//
+//   - scheme and host are lower case, the host localhost of file URIs
+//     is dropped,
+//   - percent-encoding of the path is canonical, so that file:///c%3A/a%7E
+//     becomes file:///C:/a~, while encoded reserved characters like %2F
+//     stay encoded,
+//   - drive letters are upper case,
+//   - trailing slashes are removed, except for the root.
+//
+// Invalid URIs are returned unchanged.
+func (u URI) Normalize() URI {
+	if n, err := normalizeURI(string(u)); err == nil {
+		return URI(n)
+	}
+	return u
+}
+
+// Equal reports whether u and v are equal after normalization.
+func (u URI) Equal(v URI) bool {
+	return u == v || u.Normalize() == v.Normalize()
+}
+
+// IsFile reports whether u is a file URI.
+func (u URI) IsFile() bool {
+	return isFileURI(string(u))
+}
+
+// Path returns the local file system path of a file URI.
+func (u URI) Path() (string, error) {
+	return uriPath(string(u))
+}
+
+// String returns u as string.
+func (u DocumentURI) String() string {
+	return string(u)
+}
+
+// Normalize returns the canonical form of u. See URI.Normalize.
+func (u DocumentURI) Normalize() DocumentURI {
+	return DocumentURI(URI(u).Normalize())
+}
+
+// Equal reports whether u and v are equal after normalization.
+func (u DocumentURI) Equal(v DocumentURI) bool {
+	return URI(u).Equal(URI(v))
+}
+
+// IsFile reports whether u is a file URI.
+func (u DocumentURI) IsFile() bool {
+	return isFileURI(string(u))
+}
+
+// Path returns the local file system path of a file URI.
+func (u DocumentURI) Path() (string, error) {
+	return uriPath(string(u))
+}
+
+func normalizeURI(s string) (string, error) {
+	u, err := url.Parse(s)
+	if err != nil {
+		return "", err
+	}
+	if u.Scheme == "" {
+		return "", fmt.Errorf("invalid URI %q: missing scheme", s)
+	}
+	if u.Opaque != "" {
+		return u.String(), nil
+	}
+	u.Host = strings.ToLower(u.Host)
+	if u.Scheme == "file" && u.Host == "localhost" {
+		u.Host = ""
+	}
+	p := normalizePath(u.EscapedPath())
+	if len(p) >= 5 && p[0] == '/' && p[2:5] == "%3A" {
+		p = p[:2] + ":" + p[5:]
+	}
+	if isDrivePath(p) {
+		p = "/" + strings.ToUpper(p[1:2]) + ":/" + strings.TrimPrefix(p[3:], "/")
+	}
+	for len(p) > 1 && strings.HasSuffix(p, "/") && !(len(p) == 4 && isDrivePath(p)) {
+		p = p[:len(p)-1]
+	}
+	if u.Path, err = url.PathUnescape(p); err != nil {
+		return "", err
+	}
+	u.RawPath = p
+	u.RawFragment = ""
+	return u.String(), nil
+}
+
+// normalizePath returns the escaped path p with canonical percent-encoding:
+// unreserved characters are decoded and the hex digits of the remaining
+// encodings are upper case. Reserved characters keep their encoding, since
+// decoding them may change the path, like %2F. Characters not allowed in
+// paths are encoded.
+func normalizePath(p string) string {
+	var b strings.Builder
+	for i := 0; i < len(p); i++ {
+		c := p[i]
+		encoded := false
+		if c == '%' && i+2 < len(p) {
+			if n, err := strconv.ParseUint(p[i+1:i+3], 16, 8); err == nil {
+				c, encoded = byte(n), true
+				i += 2
+			}
+		}
+		switch {
+		case isUnreserved(c):
+			b.WriteByte(c)
+		case !encoded && strings.IndexByte("/:@!$&'()*+,;=", c) >= 0:
+			b.WriteByte(c)
+		default:
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
+
+// isUnreserved reports whether c is an unreserved character of RFC 3986.
+func isUnreserved(c byte) bool {
+	return 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9' ||
+		c == '-' || c == '.' || c == '_' || c == '~'
+}
+
+// isDrivePath reports whether the path of a URI starts with a Windows drive
+// letter, like /C: or /c:/dir.
+func isDrivePath(p string) bool {
+	if len(p) < 3 || p[0] != '/' || p[2] != ':' {
+		return false
+	}
+	c := p[1] | 0x20
+	return c >= 'a' && c <= 'z' && (len(p) == 3 || p[3] == '/')
+}
+
+func isFileURI(s string) bool {
+	return len(s) >= 5 && strings.EqualFold(s[:5], "file:")
+}
+
+func uriPath(s string) (string, error) {
+	u, err := url.Parse(s)
+	if err != nil {
+		return "", err
+	}
+	if u.Scheme != "file" {
+		return "", fmt.Errorf("%s: not a file URI", s)
+	}
+	p := u.Path
+	if isDrivePath(p) {
+		p = strings.ToUpper(p[1:2]) + p[2:]
+		if len(p) == 2 {
+			p += "/"
+		}
+	}
+	if u.Host != "" && u.Host != "localhost" {
+		p = "//" + u.Host + p
+	}
+	return filepath.FromSlash(p), nil
+}
@@ -0,0 +1,133 @@
+package lsp
+
+import (
+	"path/filepath"
+	"runtime"
+	"testing"
+)
+
+func TestNormalizeURI(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"file:///c%3A/Users/a%7Eb/", "file:///C:/Users/a~b"},
+		{"file:///c%3a/x", "file:///C:/x"},
+		{"file:///c:/x", "file:///C:/x"},
+		{"file:///C:/", "file:///C:/"},
+		{"file:///c:", "file:///C:/"},
+		{"file:///c%3A", "file:///C:/"},
+		{"file:///d:/dir/", "file:///D:/dir"},
+		{"file:///ab%3Ac", "file:///ab%3Ac"},
+		{"file:///cd:/x", "file:///cd:/x"},
+
+		{"FILE://LocalHost/tmp/a%20b", "file:///tmp/a%20b"},
+		{"file://localhost/", "file:///"},
+		{"file://Server/share/x", "file://server/share/x"},
+
+		{"file:///tmp/dir/", "file:///tmp/dir"},
+		{"file:///tmp/dir//", "file:///tmp/dir"},
+		{"file:///", "file:///"},
+
+		{"file:///a%2Fb", "file:///a%2Fb"},
+		{"file:///a%2fb/", "file:///a%2Fb"},
+		{"file:///a%3Fb%23c%25d", "file:///a%3Fb%23c%25d"},
+		{"file:///%61%2D%5F", "file:///a-_"},
+		{"file:///a%e4b", "file:///a%E4b"},
+		{"file:///über", "file:///%C3%BCber"},
+		{"file:///a$b;c", "file:///a$b;c"},
+
+		{"untitled:Untitled-1", "untitled:Untitled-1"},
+		{"https://Example.COM/a/b/?q=1#x", "https://example.com/a/b?q=1#x"},
+	}
+	for _, tt := range tests {
+		got, err := ParseDocumentURI(tt.input)
+		if err != nil || string(got) != tt.want {
+			t.Errorf("ParseDocumentURI(%q) = %q, %v, want %q", tt.input, got, err, tt.want)
+		}
+		if n := got.Normalize(); n != got {
+			t.Errorf("%q: normalized again to %q", got, n)
+		}
+	}
+
+	for _, s := range []string{"relative/path", "file:///a%zz"} {
+		if u, err := ParseURI(s); err == nil {
+			t.Errorf("ParseURI(%q) = %q, want error", s, u)
+		}
+	}
+	if u := URI("relative/path").Normalize(); u != "relative/path" {
+		t.Errorf("invalid URI normalized to %q", u)
+	}
+	if !DocumentURI("file:///c%3A/x/").Equal("file:///C:/x") {
+		t.Error("file:///c%3A/x/ does not equal file:///C:/x")
+	}
+	if DocumentURI("file:///a%2Fb").Equal("file:///a/b") {
+		t.Error("file:///a%2Fb equals file:///a/b")
+	}
+}
+
+func TestURIPath(t *testing.T) {
+	tests := []struct {
+		uri  DocumentURI
+		want string
+	}{
+		{"file:///tmp/a%20b/c", "/tmp/a b/c"},
+		{"file://localhost/tmp/x", "/tmp/x"},
+		{"file:///c%3A/x", "C:/x"},
+		{"file:///c:", "C:/"},
+		{"file://server/share/x", "//server/share/x"},
+	}
+	for _, tt := range tests {
+		p, err := tt.uri.Path()
+		if want := filepath.FromSlash(tt.want); err != nil || p != want {
+			t.Errorf("%s.Path() = %q, %v, want %q", tt.uri, p, err, want)
+		}
+	}
+	for _, u := range []DocumentURI{"untitled:x", "https://example.com/x"} {
+		if p, err := u.Path(); err == nil {
+			t.Errorf("%s.Path() = %q, want error", u, p)
+		}
+	}
+}
+
+func TestFileURI(t *testing.T) {
+	tests := []struct {
+		path string
+		want DocumentURI
+	}{
+		{"/tmp/a b/c", "file:///tmp/a%20b/c"},
+		{"/tmp/a%b", "file:///tmp/a%25b"},
+		{"/tmp/dir/", "file:///tmp/dir"},
+		{"/", "file:///"},
+	}
+	if runtime.GOOS == "windows" {
+		tests = []struct {
+			path string
+			want DocumentURI
+		}{
+			{`C:\dir\a b`, "file:///C:/dir/a%20b"},
+			{`c:\`, "file:///C:/"},
+			{`\\host\share\file`, "file://host/share/file"},
+		}
+	}
+	for _, tt := range tests {
+		u, err := FileURI(tt.path)
+		if err != nil || u != tt.want {
+			t.Errorf("FileURI(%q) = %q, %v, want %q", tt.path, u, err, tt.want)
+			continue
+		}
+		abs, _ := filepath.Abs(tt.path)
+		if p, err := u.Path(); err != nil || p != abs {
+			t.Errorf("%s.Path() = %q, %v, want %q", u, p, err, abs)
+		}
+	}
+
+	u, err := FileURI("x")
+	if err != nil {
+		t.Fatal(err)
+	}
+	abs, _ := filepath.Abs("x")
+	if p, err := u.Path(); !u.IsFile() || err != nil || p != abs {
+		t.Errorf("FileURI of a relative path: got %q with path %q, %v, want path %q", u, p, err, abs)
+	}
+}